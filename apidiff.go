@@ -0,0 +1,487 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cqln/vkgen/schema"
+	"github.com/urfave/cli/v2"
+)
+
+// apiEntry is an exported identifier of a package: a type, a struct field
+// or interface method ("T.Field"), a function or a method ("T.Method").
+type apiEntry struct {
+	decl   string // the type or signature, without names and tags
+	isType bool
+	fn     *ast.FuncDecl
+	fset   *token.FileSet
+}
+
+type goAPI map[string]apiEntry
+
+// apiChange is a change of the exported identifier Name.
+type apiChange struct {
+	Kind         string // added, removed, changed or renamed
+	Name         string
+	Old, New     string // declarations, or the new name of a renamed one
+	Incompatible bool
+}
+
+func (c apiChange) String() string {
+	mark := "             "
+	if c.Incompatible {
+		mark = "INCOMPATIBLE "
+	}
+	switch c.Kind {
+	case "added":
+		return mark + "added " + c.Name + ": " + c.New
+	case "removed":
+		return mark + "removed " + c.Name + ": " + c.Old
+	case "renamed":
+		return mark + "renamed " + c.Name + " -> " + c.New
+	}
+	return mark + "changed " + c.Name + ": " + c.Old + " -> " + c.New
+}
+
+func apiDiffCmd(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return errors.New("apidiff: want old and new schema or package directories")
+	}
+	config, err := LoadConfig(c.String("config"))
+	if err != nil {
+		return err
+	}
+	old, _, err := loadAPI(c.Args().Get(0), c.String("events"), c.String("package"), config)
+	if err != nil {
+		return err
+	}
+	new, pkgName, err := loadAPI(c.Args().Get(1), c.String("events"), c.String("package"), config)
+	if err != nil {
+		return err
+	}
+
+	changes := compareAPI(old, new)
+	for _, change := range changes {
+		fmt.Println(change)
+	}
+
+	if shims := c.String("shims"); shims != "" {
+		src, err := apiShims(pkgName, changes, old, new)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(shims, src, 0644); err != nil {
+			return err
+		}
+	}
+
+	if c.Bool("fail") {
+		for _, change := range changes {
+			if change.Incompatible {
+				return cli.Exit("", 1)
+			}
+		}
+	}
+	return nil
+}
+
+// loadAPI reads the exported API of the package generated in memory from
+// the schema directory, or of the Go package directory, together with the
+// name of the package.
+func loadAPI(dir, events, pkgName string, config Config) (goAPI, string, error) {
+	files := make(map[string][]byte)
+	if _, err := os.Stat(filepath.Join(dir, string(schema.ObjectsSchema))); err == nil {
+		objschema, err := ioutil.ReadFile(filepath.Join(dir, string(schema.ObjectsSchema)))
+		if err != nil {
+			return nil, "", err
+		}
+		err = NewGenerator(Options{
+			Events:    events,
+			Package:   pkgName,
+			Config:    config,
+			SchemaDir: dir,
+			Sink: func(name string, src []byte) error {
+				files[name] = src
+				return nil
+			},
+		}, objschema).Generate()
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", dir, err)
+		}
+	} else {
+		names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			return nil, "", err
+		}
+		for _, name := range names {
+			if strings.HasSuffix(name, "_test.go") {
+				continue
+			}
+			if files[name], err = ioutil.ReadFile(name); err != nil {
+				return nil, "", err
+			}
+		}
+	}
+
+	api := make(goAPI)
+	fset := token.NewFileSet()
+	for name, src := range files {
+		f, err := parser.ParseFile(fset, name, src, 0)
+		if err != nil {
+			return nil, "", err
+		}
+		api.addFile(fset, f)
+		pkgName = f.Name.Name
+	}
+	if pkgName == "" {
+		pkgName = defaultPkgName
+	}
+	return api, pkgName, nil
+}
+
+func (api goAPI) addFile(fset *token.FileSet, f *ast.File) {
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if !decl.Name.IsExported() {
+				continue
+			}
+			name := decl.Name.Name
+			if decl.Recv != nil {
+				recv := receiverType(decl.Recv.List[0].Type)
+				if !ast.IsExported(recv) {
+					continue
+				}
+				name = recv + "." + name
+			}
+			api[name] = apiEntry{decl: funcSignature(fset, decl.Type), fn: decl, fset: fset}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					if spec.Name.IsExported() {
+						api.addType(fset, spec)
+					}
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						if name.IsExported() {
+							api[name.Name] = apiEntry{decl: decl.Tok.String() + " " + nodeString(fset, spec.Type)}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func (api goAPI) addType(fset *token.FileSet, spec *ast.TypeSpec) {
+	name := spec.Name.Name
+	switch typ := spec.Type.(type) {
+	case *ast.StructType:
+		api[name] = apiEntry{decl: "struct", isType: true}
+		for _, field := range typ.Fields.List {
+			fieldType := nodeString(fset, field.Type)
+			if len(field.Names) == 0 {
+				embedded := receiverType(field.Type)
+				if ast.IsExported(embedded) {
+					api[name+"."+embedded] = apiEntry{decl: fieldType}
+				}
+			}
+			for _, fieldName := range field.Names {
+				if fieldName.IsExported() {
+					api[name+"."+fieldName.Name] = apiEntry{decl: fieldType}
+				}
+			}
+		}
+	case *ast.InterfaceType:
+		api[name] = apiEntry{decl: "interface", isType: true}
+		for _, method := range typ.Methods.List {
+			for _, methodName := range method.Names {
+				if ft, ok := method.Type.(*ast.FuncType); ok && methodName.IsExported() {
+					api[name+"."+methodName.Name] = apiEntry{decl: funcSignature(fset, ft)}
+				}
+			}
+		}
+	default:
+		decl := nodeString(fset, spec.Type)
+		if spec.Assign.IsValid() {
+			decl = "= " + decl
+		}
+		api[name] = apiEntry{decl: decl, isType: true}
+	}
+}
+
+// receiverType returns the type name of a receiver or an embedded field.
+func receiverType(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return receiverType(expr.X)
+	case *ast.SelectorExpr:
+		return expr.Sel.Name
+	case *ast.Ident:
+		return expr.Name
+	}
+	return ""
+}
+
+// funcSignature prints the parameter and result types.
+func funcSignature(fset *token.FileSet, ft *ast.FuncType) string {
+	types := func(fields *ast.FieldList) string {
+		if fields == nil {
+			return ""
+		}
+		var list []string
+		for _, field := range fields.List {
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				list = append(list, nodeString(fset, field.Type))
+			}
+		}
+		return strings.Join(list, ", ")
+	}
+
+	sig := "func(" + types(ft.Params) + ")"
+	switch results := types(ft.Results); {
+	case ft.Results == nil || results == "":
+	case len(ft.Results.List) == 1 && len(ft.Results.List[0].Names) == 0:
+		sig += " " + results
+	default:
+		sig += " (" + results + ")"
+	}
+	return sig
+}
+
+func nodeString(fset *token.FileSet, node ast.Node) string {
+	if node == nil {
+		return ""
+	}
+	var b bytes.Buffer
+	printer.Fprint(&b, fset, node)
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// compareAPI lists the changes sorted by name. A removed type, function or
+// method whose declaration reappears under a single new name is renamed.
+func compareAPI(old, new goAPI) []apiChange {
+	var removed, added []string
+	for name := range old {
+		if _, ok := new[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	for name := range new {
+		if _, ok := old[name]; !ok {
+			added = append(added, name)
+		}
+	}
+	sort.Strings(removed)
+	sort.Strings(added)
+
+	// declarations using renamed types stay compatible through the aliases
+	renames := findRenames(old, new, removed, added)
+	var changes []apiChange
+	for name, entry := range old {
+		newEntry, ok := new[name]
+		if ok && renameTypes(entry.decl, renames) != newEntry.decl {
+			changes = append(changes, apiChange{Kind: "changed", Name: name, Old: entry.decl, New: newEntry.decl, Incompatible: true})
+		}
+	}
+	for _, name := range removed {
+		if newName, ok := renames[name]; ok {
+			// members of a renamed type keeping their name follow it
+			if memberName(name) != memberName(newName) {
+				changes = append(changes, apiChange{Kind: "renamed", Name: name, New: newName, Incompatible: true})
+			}
+			continue
+		}
+		changes = append(changes, apiChange{Kind: "removed", Name: name, Old: old[name].decl, Incompatible: true})
+	}
+	renamed := make(map[string]bool, len(renames))
+	for _, newName := range renames {
+		renamed[newName] = true
+	}
+	for _, name := range added {
+		if !renamed[name] {
+			changes = append(changes, apiChange{Kind: "added", Name: name, New: new[name].decl})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+// findRenames maps the removed names to the added ones. Types match on
+// their declaration and members, which are renamed along; functions and
+// methods match on their signature and the (renamed) receiver.
+func findRenames(old, new goAPI, removed, added []string) map[string]string {
+	renames := make(map[string]string)
+
+	shape := func(api goAPI, name string) string {
+		members := []string{api[name].decl}
+		for member, entry := range api {
+			if strings.HasPrefix(member, name+".") {
+				members = append(members, strings.TrimPrefix(member, name)+" "+entry.decl)
+			}
+		}
+		sort.Strings(members)
+		return strings.Join(members, "\n")
+	}
+	match := func(candidates []string, key func(string) string, want string) string {
+		found := ""
+		for _, name := range candidates {
+			if key(name) == want {
+				if found != "" {
+					return "" // ambiguous
+				}
+				found = name
+			}
+		}
+		return found
+	}
+	var addedTypes []string
+	for _, name := range added {
+		if new[name].isType {
+			addedTypes = append(addedTypes, name)
+		}
+	}
+	for _, name := range removed {
+		if !old[name].isType {
+			continue
+		}
+		newName := match(addedTypes, func(n string) string { return shape(new, n) }, shape(old, name))
+		if newName == "" {
+			continue
+		}
+		renames[name] = newName
+		for member := range old {
+			if strings.HasPrefix(member, name+".") {
+				renames[member] = newName + strings.TrimPrefix(member, name)
+			}
+		}
+	}
+
+	for _, name := range removed {
+		if _, ok := renames[name]; ok || old[name].fn == nil {
+			continue
+		}
+		recv := ""
+		if i := strings.Index(name, "."); i >= 0 {
+			recv = name[:i]
+			if newRecv, ok := renames[recv]; ok {
+				recv = newRecv
+			}
+		}
+		var candidates []string
+		for _, n := range added {
+			if new[n].fn != nil && (recv == "" && !strings.Contains(n, ".") || recv != "" && strings.HasPrefix(n, recv+".")) {
+				candidates = append(candidates, n)
+			}
+		}
+		if newName := match(candidates, func(n string) string { return new[n].decl }, renameTypes(old[name].decl, renames)); newName != "" {
+			renames[name] = newName
+		}
+	}
+	return renames
+}
+
+var identRe = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+// renameTypes replaces the renamed type names in the declaration.
+func renameTypes(decl string, renames map[string]string) string {
+	return identRe.ReplaceAllStringFunc(decl, func(ident string) string {
+		if newName, ok := renames[ident]; ok {
+			return newName
+		}
+		return ident
+	})
+}
+
+// apiShims emits deprecated aliases for the renamed types and forwarding
+// functions and methods for the renamed ones, in the package pkgName.
+func apiShims(pkgName string, changes []apiChange, old, new goAPI) ([]byte, error) {
+	b := bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\npackage " + pkgName + "\n")
+	for _, change := range changes {
+		if change.Kind != "renamed" {
+			continue
+		}
+		newName := memberName(change.New)
+		fn := new[change.New].fn
+		if fn == nil {
+			b.WriteString("\n// Deprecated: use " + newName + ".\n")
+			b.WriteString("type " + change.Name + " = " + change.New + "\n")
+			continue
+		}
+
+		fset := new[change.New].fset
+		oldName := memberName(change.Name)
+		b.WriteString("\n// Deprecated: use " + newName + ".\n")
+		b.WriteString("func ")
+		call := newName
+		if fn.Recv != nil {
+			recv := fn.Recv.List[0]
+			recvName := "r"
+			if len(recv.Names) > 0 && recv.Names[0].Name != "_" {
+				recvName = recv.Names[0].Name
+			}
+			b.WriteString("(" + recvName + " " + nodeString(fset, recv.Type) + ") ")
+			call = recvName + "." + newName
+		}
+
+		var params, args []string
+		for i, field := range fn.Type.Params.List {
+			names := field.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+			}
+			for _, name := range names {
+				params = append(params, name.Name+" "+nodeString(fset, field.Type))
+				arg := name.Name
+				if _, ok := field.Type.(*ast.Ellipsis); ok {
+					arg += "..."
+				}
+				args = append(args, arg)
+			}
+		}
+		b.WriteString(oldName + "(" + strings.Join(params, ", ") + ")")
+		call += "(" + strings.Join(args, ", ") + ")"
+		if results := fn.Type.Results; results != nil && len(results.List) > 0 {
+			var list []string
+			for _, field := range results.List {
+				for i := 0; i < len(field.Names) || i == 0; i++ {
+					list = append(list, nodeString(fset, field.Type))
+				}
+			}
+			if len(list) == 1 {
+				b.WriteString(" " + list[0])
+			} else {
+				b.WriteString(" (" + strings.Join(list, ", ") + ")")
+			}
+			call = "return " + call
+		}
+		b.WriteString(" {\n\t" + call + "\n}\n")
+	}
+	return format.Source(b.Bytes())
+}
+
+// memberName strips the type of a member identifier.
+func memberName(name string) string {
+	return name[strings.LastIndex(name, ".")+1:]
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// parseAPI returns the exported API of the package source.
+func parseAPI(t *testing.T, src string) goAPI {
+	t.Helper()
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "api.go", "package generated\n"+src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := make(goAPI)
+	api.addFile(fset, f)
+	return api
+}
+
+const oldAPI = `
+type UsersUser struct {
+	ID   int64
+	Name string
+}
+
+type UsersGet struct{ UserIDs string }
+
+func (r UsersGet) Params() Params { return nil }
+
+type VK struct{}
+
+func (vk *VK) UsersGet(ctx context.Context, req UsersGet) ([]UsersUser, error) { return nil, nil }
+
+func Cacheable(method string) bool { return false }
+
+const Version = "5.131"
+
+type unexported struct{}
+`
+
+func TestCompareAPI(t *testing.T) {
+	tests := []struct {
+		name string
+		new  string // replacements of the old API, old text followed by "=>" and the new one
+		want []string
+	}{
+		{
+			name: "unchanged",
+		},
+		{
+			name: "added",
+			new:  "type unexported struct{}=>func Sample(n int) int { return n }",
+			want: []string{"added Sample: func(int) int"},
+		},
+		{
+			name: "removed",
+			new:  "func Cacheable(method string) bool { return false }=>",
+			want: []string{"INCOMPATIBLE removed Cacheable: func(string) bool"},
+		},
+		{
+			name: "changed",
+			new:  "Name string=>Name *string",
+			want: []string{"INCOMPATIBLE changed UsersUser.Name: string -> *string"},
+		},
+		{
+			name: "type renamed",
+			new:  "UsersUser=>UsersUserFull",
+			want: []string{"INCOMPATIBLE renamed UsersUser -> UsersUserFull"},
+		},
+		{
+			name: "method renamed",
+			new:  "func (vk *VK) UsersGet(=>func (vk *VK) UsersGetByID(",
+			want: []string{"INCOMPATIBLE renamed VK.UsersGet -> VK.UsersGetByID"},
+		},
+		{
+			name: "request and method renamed",
+			new:  "UsersGet=>UsersLookup",
+			want: []string{
+				"INCOMPATIBLE renamed UsersGet -> UsersLookup",
+				"INCOMPATIBLE renamed VK.UsersGet -> VK.UsersLookup",
+			},
+		},
+		{
+			name: "ambiguous rename",
+			new:  "func Cacheable(method string) bool { return false }=>func Cached(m string) bool { return false }\nfunc Stored(m string) bool { return false }",
+			want: []string{
+				"INCOMPATIBLE removed Cacheable: func(string) bool",
+				"added Cached: func(string) bool",
+				"added Stored: func(string) bool",
+			},
+		},
+	}
+	old := parseAPI(t, oldAPI)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := oldAPI
+			if tt.new != "" {
+				parts := strings.SplitN(tt.new, "=>", 2)
+				src = strings.Replace(src, parts[0], parts[1], -1)
+			}
+			changes := compareAPI(old, parseAPI(t, src))
+			var got []string
+			for _, change := range changes {
+				got = append(got, strings.TrimSpace(change.String()))
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("compareAPI() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestFindRenames(t *testing.T) {
+	old := parseAPI(t, oldAPI)
+	new := parseAPI(t, strings.Replace(oldAPI, "UsersUser", "UsersUserFull", -1))
+	renames := findRenames(old, new, []string{"UsersUser", "UsersUser.ID", "UsersUser.Name"}, []string{"UsersUserFull", "UsersUserFull.ID", "UsersUserFull.Name"})
+	want := map[string]string{
+		"UsersUser":      "UsersUserFull",
+		"UsersUser.ID":   "UsersUserFull.ID",
+		"UsersUser.Name": "UsersUserFull.Name",
+	}
+	if len(renames) != len(want) {
+		t.Fatalf("findRenames() = %v, want %v", renames, want)
+	}
+	for name, newName := range want {
+		if renames[name] != newName {
+			t.Errorf("renames[%s] = %q, want %q", name, renames[name], newName)
+		}
+	}
+	if got := renameTypes("func(context.Context, []UsersUser) UsersUserX", renames); got != "func(context.Context, []UsersUserFull) UsersUserX" {
+		t.Errorf("renameTypes() = %s", got)
+	}
+}
+
+func TestAPIShims(t *testing.T) {
+	old := parseAPI(t, `
+type UsersGet struct{}
+
+type VK struct{}
+
+func (vk *VK) UsersGet(ctx context.Context, req UsersGet) error { return nil }
+
+func Join(sep string, elems ...string) string { return "" }
+
+func Split(s string) (head, tail string, err error) { return "", "", nil }
+`)
+	new := parseAPI(t, `
+type UsersLookup struct{}
+
+type VK struct{}
+
+func (vk *VK) UsersLookup(ctx context.Context, req UsersLookup) error { return nil }
+
+func Concat(sep string, elems ...string) string { return "" }
+
+func Cut(s string) (head, tail string, err error) { return "", "", nil }
+`)
+
+	src, err := apiShims("vk", compareAPI(old, new), old, new)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := genPrefix + `
+
+package vk
+
+// Deprecated: use Concat.
+func Join(sep string, elems ...string) string {
+	return Concat(sep, elems...)
+}
+
+// Deprecated: use Cut.
+func Split(s string) (string, string, error) {
+	return Cut(s)
+}
+
+// Deprecated: use UsersLookup.
+type UsersGet = UsersLookup
+
+// Deprecated: use UsersLookup.
+func (vk *VK) UsersGet(ctx context.Context, req UsersLookup) error {
+	return vk.UsersLookup(ctx, req)
+}
+`
+	if string(src) != want {
+		t.Errorf("apiShims() =\n%s\nwant\n%s", src, want)
+	}
+}
+
+func TestLoadAPI(t *testing.T) {
+	schemaDir := func(fields string) string {
+		dir, err := ioutil.TempDir("", "apidiff")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { os.RemoveAll(dir) })
+		files := map[string]string{
+			"objects.json":   `{"title":"objects","definitions":{"users_user":{"type":"object","properties":{` + fields + `}}}}`,
+			"responses.json": `{"title":"responses","definitions":{"users_get_response":{"type":"object","properties":{"response":{"type":"array","items":{"$ref":"objects.json#/definitions/users_user"}}}}}}`,
+			"methods.json":   `{"methods":[{"name":"users.get","access_token_type":["user"],"parameters":[{"name":"user_ids","type":"string"}],"responses":{"response":{"$ref":"responses.json#/definitions/users_get_response"}}}]}`,
+		}
+		for name, content := range files {
+			if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return dir
+	}
+
+	old, pkgName, err := loadAPI(schemaDir(`"id":{"type":"integer"}`), "", "", Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pkgName != defaultPkgName {
+		t.Errorf("package = %q, want %q", pkgName, defaultPkgName)
+	}
+	new, pkgName, err := loadAPI(schemaDir(`"id":{"type":"string"},"name":{"type":"string"}`), "", "vk", Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pkgName != "vk" {
+		t.Errorf("package = %q, want %q", pkgName, "vk")
+	}
+	var got []string
+	for _, change := range compareAPI(old, new) {
+		got = append(got, strings.TrimSpace(change.String()))
+	}
+	want := []string{
+		"INCOMPATIBLE changed UsersUser.ID: int64 -> string",
+		"added UsersUser.Name: string",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("changes =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
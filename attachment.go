@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+// attachmentTypes maps the object definitions VK accepts as attachments to
+// the type prefix of their attachment strings. audio_audio declares no
+// owner_id in the schema, so it gets no helpers; audio attachments are
+// built with runtime.Attachment directly.
+var attachmentTypes = map[string]string{
+	"audio_audio":             "audio",
+	"docs_doc":                "doc",
+	"market_market_album":     "market_album",
+	"market_market_item":      "market",
+	"market_market_item_full": "market",
+	"photos_photo":            "photo",
+	"photos_photo_album":      "album",
+	"photos_photo_full":       "photo",
+	"polls_poll":              "poll",
+	"stories_story":           "story",
+	"video_video":             "video",
+	"video_video_full":        "video",
+	"wall_wallpost":           "wall",
+	"wall_wallpost_full":      "wall",
+}
+
+// generateAttachments emits Attachment and ParseAttachment methods for the
+// objects in attachmentTypes that have integer owner_id and id fields. The
+// skipped objects are logged when debugging.
+func (g Generator) generateAttachments() error {
+	objectsSchema, err := g.readSchema(schema.ObjectsSchema)
+	if err != nil {
+		return err
+	}
+	objects, err := g.parser.ParseObjects(objectsSchema)
+	if err != nil {
+		return err
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Name < objects[j].Name
+	})
+
+	b := bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\npackage " + g.pkgName + "\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\t\"errors\"\n\n")
+	b.WriteString("\t\"" + runtimePkg + "\"\n")
+	b.WriteString(")\n\n")
+	for _, object := range objects {
+		typ, ok := attachmentTypes[object.Name]
+		if !ok {
+			continue
+		}
+		s, ok := g.objectJSONStruct(object)
+		if !ok || s.base != "" {
+			g.debugf("attachments: skipping %s: not a struct", object.Name)
+			continue
+		}
+		if reason := g.writeAttachment(b, s, typ); reason != "" {
+			g.debugf("attachments: skipping %s: %s", object.Name, reason)
+		}
+	}
+	return g.writeSource("attachments.gen.go", b)
+}
+
+// writeAttachment writes the methods of the struct, or returns why it
+// cannot.
+func (g Generator) writeAttachment(b *bytes.Buffer, s jsonStruct, typ string) string {
+	fields := make(map[string]jsonField)
+	for _, field := range s.fields {
+		if field.expr == nil || field.ptr {
+			continue
+		}
+		fields[field.name] = field
+	}
+	owner, ok := fields["owner_id"]
+	if !ok || g.fieldCodec(owner, nil).goType != "int64" {
+		return "no integer owner_id field"
+	}
+	id, ok := fields["id"]
+	if !ok || g.fieldCodec(id, nil).goType != "int64" {
+		return "no integer id field"
+	}
+	accessKey, hasAccessKey := fields["access_key"]
+	if hasAccessKey && g.fieldCodec(accessKey, nil).goType != "string" {
+		hasAccessKey = false
+	}
+
+	b.WriteString("// Attachment returns the " + typ + " attachment referencing the object.\n")
+	b.WriteString("func (v " + s.name + ") Attachment() runtime.Attachment {\n")
+	b.WriteString("\treturn runtime.Attachment{\n")
+	b.WriteString("\t\tType:    " + strconv.Quote(typ) + ",\n")
+	b.WriteString("\t\tOwnerID: runtime.OwnerID(v." + owner.goName + "),\n")
+	b.WriteString("\t\tID:      v." + id.goName + ",\n")
+	if hasAccessKey {
+		b.WriteString("\t\tAccessKey: v." + accessKey.goName + ",\n")
+	}
+	b.WriteString("\t}\n")
+	b.WriteString("}\n\n")
+
+	b.WriteString("// ParseAttachment sets the object reference from a " + typ + " attachment string.\n")
+	b.WriteString("func (v *" + s.name + ") ParseAttachment(s string) error {\n")
+	b.WriteString("\ta, err := runtime.ParseAttachment(s)\n")
+	b.WriteString("\tif err != nil {\n")
+	b.WriteString("\t\treturn err\n")
+	b.WriteString("\t}\n")
+	b.WriteString("\tif a.Type != " + strconv.Quote(typ) + " {\n")
+	b.WriteString("\t\treturn errors.New(\"attachment: \" + a.Type + \" is not " + typ + "\")\n")
+	b.WriteString("\t}\n")
+	b.WriteString("\tv." + owner.goName + " = int64(a.OwnerID)\n")
+	b.WriteString("\tv." + id.goName + " = a.ID\n")
+	if hasAccessKey {
+		b.WriteString("\tv." + accessKey.goName + " = a.AccessKey\n")
+	}
+	b.WriteString("\treturn nil\n")
+	b.WriteString("}\n\n")
+	return ""
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+var (
+	// readMethodRe matches the methods named like pure reads.
+	readMethodRe = regexp.MustCompile(`^\w+\.(get|search|resolve)([A-Z]|$)`)
+	// volatileMethodRe matches the reads returning one-time data, such as
+	// upload and Long Poll servers, or live state: messages, notifications,
+	// presence, counters and limits.
+	volatileMethodRe = regexp.MustCompile(`UploadServer|UploadURL|LongPoll|ServerUrl|ConfirmationCode|InviteLink|ShortLink|` +
+		`^messages\.|^notifications\.|History|Online|LastActivity|Counters|Flood|ServerTime`)
+)
+
+// cacheableSet classifies the methods by name, with the config overrides
+// applied on top.
+func (g Generator) cacheableSet(names []string) map[string]bool {
+	set := make(map[string]bool)
+	for _, name := range names {
+		if readMethodRe.MatchString(name) && !volatileMethodRe.MatchString(name) {
+			set[name] = true
+		}
+	}
+	g.config.Cache.apply(set)
+	return set
+}
+
+// generateCache emits the classification of the methods safe to cache and
+// the client method enabling the cache.
+func (g Generator) generateCache() error {
+	methodsSchema, err := g.readSchema(schema.MethodsSchema)
+	if err != nil {
+		return err
+	}
+	methods, err := g.parser.ParseMethods(methodsSchema)
+	if err != nil {
+		return err
+	}
+	names := make([]string, len(methods))
+	for i, method := range methods {
+		names[i] = method.Name
+	}
+
+	var cacheable []string
+	for name := range g.cacheableSet(names) {
+		cacheable = append(cacheable, name)
+	}
+	sort.Strings(cacheable)
+
+	b := bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\npackage " + g.pkgName + "\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\t\"time\"\n\n")
+	b.WriteString("\t\"" + runtimePkg + "\"\n")
+	b.WriteString(")\n\n")
+
+	b.WriteString("var cacheableMethods = map[string]bool{\n")
+	for _, name := range cacheable {
+		b.WriteString("\t" + strconv.Quote(name) + ": true,\n")
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString("// Cacheable reports whether the method is a read safe to cache.\n")
+	b.WriteString("func Cacheable(method string) bool {\n")
+	b.WriteString("\treturn cacheableMethods[method]\n")
+	b.WriteString("}\n\n")
+
+	b.WriteString("// EnableCache caches the responses of the Cacheable methods for ttl. A nil\n")
+	b.WriteString("// store is an in-memory LRU of 1000 entries.\n")
+	b.WriteString("func (vk *VK) EnableCache(store runtime.Store, ttl time.Duration) {\n")
+	b.WriteString("\tif store == nil {\n")
+	b.WriteString("\t\tstore = runtime.NewLRU(1000)\n")
+	b.WriteString("\t}\n")
+	b.WriteString("\tcache := &runtime.Cache{\n")
+	b.WriteString("\t\tStore:     store,\n")
+	b.WriteString("\t\tTTL:       ttl,\n")
+	b.WriteString("\t\tCacheable: Cacheable,\n")
+	b.WriteString("\t\tScope:     runtime.TokenScope(vk.AccessToken),\n")
+	b.WriteString("\t}\n")
+	b.WriteString("\tvk.Interceptors = append(vk.Interceptors, cache.Interceptor())\n")
+	b.WriteString("}\n")
+	return g.writeSource("cache.gen.go", b)
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestCacheableSet(t *testing.T) {
+	names := []string{
+		// reads
+		"users.get", "users.getFollowers", "groups.search", "utils.resolveScreenName", "database.getCities",
+		// writes and names only starting like reads
+		"wall.post", "users.report", "apps.getaway", "friends.searchable", "messages.send",
+		// one-time data
+		"photos.getUploadServer", "docs.getMessagesUploadServer", "groups.getLongPollServer",
+		"messages.getLongPollHistory", "streaming.getServerUrl", "groups.getCallbackConfirmationCode",
+		"messages.getInviteLink", "utils.getShortLink",
+		// live state
+		"messages.getHistory", "messages.getById", "messages.search", "notifications.get",
+		"friends.getOnline", "messages.getLastActivity", "account.getCounters", "ads.getFloodStats",
+		"utils.getServerTime", "secure.getSMSHistory",
+	}
+	tests := []struct {
+		name   string
+		config Overrides
+		want   []string
+	}{
+		{
+			name: "schema",
+			want: []string{"database.getCities", "groups.search", "users.get", "users.getFollowers", "utils.resolveScreenName"},
+		},
+		{
+			name:   "overrides",
+			config: Overrides{Include: []string{"messages.getById"}, Exclude: []string{"users.getFollowers"}},
+			want:   []string{"database.getCities", "groups.search", "messages.getById", "users.get", "utils.resolveScreenName"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := Generator{config: Config{Cache: tt.config}}
+			var got []string
+			for name := range g.cacheableSet(names) {
+				got = append(got, name)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("cacheableSet() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateCache(t *testing.T) {
+	method := func(name string) string {
+		return `{"name": "` + name + `", "responses": {}}`
+	}
+	dir := writeSchemaDir(t, "", "", strings.Join([]string{
+		method("users.get"), method("wall.post"), method("messages.getHistory"), method("groups.getById"),
+	}, ","))
+	src := generateFiles(t, dir, Options{
+		Config: Config{Cache: Overrides{Include: []string{"wall.post"}, Exclude: []string{"groups.getById"}}},
+	})["cache.gen.go"]
+	want := "var cacheableMethods = map[string]bool{\n\t\"users.get\": true,\n\t\"wall.post\": true,\n}"
+	if !strings.Contains(src, want) {
+		t.Errorf("cache.gen.go lacks\n%s\nin\n%s", want, src)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+)
+
+// Config holds generator overrides loaded from a JSON file.
+type Config struct {
+	Lenient Overrides `json:"lenient"`
+	// Cache overrides the methods classified as safe to cache.
+	Cache Overrides `json:"cache"`
+}
+
+// Overrides extend or narrow a set the generator derives from the schema.
+// Entries are definition names ("base_bool_int"), definition properties
+// ("wall_wallpost.post_id") or method names ("users.get").
+type Overrides struct {
+	Include []string `json:"include"`
+	Exclude []string `json:"exclude"`
+}
+
+// apply adds the included entries to set and removes the excluded ones.
+func (o Overrides) apply(set map[string]bool) {
+	for _, name := range o.Include {
+		set[name] = true
+	}
+	for _, name := range o.Exclude {
+		delete(set, name)
+	}
+}
+
+// LoadConfig reads the config file. An empty path gives an empty config.
+func LoadConfig(path string) (Config, error) {
+	var cfg Config
+	if path == "" {
+		return cfg, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cfg, err
+	}
+	err = json.Unmarshal(data, &cfg)
+	return cfg, err
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"log"
+	"sort"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+// debugf logs the diagnostics when debugging.
+func (g Generator) debugf(format string, args ...interface{}) {
+	if g.debug {
+		log.Printf(format, args...)
+	}
+}
+
+// debugFallbacks logs the fields and definitions the generator could not
+// type precisely, as stats reports them.
+func (g Generator) debugFallbacks() error {
+	bundle, err := schema.LoadDir(g.schemaDir)
+	if err != nil {
+		return err
+	}
+	cov := newCoverage(g, bundle)
+	counts := make(map[string]int)
+	for _, fb := range cov.fallbacks {
+		counts[fb.Category]++
+		g.debugf("fallback %s: %s", fb.Category, fb.Path)
+	}
+	categories := make([]string, 0, len(counts))
+	for category := range counts {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	for _, category := range categories {
+		g.debugf("fallbacks %s: %d", category, counts[category])
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/cqln/vkgen/schema"
+	"github.com/urfave/cli/v2"
+)
+
+func diffSchemaCmd(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return errors.New("diff: want old and new schema directories")
+	}
+	old, err := schema.LoadDir(c.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	new, err := schema.LoadDir(c.Args().Get(1))
+	if err != nil {
+		return err
+	}
+
+	changes := schema.Diff(old, new)
+	switch c.String("format") {
+	case "text":
+		writeDiffText(os.Stdout, changes)
+	case "json":
+		err = writeDiffJSON(os.Stdout, changes)
+	case "markdown":
+		writeDiffMarkdown(os.Stdout, changes)
+	default:
+		return fmt.Errorf("diff: unknown format %q", c.String("format"))
+	}
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("fail") {
+		for _, change := range changes {
+			if change.Breaking {
+				return cli.Exit("", 1)
+			}
+		}
+	}
+	return nil
+}
+
+func writeDiffText(w io.Writer, changes []schema.Change) {
+	for _, change := range changes {
+		mark := "         "
+		if change.Breaking {
+			mark = "BREAKING "
+		}
+		fmt.Fprintln(w, mark+change.String())
+	}
+}
+
+func writeDiffJSON(w io.Writer, changes []schema.Change) error {
+	if changes == nil {
+		changes = []schema.Change{}
+	}
+	data, err := json.MarshalIndent(changes, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+func writeDiffMarkdown(w io.Writer, changes []schema.Change) {
+	var breaking, compatible []schema.Change
+	for _, change := range changes {
+		if change.Breaking {
+			breaking = append(breaking, change)
+		} else {
+			compatible = append(compatible, change)
+		}
+	}
+
+	section := func(title string, changes []schema.Change) {
+		fmt.Fprintf(w, "## %s (%d)\n\n", title, len(changes))
+		for _, change := range changes {
+			line := fmt.Sprintf("- **%s** `%s`", change.Kind, change.Path)
+			switch {
+			case change.Old != "" && change.New != "":
+				line += fmt.Sprintf(": `%s` → `%s`", change.Old, change.New)
+			case change.Old != "" || change.New != "":
+				line += fmt.Sprintf(": `%s`", change.Old+change.New)
+			}
+			fmt.Fprintln(w, line)
+		}
+		if len(changes) > 0 {
+			fmt.Fprintln(w)
+		}
+	}
+	section("Breaking changes", breaking)
+	section("Non-breaking changes", compatible)
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+// eventType returns the Go type of the event object. Inline objects get
+// their own Event-prefixed type.
+func (g Generator) eventType(event schema.ObjectDefinition) (string, bool) {
+	if event.Expr.IsReference || event.Expr.IsBaseType {
+		return g.objectExprToGolang(event.Expr), false
+	}
+	return g.goify("event_" + event.Name), true
+}
+
+// generateEvents emits the event object types, EventHandler, which
+// dispatches runtime events to typed callbacks, and CallbackHandler
+// serving the Callback API. The events schema file, relative to the schema
+// directory, holds the objects of the events keyed by event type, in the
+// objects.json dialect.
+func (g Generator) generateEvents() error {
+	eventsFile := g.eventsFile
+	if !filepath.IsAbs(eventsFile) {
+		eventsFile = filepath.Join(g.schemaDir, eventsFile)
+	}
+	eventsSchema, err := ioutil.ReadFile(eventsFile)
+	if err != nil {
+		return err
+	}
+	events, err := g.parser.ParseObjects(eventsSchema)
+	if err != nil {
+		return err
+	}
+
+	b := bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\npackage " + g.pkgName + "\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\t\"context\"\n")
+	b.WriteString("\t\"encoding/json\"\n")
+	b.WriteString("\t\"net/http\"\n\n")
+	b.WriteString("\t\"" + runtimePkg + "\"\n")
+	b.WriteString(")\n\n")
+
+	for _, event := range events {
+		if _, inline := g.eventType(event); inline {
+			b.WriteString(g.ObjectDefinitionToGolang(schema.ObjectDefinition{Name: "event_" + event.Name, Expr: event.Expr}))
+			b.WriteString("\n")
+		}
+	}
+
+	b.WriteString("// EventHandler dispatches events to the callbacks set for their types.\n")
+	b.WriteString("// Events without a callback go to Fallback, if set.\n")
+	b.WriteString("type EventHandler struct {\n")
+	for _, event := range events {
+		gtype, _ := g.eventType(event)
+		b.WriteString("\tOn" + g.goify(event.Name) + " func(ctx context.Context, event runtime.Event, obj " + gtype + ") error\n")
+	}
+	b.WriteString("\tFallback func(ctx context.Context, event runtime.Event) error\n")
+	b.WriteString("}\n\n")
+
+	b.WriteString("// Handle decodes the event object and calls the callback of its type.\n")
+	b.WriteString("func (h *EventHandler) Handle(ctx context.Context, event runtime.Event) error {\n")
+	b.WriteString("\tswitch event.Type {\n")
+	for _, event := range events {
+		gtype, _ := g.eventType(event)
+		callback := "h.On" + g.goify(event.Name)
+		b.WriteString("\tcase \"" + event.Name + "\":\n")
+		b.WriteString("\t\tif " + callback + " == nil {\n")
+		b.WriteString("\t\t\tbreak\n")
+		b.WriteString("\t\t}\n")
+		b.WriteString("\t\tvar obj " + gtype + "\n")
+		b.WriteString("\t\tif err := json.Unmarshal(event.Object, &obj); err != nil {\n")
+		b.WriteString("\t\t\treturn err\n")
+		b.WriteString("\t\t}\n")
+		b.WriteString("\t\treturn " + callback + "(ctx, event, obj)\n")
+	}
+	b.WriteString("\t}\n\n")
+	b.WriteString("\tif h.Fallback != nil {\n")
+	b.WriteString("\t\treturn h.Fallback(ctx, event)\n")
+	b.WriteString("\t}\n")
+	b.WriteString("\treturn nil\n")
+	b.WriteString("}\n\n")
+
+	b.WriteString("// CallbackHandler is an http.Handler receiving Callback API events. It\n")
+	b.WriteString("// answers confirmation requests with ConfirmationCode and rejects events\n")
+	b.WriteString("// without the Secret when one is set.\n")
+	b.WriteString("type CallbackHandler struct {\n")
+	b.WriteString("\tEventHandler\n")
+	b.WriteString("\tConfirmationCode string\n")
+	b.WriteString("\tSecret           string\n")
+	b.WriteString("}\n\n")
+	b.WriteString("func (h *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {\n")
+	b.WriteString("\truntime.ServeCallback(w, r, h.ConfirmationCode, h.Secret, h.Handle)\n")
+	b.WriteString("}\n")
+	return g.writeSource("events.gen.go", b)
+}
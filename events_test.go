@@ -0,0 +1,44 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestGenerateEvents(t *testing.T) {
+	dir := writeSchemaDir(t, `"wall_wallpost": {"type": "object", "properties": {"id": {"type": "integer"}}}`, "", "")
+	events := `{"title": "events", "definitions": {
+		"message_new": {"type": "object", "properties": {"message": {"type": "object", "properties": {"text": {"type": "string"}}}}},
+		"wall_post_new": {"$ref": "objects.json#/definitions/wall_wallpost"}
+	}}`
+	if err := ioutil.WriteFile(filepath.Join(dir, "events.json"), []byte(events), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	src, ok := generateFiles(t, dir, Options{Events: "events.json"})["events.gen.go"]
+	if !ok {
+		t.Fatal("no events.gen.go")
+	}
+	src = regexp.MustCompile(` +`).ReplaceAllString(src, " ") // undo gofmt alignment
+	for _, want := range []string{
+		"type EventMessageNew struct {",
+		"OnMessageNew func(ctx context.Context, event runtime.Event, obj EventMessageNew) error",
+		"OnWallPostNew func(ctx context.Context, event runtime.Event, obj WallWallpost) error",
+		"Fallback func(ctx context.Context, event runtime.Event) error",
+		"\tcase \"message_new\":\n\t\tif h.OnMessageNew == nil {\n\t\t\tbreak\n\t\t}\n\t\tvar obj EventMessageNew\n",
+		"\t\treturn h.OnWallPostNew(ctx, event, obj)\n",
+		"\tif h.Fallback != nil {\n\t\treturn h.Fallback(ctx, event)\n\t}",
+		"runtime.ServeCallback(w, r, h.ConfirmationCode, h.Secret, h.Handle)",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("events.gen.go lacks\n%s\nin\n%s", want, src)
+		}
+	}
+
+	if _, ok := generateFiles(t, dir, Options{})["events.gen.go"]; ok {
+		t.Error("events.gen.go generated without an events file")
+	}
+}
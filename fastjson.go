@@ -0,0 +1,685 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+// jsonStruct is a generated struct type that gets its own MarshalJSON and
+// UnmarshalJSON. Types declared as another struct type (type X Y) only
+// delegate to the base type.
+type jsonStruct struct {
+	def    string // schema definition name
+	name   string
+	base   string
+	fields []jsonField
+}
+
+type jsonField struct {
+	name      string
+	goName    string
+	expr      *schema.ObjectExpr // nil for conflicting allOf fields (json.RawMessage)
+	ptr       bool
+	omitempty bool
+}
+
+// jsonCodec describes how a value of a Go type is read and written.
+type jsonCodec struct {
+	kind   string // int64, float64, string, bool, struct, inline, array or any
+	goType string
+	empty  string // omitempty check, %s is the value; empty if never omitted
+	elem   *jsonCodec
+	def    string // schema definition of enum types
+	// fields and their codecs of inline struct types
+	fields []jsonField
+	codecs []jsonCodec
+}
+
+func (g Generator) generateFastJSON() error {
+	objectsSchema, err := g.readSchema(schema.ObjectsSchema)
+	if err != nil {
+		return err
+	}
+	responsesSchema, err := g.readSchema(schema.ResponsesSchema)
+	if err != nil {
+		return err
+	}
+
+	objects, err := g.parser.ParseObjects(objectsSchema)
+	if err != nil {
+		return err
+	}
+	responses, err := g.parser.ParseResponses(responsesSchema)
+	if err != nil {
+		return err
+	}
+
+	lenient := make(lenientSet)
+	if g.lenient {
+		lenient = g.lenientSet(objects, responses)
+	}
+
+	var structs []jsonStruct
+	for _, object := range objects {
+		if s, ok := g.objectJSONStruct(object); ok {
+			structs = append(structs, s)
+		}
+	}
+	responseStructs := make(map[string]bool)
+	for _, response := range responses {
+		if s, ok := g.responseJSONStruct(response); ok {
+			structs = append(structs, s)
+			responseStructs[s.name] = true
+		}
+	}
+
+	// drop delegating types whose base has no generated methods, repeating
+	// until chains of declarations settle
+	methods := make(map[string]bool)
+	for _, s := range structs {
+		if s.base == "" {
+			methods[s.name] = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, s := range structs {
+			if s.base != "" && !methods[s.name] && methods[s.base] {
+				methods[s.name] = true
+				changed = true
+			}
+		}
+	}
+
+	body := bytes.NewBuffer(nil)
+	var benchmarks []jsonStruct
+	for _, s := range structs {
+		if !methods[s.name] {
+			continue
+		}
+		if s.base != "" {
+			g.writeJSONDelegate(body, s)
+			continue
+		}
+		g.writeJSONStruct(body, s, methods, lenient)
+		if responseStructs[s.name] {
+			benchmarks = append(benchmarks, s)
+		}
+	}
+
+	b := bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\npackage " + g.pkgName + "\n\n")
+	b.WriteString("import (\n")
+	// inline allOf structs may declare json.RawMessage fields
+	if bytes.Contains(body.Bytes(), []byte("json.RawMessage")) {
+		b.WriteString("\t\"encoding/json\"\n\n")
+	}
+	b.WriteString("\t\"" + runtimePkg + "\"\n")
+	b.WriteString(")\n\n")
+	b.Write(body.Bytes())
+	if err := g.writeSource("json.gen.go", b); err != nil {
+		return err
+	}
+
+	return g.generateJSONBenchmarks(benchmarks, responses)
+}
+
+func (g Generator) objectJSONStruct(obj schema.ObjectDefinition) (jsonStruct, bool) {
+	expr := obj.Expr
+	switch {
+	case expr.IsBaseType || expr.IsReference:
+		gtype := g.objectExprToGolang(expr)
+		if !expr.IsReference || isBuiltin(gtype) {
+			return jsonStruct{}, false
+		}
+		return jsonStruct{def: obj.Name, name: g.objectTypeName(obj.Name), base: gtype}, true
+	case expr.IsEnum, expr.IsOneOf:
+		return jsonStruct{}, false
+	case expr.IsAllOf:
+		return jsonStruct{def: obj.Name, name: g.goify(obj.Name), fields: g.allofJSONFields(expr)}, true
+	}
+
+	s := jsonStruct{def: obj.Name, name: g.objectTypeName(obj.Name)}
+	for _, prop := range expr.Properties {
+		prop := prop
+		ptr := false
+		if prop.Expr.IsReference {
+			ref, err := prop.Expr.Ref()
+			if err != nil {
+				panic(err)
+			}
+			ptr = obj.Name == ref.Name
+		}
+		s.fields = append(s.fields, jsonField{
+			name:   prop.Name,
+			goName: g.goify(prop.Name),
+			expr:   &prop.Expr,
+			ptr:    ptr,
+		})
+	}
+	return s, true
+}
+
+func (g Generator) responseJSONStruct(resp schema.ResponseDefinition) (jsonStruct, bool) {
+	if _, ok := responseRules[resp.Name]; ok {
+		return jsonStruct{}, false
+	}
+
+	expr := resp.Expr.ObjectExpr
+	switch {
+	case expr.IsBaseType || expr.IsReference:
+		gtype := g.objectExprToGolang(expr)
+		if !expr.IsReference || isBuiltin(gtype) {
+			return jsonStruct{}, false
+		}
+		return jsonStruct{def: resp.Name, name: g.responseTypeName(resp.Name), base: gtype}, true
+	case expr.IsEnum, expr.IsOneOf, expr.IsAllOf:
+		return jsonStruct{}, false
+	}
+
+	requiredFields := make(map[string]struct{})
+	for _, field := range expr.Required {
+		requiredFields[field] = struct{}{}
+	}
+	allFieldsRequired := len(requiredFields) == 0
+
+	s := jsonStruct{def: resp.Name, name: g.responseTypeName(resp.Name)}
+	for _, prop := range expr.Properties {
+		prop := prop
+		_, required := requiredFields[prop.Name]
+		omitempty := !required && !allFieldsRequired
+		ptr := false
+		if prop.Expr.IsReference {
+			ref, err := prop.Expr.Ref()
+			if err != nil {
+				panic(err)
+			}
+			ptr = resp.Name == ref.Name || omitempty
+		}
+		s.fields = append(s.fields, jsonField{
+			name:      prop.Name,
+			goName:    g.goify(prop.Name),
+			expr:      &prop.Expr,
+			ptr:       ptr,
+			omitempty: omitempty,
+		})
+	}
+	return s, true
+}
+
+// allofJSONFields mirrors allofExprToGolang: merged fields sorted by name,
+// conflicting definitions become json.RawMessage.
+func (g Generator) allofJSONFields(expr schema.ObjectExpr) []jsonField {
+	merged := g.allofExtractFields(expr)
+	var keys []string
+	for name := range merged {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+
+	var fields []jsonField
+	for _, name := range keys {
+		exprs := merged[name]
+		field := jsonField{name: name, goName: g.goify(name), expr: &exprs[0]}
+		for i := 1; i < len(exprs); i++ {
+			if isDifferentExprs(exprs[i-1], exprs[i]) {
+				field.expr = nil
+				break
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func (g Generator) jsonCodecOf(expr schema.ObjectExpr, methods map[string]bool) jsonCodec {
+	if expr.IsReference {
+		ref, err := expr.Ref()
+		if err != nil {
+			panic(err)
+		}
+		name := g.goify(ref.Name)
+		switch {
+		case ref.Expr.IsEnum:
+			c := g.jsonCodecOf(schema.ObjectExpr{Type: ref.Expr.Type}, methods)
+			if c.kind != "any" {
+				c.goType = name
+				c.def = ref.Name
+			}
+			return c
+		case ref.Expr.IsBaseType || ref.Expr.IsReference:
+			if isBuiltin(g.objectExprToGolang(ref.Expr)) {
+				// type alias, identical to the underlying type
+				return g.jsonCodecOf(ref.Expr, methods)
+			}
+			if methods[name] {
+				return jsonCodec{kind: "struct", goType: name}
+			}
+			if ref.Expr.ArrayOf != nil {
+				return jsonCodec{kind: "any", goType: name, empty: "len(%s) != 0"}
+			}
+			return jsonCodec{kind: "any", goType: name}
+		case methods[name]:
+			return jsonCodec{kind: "struct", goType: name}
+		}
+		return jsonCodec{kind: "any", goType: name}
+	}
+
+	if expr.IsAllOf {
+		return g.inlineCodec(g.objectExprToGolang(expr), g.allofJSONFields(expr), methods)
+	}
+
+	switch expr.Type {
+	case "integer":
+		return jsonCodec{kind: "int64", goType: "int64", empty: "%s != 0"}
+	case "number":
+		return jsonCodec{kind: "float64", goType: "float64", empty: "%s != 0"}
+	case "string":
+		return jsonCodec{kind: "string", goType: "string", empty: "%s != \"\""}
+	case "boolean":
+		return jsonCodec{kind: "bool", goType: "bool", empty: "%s"}
+	case "array":
+		elem := g.jsonCodecOf(*expr.ArrayOf, methods)
+		return jsonCodec{kind: "array", goType: g.objectExprToGolang(expr), empty: "len(%s) != 0", elem: &elem}
+	}
+
+	gtype := g.objectExprToGolang(expr)
+	if gtype == "interface{}" {
+		return jsonCodec{kind: "any", goType: gtype, empty: "%s != nil"}
+	}
+	if expr.Type == "object" && len(expr.Properties) > 0 {
+		var fields []jsonField
+		for _, prop := range expr.Properties {
+			prop := prop
+			fields = append(fields, jsonField{name: prop.Name, goName: g.goify(prop.Name), expr: &prop.Expr})
+		}
+		return g.inlineCodec(gtype, fields, methods)
+	}
+	return jsonCodec{kind: "any", goType: gtype}
+}
+
+// inlineCodec reads and writes the fields of an anonymous struct type in
+// place.
+func (g Generator) inlineCodec(goType string, fields []jsonField, methods map[string]bool) jsonCodec {
+	c := jsonCodec{kind: "inline", goType: goType, fields: fields}
+	for _, field := range fields {
+		c.codecs = append(c.codecs, g.fieldCodec(field, methods))
+	}
+	return c
+}
+
+func (g Generator) fieldCodec(field jsonField, methods map[string]bool) jsonCodec {
+	if field.expr == nil {
+		return jsonCodec{kind: "any", goType: "json.RawMessage", empty: "len(%s) != 0"}
+	}
+	return g.jsonCodecOf(*field.expr, methods)
+}
+
+func (g Generator) writeJSONDelegate(b *bytes.Buffer, s jsonStruct) {
+	b.WriteString("func (v " + s.name + ") MarshalJSON() ([]byte, error) {\n")
+	b.WriteString("\treturn " + s.base + "(v).MarshalJSON()\n")
+	b.WriteString("}\n\n")
+	b.WriteString("func (v *" + s.name + ") UnmarshalJSON(data []byte) error {\n")
+	b.WriteString("\treturn (*" + s.base + ")(v).UnmarshalJSON(data)\n")
+	b.WriteString("}\n\n")
+	b.WriteString("func (v " + s.name + ") encodeJSON(w *runtime.Writer) {\n")
+	b.WriteString("\t" + s.base + "(v).encodeJSON(w)\n")
+	b.WriteString("}\n\n")
+	b.WriteString("func (v *" + s.name + ") decodeJSON(l *runtime.Lexer) {\n")
+	b.WriteString("\t(*" + s.base + ")(v).decodeJSON(l)\n")
+	b.WriteString("}\n\n")
+}
+
+func (g Generator) writeJSONStruct(b *bytes.Buffer, s jsonStruct, methods map[string]bool, lenient lenientSet) {
+	b.WriteString("func (v " + s.name + ") MarshalJSON() ([]byte, error) {\n")
+	b.WriteString("\tw := runtime.NewWriter()\n")
+	b.WriteString("\tv.encodeJSON(w)\n")
+	b.WriteString("\treturn w.Bytes()\n")
+	b.WriteString("}\n\n")
+	b.WriteString("func (v *" + s.name + ") UnmarshalJSON(data []byte) error {\n")
+	b.WriteString("\tl := runtime.Lexer{Data: data}\n")
+	b.WriteString("\tv.decodeJSON(&l)\n")
+	b.WriteString("\tl.Consumed()\n")
+	b.WriteString("\treturn l.Error()\n")
+	b.WriteString("}\n\n")
+
+	// encoder
+	b.WriteString("func (v " + s.name + ") encodeJSON(w *runtime.Writer) {\n")
+	if len(s.fields) == 0 {
+		b.WriteString("\tw.RawString(\"{}\")\n")
+	} else {
+		dynamic := false
+		for _, field := range s.fields {
+			dynamic = dynamic || field.omitempty
+		}
+		if dynamic {
+			b.WriteString("\tfirst := true\n")
+			b.WriteString("\tw.RawByte('{')\n")
+		}
+		for i, field := range s.fields {
+			c := g.fieldCodec(field, methods)
+			src := "v." + field.goName
+			key := `"` + field.name + `":`
+			indent := "\t"
+			if field.omitempty {
+				cond := ""
+				if field.ptr {
+					cond = src + " != nil"
+				} else if c.empty != "" {
+					cond = fmt.Sprintf(c.empty, src)
+				}
+				if cond != "" {
+					b.WriteString("\tif " + cond + " {\n")
+					indent = "\t\t"
+				}
+			}
+			switch {
+			case dynamic:
+				b.WriteString(indent + "w.Field(&first, " + strconv.Quote(key) + ")\n")
+			case i == 0:
+				b.WriteString(indent + "w.RawString(" + strconv.Quote("{"+key) + ")\n")
+			default:
+				b.WriteString(indent + "w.RawString(" + strconv.Quote(","+key) + ")\n")
+			}
+			if field.ptr && c.kind != "any" {
+				b.WriteString(indent + "if " + src + " == nil {\n")
+				b.WriteString(indent + "\tw.Null()\n")
+				b.WriteString(indent + "} else {\n")
+				if c.kind == "struct" {
+					writeEncode(b, indent+"\t", c, src, 0)
+				} else {
+					writeEncode(b, indent+"\t", c, "*"+src, 0)
+				}
+				b.WriteString(indent + "}\n")
+			} else {
+				writeEncode(b, indent, c, src, 0)
+			}
+			if indent != "\t" {
+				b.WriteString("\t}\n")
+			}
+		}
+		b.WriteString("\tw.RawByte('}')\n")
+	}
+	b.WriteString("}\n\n")
+
+	// decoder
+	b.WriteString("func (v *" + s.name + ") decodeJSON(l *runtime.Lexer) {\n")
+	if lenient.acceptsEmptyArray(s) {
+		b.WriteString("\tif l.IsNull() || l.EmptyArray() {\n")
+	} else {
+		b.WriteString("\tif l.IsNull() {\n")
+	}
+	b.WriteString("\t\treturn\n")
+	b.WriteString("\t}\n")
+	b.WriteString("\tl.Delim('{')\n")
+	b.WriteString("\tfor !l.IsDelim('}') {\n")
+	b.WriteString("\t\tswitch string(l.Key()) {\n")
+	for _, field := range s.fields {
+		c := g.fieldCodec(field, methods)
+		dst := "v." + field.goName
+		b.WriteString("\t\tcase " + strconv.Quote(field.name) + ":\n")
+		if lenient[s.def+"."+field.name] {
+			writeLenientDecode(b, c, field, dst, lenient)
+			continue
+		}
+		switch {
+		case field.ptr && c.kind == "any":
+			b.WriteString("\t\t\tl.Unmarshal(&" + dst + ")\n")
+		case field.ptr:
+			b.WriteString("\t\t\tif l.IsNull() {\n")
+			b.WriteString("\t\t\t\t" + dst + " = nil\n")
+			b.WriteString("\t\t\t} else {\n")
+			b.WriteString("\t\t\t\tvar val " + c.goType + "\n")
+			writeDecode(b, "\t\t\t\t", c, "val", 0, lenient)
+			b.WriteString("\t\t\t\t" + dst + " = &val\n")
+			b.WriteString("\t\t\t}\n")
+		default:
+			writeDecode(b, "\t\t\t", c, dst, 0, lenient)
+		}
+	}
+	b.WriteString("\t\tdefault:\n")
+	b.WriteString("\t\t\tl.Skip()\n")
+	b.WriteString("\t\t}\n")
+	b.WriteString("\t\tl.WantComma()\n")
+	b.WriteString("\t}\n")
+	b.WriteString("\tl.Delim('}')\n")
+	b.WriteString("}\n\n")
+}
+
+func writeEncode(b *bytes.Buffer, indent string, c jsonCodec, src string, depth int) {
+	switch c.kind {
+	case "int64", "float64", "string", "bool":
+		fn := map[string]string{"int64": "Int64", "float64": "Float64", "string": "String", "bool": "Bool"}[c.kind]
+		if c.goType != c.kind {
+			src = c.kind + "(" + src + ")"
+		}
+		b.WriteString(indent + "w." + fn + "(" + src + ")\n")
+	case "struct":
+		b.WriteString(indent + src + ".encodeJSON(w)\n")
+	case "inline":
+		if len(c.fields) == 0 {
+			b.WriteString(indent + "w.RawString(\"{}\")\n")
+			break
+		}
+		for i, field := range c.fields {
+			key := `"` + field.name + `":`
+			if i == 0 {
+				key = "{" + key
+			} else {
+				key = "," + key
+			}
+			b.WriteString(indent + "w.RawString(" + strconv.Quote(key) + ")\n")
+			writeEncode(b, indent, c.codecs[i], src+"."+field.goName, depth)
+		}
+		b.WriteString(indent + "w.RawByte('}')\n")
+	case "array":
+		i := "i" + strconv.Itoa(depth)
+		item := "item" + strconv.Itoa(depth)
+		b.WriteString(indent + "if " + src + " == nil {\n")
+		b.WriteString(indent + "\tw.Null()\n")
+		b.WriteString(indent + "} else {\n")
+		b.WriteString(indent + "\tw.RawByte('[')\n")
+		b.WriteString(indent + "\tfor " + i + ", " + item + " := range " + src + " {\n")
+		b.WriteString(indent + "\t\tif " + i + " > 0 {\n")
+		b.WriteString(indent + "\t\t\tw.RawByte(',')\n")
+		b.WriteString(indent + "\t\t}\n")
+		writeEncode(b, indent+"\t\t", *c.elem, item, depth+1)
+		b.WriteString(indent + "\t}\n")
+		b.WriteString(indent + "\tw.RawByte(']')\n")
+		b.WriteString(indent + "}\n")
+	default:
+		b.WriteString(indent + "w.Marshal(" + src + ")\n")
+	}
+}
+
+func writeDecode(b *bytes.Buffer, indent string, c jsonCodec, dst string, depth int, lenient lenientSet) {
+	switch c.kind {
+	case "int64", "float64", "string", "bool":
+		fn := map[string]string{"int64": "Int64", "float64": "Float64", "string": "String", "bool": "Bool"}[c.kind]
+		if lenient[c.def] && (c.kind == "int64" || c.kind == "bool") {
+			fn = map[string]string{"int64": "FlexInt64", "bool": "FlexBool"}[c.kind]
+		}
+		val := "l." + fn + "()"
+		if c.goType != c.kind {
+			val = c.goType + "(" + val + ")"
+		}
+		b.WriteString(indent + dst + " = " + val + "\n")
+	case "struct":
+		b.WriteString(indent + dst + ".decodeJSON(l)\n")
+	case "inline":
+		b.WriteString(indent + "if !l.IsNull() {\n")
+		b.WriteString(indent + "\tl.Delim('{')\n")
+		b.WriteString(indent + "\tfor !l.IsDelim('}') {\n")
+		b.WriteString(indent + "\t\tswitch string(l.Key()) {\n")
+		for i, field := range c.fields {
+			b.WriteString(indent + "\t\tcase " + strconv.Quote(field.name) + ":\n")
+			writeDecode(b, indent+"\t\t\t", c.codecs[i], dst+"."+field.goName, depth, lenient)
+		}
+		b.WriteString(indent + "\t\tdefault:\n")
+		b.WriteString(indent + "\t\t\tl.Skip()\n")
+		b.WriteString(indent + "\t\t}\n")
+		b.WriteString(indent + "\t\tl.WantComma()\n")
+		b.WriteString(indent + "\t}\n")
+		b.WriteString(indent + "\tl.Delim('}')\n")
+		b.WriteString(indent + "}\n")
+	case "array":
+		item := "item" + strconv.Itoa(depth)
+		b.WriteString(indent + "if l.IsNull() {\n")
+		b.WriteString(indent + "\t" + dst + " = nil\n")
+		b.WriteString(indent + "} else {\n")
+		b.WriteString(indent + "\tl.Delim('[')\n")
+		b.WriteString(indent + "\t" + dst + " = " + dst + "[:0]\n")
+		b.WriteString(indent + "\tfor !l.IsDelim(']') {\n")
+		b.WriteString(indent + "\t\tvar " + item + " " + c.elem.goType + "\n")
+		writeDecode(b, indent+"\t\t", *c.elem, item, depth+1, lenient)
+		b.WriteString(indent + "\t\t" + dst + " = append(" + dst + ", " + item + ")\n")
+		b.WriteString(indent + "\t\tl.WantComma()\n")
+		b.WriteString(indent + "\t}\n")
+		b.WriteString(indent + "\tl.Delim(']')\n")
+		b.WriteString(indent + "\tif " + dst + " == nil {\n")
+		b.WriteString(indent + "\t\t" + dst + " = " + c.goType + "{}\n")
+		b.WriteString(indent + "\t}\n")
+		b.WriteString(indent + "}\n")
+	default:
+		b.WriteString(indent + "l.Unmarshal(&" + dst + ")\n")
+	}
+}
+
+// generateJSONBenchmarks emits benchmarks comparing the generated
+// (un)marshallers of every response with the reflection-based path of
+// encoding/json.
+func (g Generator) generateJSONBenchmarks(structs []jsonStruct, responses []schema.ResponseDefinition) error {
+	exprs := make(map[string]schema.ObjectExpr)
+	for _, response := range responses {
+		exprs[g.responseTypeName(response.Name)] = response.Expr.ObjectExpr
+	}
+
+	b := bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\npackage " + g.pkgName + "\n\n")
+	b.WriteString("import (\n\t\"encoding/json\"\n\t\"reflect\"\n\t\"testing\"\n)\n\n")
+	for _, s := range structs {
+		sample, _ := g.sampleValue(exprs[s.name], 0)
+		data, err := json.Marshal(sample)
+		if err != nil {
+			return err
+		}
+		b.WriteString("func Benchmark" + strings.TrimSuffix(s.name, "Response") + "JSON(b *testing.B) {\n")
+		b.WriteString("\tbenchmarkJSON(b, []byte(" + strconv.Quote(string(data)) + "),\n")
+		b.WriteString("\t\tfunc() interface{} { return new(" + s.name + ") })\n")
+		b.WriteString("}\n\n")
+	}
+	b.WriteString(benchmarkHelpers)
+	return g.writeSource("json_bench.gen_test.go", b)
+}
+
+const benchmarkHelpers = `// benchmarkJSON compares the generated methods with encoding/json decoding
+// a copy of the type that has no methods at any depth.
+func benchmarkJSON(b *testing.B, data []byte, generated func() interface{}) {
+	typ := reflect.TypeOf(generated()).Elem()
+	typ = reflectOnly(typ, typ.PkgPath(), make(map[reflect.Type]bool))
+	reflected := func() interface{} { return reflect.New(typ).Interface() }
+
+	b.Run("unmarshal/reflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := json.Unmarshal(data, reflected()); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("unmarshal/generated", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if err := generated().(json.Unmarshaler).UnmarshalJSON(data); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("marshal/reflect", func(b *testing.B) {
+		v := reflected()
+		if err := json.Unmarshal(data, v); err != nil {
+			b.Fatal(err)
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := json.Marshal(v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("marshal/generated", func(b *testing.B) {
+		v := generated()
+		if err := json.Unmarshal(data, v); err != nil {
+			b.Fatal(err)
+		}
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if _, err := v.(json.Marshaler).MarshalJSON(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// reflectOnly returns the layout of t built from unnamed types, which have
+// no methods. Recursive types are cut to interface{}, the types of other
+// packages such as json.RawMessage are kept.
+func reflectOnly(t reflect.Type, pkg string, seen map[reflect.Type]bool) reflect.Type {
+	if t.PkgPath() != "" && t.PkgPath() != pkg {
+		return t
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return reflect.PtrTo(reflectOnly(t.Elem(), pkg, seen))
+	case reflect.Slice:
+		return reflect.SliceOf(reflectOnly(t.Elem(), pkg, seen))
+	case reflect.Array:
+		return reflect.ArrayOf(t.Len(), reflectOnly(t.Elem(), pkg, seen))
+	case reflect.Map:
+		return reflect.MapOf(reflectOnly(t.Key(), pkg, seen), reflectOnly(t.Elem(), pkg, seen))
+	case reflect.Interface:
+		return t
+	case reflect.Struct:
+		if seen[t] {
+			return reflect.TypeOf((*interface{})(nil)).Elem()
+		}
+		seen[t] = true
+		defer delete(seen, t)
+		var fields []reflect.StructField
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			// the generated embedded fields are tagged, so they are
+			// plain fields to encoding/json
+			f.Type = reflectOnly(f.Type, pkg, seen)
+			f.Anonymous = false
+			f.Index = nil
+			fields = append(fields, f)
+		}
+		return reflect.StructOf(fields)
+	}
+	if basic, ok := basicTypes[t.Kind()]; ok {
+		return basic
+	}
+	return t
+}
+
+var basicTypes = map[reflect.Kind]reflect.Type{
+	reflect.Bool:    reflect.TypeOf(false),
+	reflect.Int:     reflect.TypeOf(int(0)),
+	reflect.Int64:   reflect.TypeOf(int64(0)),
+	reflect.Uint8:   reflect.TypeOf(uint8(0)),
+	reflect.Float64: reflect.TypeOf(float64(0)),
+	reflect.String:  reflect.TypeOf(""),
+}
+`
@@ -0,0 +1,328 @@
+// Code generated by vkgen; DO NOT EDIT.
+
+package generated
+
+import (
+	"errors"
+
+	"github.com/cqln/vkgen/runtime"
+)
+
+// Attachment returns the doc attachment referencing the object.
+func (v DocsDoc) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:      "doc",
+		OwnerID:   runtime.OwnerID(v.OwnerID),
+		ID:        v.ID,
+		AccessKey: v.AccessKey,
+	}
+}
+
+// ParseAttachment sets the object reference from a doc attachment string.
+func (v *DocsDoc) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "doc" {
+		return errors.New("attachment: " + a.Type + " is not doc")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	v.AccessKey = a.AccessKey
+	return nil
+}
+
+// Attachment returns the market_album attachment referencing the object.
+func (v MarketMarketAlbum) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:    "market_album",
+		OwnerID: runtime.OwnerID(v.OwnerID),
+		ID:      v.ID,
+	}
+}
+
+// ParseAttachment sets the object reference from a market_album attachment string.
+func (v *MarketMarketAlbum) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "market_album" {
+		return errors.New("attachment: " + a.Type + " is not market_album")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	return nil
+}
+
+// Attachment returns the market attachment referencing the object.
+func (v MarketMarketItem) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:      "market",
+		OwnerID:   runtime.OwnerID(v.OwnerID),
+		ID:        v.ID,
+		AccessKey: v.AccessKey,
+	}
+}
+
+// ParseAttachment sets the object reference from a market attachment string.
+func (v *MarketMarketItem) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "market" {
+		return errors.New("attachment: " + a.Type + " is not market")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	v.AccessKey = a.AccessKey
+	return nil
+}
+
+// Attachment returns the market attachment referencing the object.
+func (v MarketMarketItemFull) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:      "market",
+		OwnerID:   runtime.OwnerID(v.OwnerID),
+		ID:        v.ID,
+		AccessKey: v.AccessKey,
+	}
+}
+
+// ParseAttachment sets the object reference from a market attachment string.
+func (v *MarketMarketItemFull) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "market" {
+		return errors.New("attachment: " + a.Type + " is not market")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	v.AccessKey = a.AccessKey
+	return nil
+}
+
+// Attachment returns the photo attachment referencing the object.
+func (v PhotosPhoto) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:      "photo",
+		OwnerID:   runtime.OwnerID(v.OwnerID),
+		ID:        v.ID,
+		AccessKey: v.AccessKey,
+	}
+}
+
+// ParseAttachment sets the object reference from a photo attachment string.
+func (v *PhotosPhoto) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "photo" {
+		return errors.New("attachment: " + a.Type + " is not photo")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	v.AccessKey = a.AccessKey
+	return nil
+}
+
+// Attachment returns the album attachment referencing the object.
+func (v PhotosPhotoAlbum) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:    "album",
+		OwnerID: runtime.OwnerID(v.OwnerID),
+		ID:      v.ID,
+	}
+}
+
+// ParseAttachment sets the object reference from a album attachment string.
+func (v *PhotosPhotoAlbum) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "album" {
+		return errors.New("attachment: " + a.Type + " is not album")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	return nil
+}
+
+// Attachment returns the photo attachment referencing the object.
+func (v PhotosPhotoFull) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:      "photo",
+		OwnerID:   runtime.OwnerID(v.OwnerID),
+		ID:        v.ID,
+		AccessKey: v.AccessKey,
+	}
+}
+
+// ParseAttachment sets the object reference from a photo attachment string.
+func (v *PhotosPhotoFull) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "photo" {
+		return errors.New("attachment: " + a.Type + " is not photo")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	v.AccessKey = a.AccessKey
+	return nil
+}
+
+// Attachment returns the poll attachment referencing the object.
+func (v PollsPoll) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:    "poll",
+		OwnerID: runtime.OwnerID(v.OwnerID),
+		ID:      v.ID,
+	}
+}
+
+// ParseAttachment sets the object reference from a poll attachment string.
+func (v *PollsPoll) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "poll" {
+		return errors.New("attachment: " + a.Type + " is not poll")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	return nil
+}
+
+// Attachment returns the story attachment referencing the object.
+func (v StoriesStory) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:      "story",
+		OwnerID:   runtime.OwnerID(v.OwnerID),
+		ID:        v.ID,
+		AccessKey: v.AccessKey,
+	}
+}
+
+// ParseAttachment sets the object reference from a story attachment string.
+func (v *StoriesStory) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "story" {
+		return errors.New("attachment: " + a.Type + " is not story")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	v.AccessKey = a.AccessKey
+	return nil
+}
+
+// Attachment returns the video attachment referencing the object.
+func (v VideoVideo) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:      "video",
+		OwnerID:   runtime.OwnerID(v.OwnerID),
+		ID:        v.ID,
+		AccessKey: v.AccessKey,
+	}
+}
+
+// ParseAttachment sets the object reference from a video attachment string.
+func (v *VideoVideo) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "video" {
+		return errors.New("attachment: " + a.Type + " is not video")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	v.AccessKey = a.AccessKey
+	return nil
+}
+
+// Attachment returns the video attachment referencing the object.
+func (v VideoVideoFull) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:      "video",
+		OwnerID:   runtime.OwnerID(v.OwnerID),
+		ID:        v.ID,
+		AccessKey: v.AccessKey,
+	}
+}
+
+// ParseAttachment sets the object reference from a video attachment string.
+func (v *VideoVideoFull) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "video" {
+		return errors.New("attachment: " + a.Type + " is not video")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	v.AccessKey = a.AccessKey
+	return nil
+}
+
+// Attachment returns the wall attachment referencing the object.
+func (v WallWallpost) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:      "wall",
+		OwnerID:   runtime.OwnerID(v.OwnerID),
+		ID:        v.ID,
+		AccessKey: v.AccessKey,
+	}
+}
+
+// ParseAttachment sets the object reference from a wall attachment string.
+func (v *WallWallpost) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "wall" {
+		return errors.New("attachment: " + a.Type + " is not wall")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	v.AccessKey = a.AccessKey
+	return nil
+}
+
+// Attachment returns the wall attachment referencing the object.
+func (v WallWallpostFull) Attachment() runtime.Attachment {
+	return runtime.Attachment{
+		Type:      "wall",
+		OwnerID:   runtime.OwnerID(v.OwnerID),
+		ID:        v.ID,
+		AccessKey: v.AccessKey,
+	}
+}
+
+// ParseAttachment sets the object reference from a wall attachment string.
+func (v *WallWallpostFull) ParseAttachment(s string) error {
+	a, err := runtime.ParseAttachment(s)
+	if err != nil {
+		return err
+	}
+	if a.Type != "wall" {
+		return errors.New("attachment: " + a.Type + " is not wall")
+	}
+	v.OwnerID = int64(a.OwnerID)
+	v.ID = a.ID
+	v.AccessKey = a.AccessKey
+	return nil
+}
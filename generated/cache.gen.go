@@ -0,0 +1,189 @@
+// Code generated by vkgen; DO NOT EDIT.
+
+package generated
+
+import (
+	"time"
+
+	"github.com/cqln/vkgen/runtime"
+)
+
+var cacheableMethods = map[string]bool{
+	"account.getActiveOffers":        true,
+	"account.getAppPermissions":      true,
+	"account.getBanned":              true,
+	"account.getInfo":                true,
+	"account.getProfileInfo":         true,
+	"account.getPushSettings":        true,
+	"ads.getAccounts":                true,
+	"ads.getAds":                     true,
+	"ads.getAdsLayout":               true,
+	"ads.getAdsTargeting":            true,
+	"ads.getBudget":                  true,
+	"ads.getCampaigns":               true,
+	"ads.getCategories":              true,
+	"ads.getClients":                 true,
+	"ads.getDemographics":            true,
+	"ads.getLookalikeRequests":       true,
+	"ads.getMusicians":               true,
+	"ads.getOfficeUsers":             true,
+	"ads.getPostsReach":              true,
+	"ads.getRejectionReason":         true,
+	"ads.getStatistics":              true,
+	"ads.getSuggestions":             true,
+	"ads.getTargetGroups":            true,
+	"ads.getTargetingStats":          true,
+	"apps.get":                       true,
+	"apps.getCatalog":                true,
+	"apps.getFriendsList":            true,
+	"apps.getLeaderboard":            true,
+	"apps.getScopes":                 true,
+	"apps.getScore":                  true,
+	"board.getComments":              true,
+	"board.getTopics":                true,
+	"database.getChairs":             true,
+	"database.getCities":             true,
+	"database.getCitiesById":         true,
+	"database.getCountries":          true,
+	"database.getCountriesById":      true,
+	"database.getFaculties":          true,
+	"database.getMetroStations":      true,
+	"database.getMetroStationsById":  true,
+	"database.getRegions":            true,
+	"database.getSchoolClasses":      true,
+	"database.getSchools":            true,
+	"database.getUniversities":       true,
+	"docs.get":                       true,
+	"docs.getById":                   true,
+	"docs.getTypes":                  true,
+	"docs.search":                    true,
+	"downloadedGames.getPaidStatus":  true,
+	"fave.get":                       true,
+	"fave.getPages":                  true,
+	"fave.getTags":                   true,
+	"friends.get":                    true,
+	"friends.getAppUsers":            true,
+	"friends.getByPhones":            true,
+	"friends.getLists":               true,
+	"friends.getMutual":              true,
+	"friends.getRecent":              true,
+	"friends.getRequests":            true,
+	"friends.getSuggestions":         true,
+	"friends.search":                 true,
+	"gifts.get":                      true,
+	"groups.get":                     true,
+	"groups.getAddresses":            true,
+	"groups.getBanned":               true,
+	"groups.getById":                 true,
+	"groups.getCallbackServers":      true,
+	"groups.getCallbackSettings":     true,
+	"groups.getCatalog":              true,
+	"groups.getCatalogInfo":          true,
+	"groups.getInvitedUsers":         true,
+	"groups.getInvites":              true,
+	"groups.getMembers":              true,
+	"groups.getRequests":             true,
+	"groups.getSettings":             true,
+	"groups.getTokenPermissions":     true,
+	"groups.search":                  true,
+	"leads.getStats":                 true,
+	"leads.getUsers":                 true,
+	"likes.getList":                  true,
+	"market.get":                     true,
+	"market.getAlbumById":            true,
+	"market.getAlbums":               true,
+	"market.getById":                 true,
+	"market.getCategories":           true,
+	"market.getComments":             true,
+	"market.search":                  true,
+	"newsfeed.get":                   true,
+	"newsfeed.getBanned":             true,
+	"newsfeed.getComments":           true,
+	"newsfeed.getLists":              true,
+	"newsfeed.getMentions":           true,
+	"newsfeed.getRecommended":        true,
+	"newsfeed.getSuggestedSources":   true,
+	"newsfeed.search":                true,
+	"notes.get":                      true,
+	"notes.getById":                  true,
+	"notes.getComments":              true,
+	"orders.get":                     true,
+	"orders.getAmount":               true,
+	"orders.getById":                 true,
+	"orders.getUserSubscriptionById": true,
+	"orders.getUserSubscriptions":    true,
+	"pages.get":                      true,
+	"pages.getTitles":                true,
+	"pages.getVersion":               true,
+	"photos.get":                     true,
+	"photos.getAlbums":               true,
+	"photos.getAlbumsCount":          true,
+	"photos.getAll":                  true,
+	"photos.getAllComments":          true,
+	"photos.getById":                 true,
+	"photos.getComments":             true,
+	"photos.getNewTags":              true,
+	"photos.getTags":                 true,
+	"photos.getUserPhotos":           true,
+	"photos.search":                  true,
+	"polls.getById":                  true,
+	"polls.getVoters":                true,
+	"prettyCards.get":                true,
+	"prettyCards.getById":            true,
+	"search.getHints":                true,
+	"secure.getAppBalance":           true,
+	"secure.getUserLevel":            true,
+	"stats.get":                      true,
+	"stats.getPostReach":             true,
+	"status.get":                     true,
+	"storage.get":                    true,
+	"storage.getKeys":                true,
+	"stories.get":                    true,
+	"stories.getBanned":              true,
+	"stories.getById":                true,
+	"stories.getReplies":             true,
+	"stories.getStats":               true,
+	"stories.getViewers":             true,
+	"stories.search":                 true,
+	"users.get":                      true,
+	"users.getFollowers":             true,
+	"users.getSubscriptions":         true,
+	"users.search":                   true,
+	"utils.getLastShortenedLinks":    true,
+	"utils.getLinkStats":             true,
+	"utils.resolveScreenName":        true,
+	"video.get":                      true,
+	"video.getAlbumById":             true,
+	"video.getAlbums":                true,
+	"video.getAlbumsByVideo":         true,
+	"video.getComments":              true,
+	"video.search":                   true,
+	"wall.get":                       true,
+	"wall.getById":                   true,
+	"wall.getComment":                true,
+	"wall.getComments":               true,
+	"wall.getReposts":                true,
+	"wall.search":                    true,
+	"widgets.getComments":            true,
+	"widgets.getPages":               true,
+}
+
+// Cacheable reports whether the method is a read safe to cache.
+func Cacheable(method string) bool {
+	return cacheableMethods[method]
+}
+
+// EnableCache caches the responses of the Cacheable methods for ttl. A nil
+// store is an in-memory LRU of 1000 entries.
+func (vk *VK) EnableCache(store runtime.Store, ttl time.Duration) {
+	if store == nil {
+		store = runtime.NewLRU(1000)
+	}
+	cache := &runtime.Cache{
+		Store:     store,
+		TTL:       ttl,
+		Cacheable: Cacheable,
+		Scope:     runtime.TokenScope(vk.AccessToken),
+	}
+	vk.Interceptors = append(vk.Interceptors, cache.Interceptor())
+}
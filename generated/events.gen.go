@@ -0,0 +1,413 @@
+// Code generated by vkgen; DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/cqln/vkgen/runtime"
+)
+
+// New message, with the features of the client it was sent from
+type EventMessageNew struct {
+	Message    MessagesMessage `json:"message"`
+	ClientInfo struct {
+		ButtonActions  []string `json:"button_actions"`
+		Keyboard       bool     `json:"keyboard"`
+		InlineKeyboard bool     `json:"inline_keyboard"`
+		Carousel       bool     `json:"carousel"`
+		LangID         int64    `json:"lang_id"`
+	} `json:"client_info"`
+}
+
+// EventHandler dispatches events to the callbacks set for their types.
+// Events without a callback go to Fallback, if set.
+type EventHandler struct {
+	OnMessageNew           func(ctx context.Context, event runtime.Event, obj EventMessageNew) error
+	OnMessageReply         func(ctx context.Context, event runtime.Event, obj MessagesMessage) error
+	OnMessageEdit          func(ctx context.Context, event runtime.Event, obj MessagesMessage) error
+	OnMessageAllow         func(ctx context.Context, event runtime.Event, obj CallbackMessageAllow) error
+	OnMessageDeny          func(ctx context.Context, event runtime.Event, obj CallbackMessageDeny) error
+	OnPhotoNew             func(ctx context.Context, event runtime.Event, obj PhotosPhoto) error
+	OnPhotoCommentNew      func(ctx context.Context, event runtime.Event, obj WallWallComment) error
+	OnPhotoCommentEdit     func(ctx context.Context, event runtime.Event, obj WallWallComment) error
+	OnPhotoCommentRestore  func(ctx context.Context, event runtime.Event, obj WallWallComment) error
+	OnPhotoCommentDelete   func(ctx context.Context, event runtime.Event, obj CallbackPhotoCommentDelete) error
+	OnVideoNew             func(ctx context.Context, event runtime.Event, obj VideoVideo) error
+	OnVideoCommentNew      func(ctx context.Context, event runtime.Event, obj WallWallComment) error
+	OnVideoCommentEdit     func(ctx context.Context, event runtime.Event, obj WallWallComment) error
+	OnVideoCommentRestore  func(ctx context.Context, event runtime.Event, obj WallWallComment) error
+	OnVideoCommentDelete   func(ctx context.Context, event runtime.Event, obj CallbackVideoCommentDelete) error
+	OnWallPostNew          func(ctx context.Context, event runtime.Event, obj WallWallpostFull) error
+	OnWallRepost           func(ctx context.Context, event runtime.Event, obj WallWallpostFull) error
+	OnWallReplyNew         func(ctx context.Context, event runtime.Event, obj WallWallComment) error
+	OnWallReplyEdit        func(ctx context.Context, event runtime.Event, obj WallWallComment) error
+	OnWallReplyRestore     func(ctx context.Context, event runtime.Event, obj WallWallComment) error
+	OnWallReplyDelete      func(ctx context.Context, event runtime.Event, obj CallbackWallCommentDelete) error
+	OnBoardPostDelete      func(ctx context.Context, event runtime.Event, obj CallbackBoardPostDelete) error
+	OnMarketCommentNew     func(ctx context.Context, event runtime.Event, obj CallbackMarketComment) error
+	OnMarketCommentEdit    func(ctx context.Context, event runtime.Event, obj CallbackMarketComment) error
+	OnMarketCommentRestore func(ctx context.Context, event runtime.Event, obj CallbackMarketComment) error
+	OnMarketCommentDelete  func(ctx context.Context, event runtime.Event, obj CallbackMarketCommentDelete) error
+	OnGroupLeave           func(ctx context.Context, event runtime.Event, obj CallbackGroupLeave) error
+	OnGroupJoin            func(ctx context.Context, event runtime.Event, obj CallbackGroupJoin) error
+	OnUserBlock            func(ctx context.Context, event runtime.Event, obj CallbackUserBlock) error
+	OnUserUnblock          func(ctx context.Context, event runtime.Event, obj CallbackUserUnblock) error
+	OnPollVoteNew          func(ctx context.Context, event runtime.Event, obj CallbackPollVoteNew) error
+	OnGroupOfficersEdit    func(ctx context.Context, event runtime.Event, obj CallbackGroupOfficersEdit) error
+	OnGroupChangeSettings  func(ctx context.Context, event runtime.Event, obj CallbackGroupChangeSettings) error
+	OnGroupChangePhoto     func(ctx context.Context, event runtime.Event, obj CallbackGroupChangePhoto) error
+	OnLikeAdd              func(ctx context.Context, event runtime.Event, obj CallbackLikeAddRemove) error
+	OnLikeRemove           func(ctx context.Context, event runtime.Event, obj CallbackLikeAddRemove) error
+	Fallback               func(ctx context.Context, event runtime.Event) error
+}
+
+// Handle decodes the event object and calls the callback of its type.
+func (h *EventHandler) Handle(ctx context.Context, event runtime.Event) error {
+	switch event.Type {
+	case "message_new":
+		if h.OnMessageNew == nil {
+			break
+		}
+		var obj EventMessageNew
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnMessageNew(ctx, event, obj)
+	case "message_reply":
+		if h.OnMessageReply == nil {
+			break
+		}
+		var obj MessagesMessage
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnMessageReply(ctx, event, obj)
+	case "message_edit":
+		if h.OnMessageEdit == nil {
+			break
+		}
+		var obj MessagesMessage
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnMessageEdit(ctx, event, obj)
+	case "message_allow":
+		if h.OnMessageAllow == nil {
+			break
+		}
+		var obj CallbackMessageAllow
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnMessageAllow(ctx, event, obj)
+	case "message_deny":
+		if h.OnMessageDeny == nil {
+			break
+		}
+		var obj CallbackMessageDeny
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnMessageDeny(ctx, event, obj)
+	case "photo_new":
+		if h.OnPhotoNew == nil {
+			break
+		}
+		var obj PhotosPhoto
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnPhotoNew(ctx, event, obj)
+	case "photo_comment_new":
+		if h.OnPhotoCommentNew == nil {
+			break
+		}
+		var obj WallWallComment
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnPhotoCommentNew(ctx, event, obj)
+	case "photo_comment_edit":
+		if h.OnPhotoCommentEdit == nil {
+			break
+		}
+		var obj WallWallComment
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnPhotoCommentEdit(ctx, event, obj)
+	case "photo_comment_restore":
+		if h.OnPhotoCommentRestore == nil {
+			break
+		}
+		var obj WallWallComment
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnPhotoCommentRestore(ctx, event, obj)
+	case "photo_comment_delete":
+		if h.OnPhotoCommentDelete == nil {
+			break
+		}
+		var obj CallbackPhotoCommentDelete
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnPhotoCommentDelete(ctx, event, obj)
+	case "video_new":
+		if h.OnVideoNew == nil {
+			break
+		}
+		var obj VideoVideo
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnVideoNew(ctx, event, obj)
+	case "video_comment_new":
+		if h.OnVideoCommentNew == nil {
+			break
+		}
+		var obj WallWallComment
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnVideoCommentNew(ctx, event, obj)
+	case "video_comment_edit":
+		if h.OnVideoCommentEdit == nil {
+			break
+		}
+		var obj WallWallComment
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnVideoCommentEdit(ctx, event, obj)
+	case "video_comment_restore":
+		if h.OnVideoCommentRestore == nil {
+			break
+		}
+		var obj WallWallComment
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnVideoCommentRestore(ctx, event, obj)
+	case "video_comment_delete":
+		if h.OnVideoCommentDelete == nil {
+			break
+		}
+		var obj CallbackVideoCommentDelete
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnVideoCommentDelete(ctx, event, obj)
+	case "wall_post_new":
+		if h.OnWallPostNew == nil {
+			break
+		}
+		var obj WallWallpostFull
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnWallPostNew(ctx, event, obj)
+	case "wall_repost":
+		if h.OnWallRepost == nil {
+			break
+		}
+		var obj WallWallpostFull
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnWallRepost(ctx, event, obj)
+	case "wall_reply_new":
+		if h.OnWallReplyNew == nil {
+			break
+		}
+		var obj WallWallComment
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnWallReplyNew(ctx, event, obj)
+	case "wall_reply_edit":
+		if h.OnWallReplyEdit == nil {
+			break
+		}
+		var obj WallWallComment
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnWallReplyEdit(ctx, event, obj)
+	case "wall_reply_restore":
+		if h.OnWallReplyRestore == nil {
+			break
+		}
+		var obj WallWallComment
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnWallReplyRestore(ctx, event, obj)
+	case "wall_reply_delete":
+		if h.OnWallReplyDelete == nil {
+			break
+		}
+		var obj CallbackWallCommentDelete
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnWallReplyDelete(ctx, event, obj)
+	case "board_post_delete":
+		if h.OnBoardPostDelete == nil {
+			break
+		}
+		var obj CallbackBoardPostDelete
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnBoardPostDelete(ctx, event, obj)
+	case "market_comment_new":
+		if h.OnMarketCommentNew == nil {
+			break
+		}
+		var obj CallbackMarketComment
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnMarketCommentNew(ctx, event, obj)
+	case "market_comment_edit":
+		if h.OnMarketCommentEdit == nil {
+			break
+		}
+		var obj CallbackMarketComment
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnMarketCommentEdit(ctx, event, obj)
+	case "market_comment_restore":
+		if h.OnMarketCommentRestore == nil {
+			break
+		}
+		var obj CallbackMarketComment
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnMarketCommentRestore(ctx, event, obj)
+	case "market_comment_delete":
+		if h.OnMarketCommentDelete == nil {
+			break
+		}
+		var obj CallbackMarketCommentDelete
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnMarketCommentDelete(ctx, event, obj)
+	case "group_leave":
+		if h.OnGroupLeave == nil {
+			break
+		}
+		var obj CallbackGroupLeave
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnGroupLeave(ctx, event, obj)
+	case "group_join":
+		if h.OnGroupJoin == nil {
+			break
+		}
+		var obj CallbackGroupJoin
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnGroupJoin(ctx, event, obj)
+	case "user_block":
+		if h.OnUserBlock == nil {
+			break
+		}
+		var obj CallbackUserBlock
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnUserBlock(ctx, event, obj)
+	case "user_unblock":
+		if h.OnUserUnblock == nil {
+			break
+		}
+		var obj CallbackUserUnblock
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnUserUnblock(ctx, event, obj)
+	case "poll_vote_new":
+		if h.OnPollVoteNew == nil {
+			break
+		}
+		var obj CallbackPollVoteNew
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnPollVoteNew(ctx, event, obj)
+	case "group_officers_edit":
+		if h.OnGroupOfficersEdit == nil {
+			break
+		}
+		var obj CallbackGroupOfficersEdit
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnGroupOfficersEdit(ctx, event, obj)
+	case "group_change_settings":
+		if h.OnGroupChangeSettings == nil {
+			break
+		}
+		var obj CallbackGroupChangeSettings
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnGroupChangeSettings(ctx, event, obj)
+	case "group_change_photo":
+		if h.OnGroupChangePhoto == nil {
+			break
+		}
+		var obj CallbackGroupChangePhoto
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnGroupChangePhoto(ctx, event, obj)
+	case "like_add":
+		if h.OnLikeAdd == nil {
+			break
+		}
+		var obj CallbackLikeAddRemove
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnLikeAdd(ctx, event, obj)
+	case "like_remove":
+		if h.OnLikeRemove == nil {
+			break
+		}
+		var obj CallbackLikeAddRemove
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnLikeRemove(ctx, event, obj)
+	}
+
+	if h.Fallback != nil {
+		return h.Fallback(ctx, event)
+	}
+	return nil
+}
+
+// CallbackHandler is an http.Handler receiving Callback API events. It
+// answers confirmation requests with ConfirmationCode and rejects events
+// without the Secret when one is set.
+type CallbackHandler struct {
+	EventHandler
+	ConfirmationCode string
+	Secret           string
+}
+
+func (h *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	runtime.ServeCallback(w, r, h.ConfirmationCode, h.Secret, h.Handle)
+}
@@ -0,0 +1,38 @@
+// Code generated by vkgen; DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+
+	"github.com/cqln/vkgen/runtime"
+)
+
+// NewBotsLongPoll returns a Bots Long Poll client receiving the events of
+// the community. Pass EventHandler.Handle to Run for typed events.
+func (vk *VK) NewBotsLongPoll(groupID int64) *runtime.BotsLongPoll {
+	req := GroupsGetLongPollServer{GroupID: groupID}
+	return runtime.NewBotsLongPoll(vk.VK, func(ctx context.Context) (runtime.LongPollServer, error) {
+		var response GroupsGetLongPollServerResponse
+		err := vk.CallUnmarshal(ctx, req, req.Params(), &response)
+		return runtime.LongPollServer{
+			Server: response.Server,
+			Key:    response.Key,
+			TS:     response.Ts,
+		}, err
+	})
+}
+
+// NewUserLongPoll returns a User Long Poll client. Set GroupID in req to
+// receive the messages of a community.
+func (vk *VK) NewUserLongPoll(req MessagesGetLongPollServer) *runtime.UserLongPoll {
+	return runtime.NewUserLongPoll(vk.VK, func(ctx context.Context) (runtime.LongPollServer, error) {
+		var response MessagesGetLongPollServerResponse
+		err := vk.CallUnmarshal(ctx, req, req.Params(), &response)
+		return runtime.LongPollServer{
+			Server: response.Server,
+			Key:    response.Key,
+			TS:     response.Ts,
+		}, err
+	})
+}
@@ -2,14 +2,27 @@
 
 package generated
 
+import "github.com/cqln/vkgen/runtime"
+
 // AccountBan.
 //
 // https://vk.com/dev/account.ban
 type AccountBan struct {
-	OwnerID int64
+	OwnerID runtime.OwnerID
+}
+
+// Method returns the API method name.
+func (req AccountBan) Method() string {
+	return "account.ban"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountBan) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req AccountBan) params() Params {
+// Params returns the set parameters.
+func (req AccountBan) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -29,7 +42,18 @@ type AccountChangePassword struct {
 	NewPassword        string // New password that will be set as a current
 }
 
-func (req AccountChangePassword) params() Params {
+// Method returns the API method name.
+func (req AccountChangePassword) Method() string {
+	return "account.changePassword"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountChangePassword) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountChangePassword) Params() Params {
 	params := make(Params)
 	if req.RestoreSid != "" {
 		params["restore_sid"] = req.RestoreSid
@@ -56,7 +80,18 @@ type AccountGetActiveOffers struct {
 	Count  int64 // Number of results to return.
 }
 
-func (req AccountGetActiveOffers) params() Params {
+// Method returns the API method name.
+func (req AccountGetActiveOffers) Method() string {
+	return "account.getActiveOffers"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountGetActiveOffers) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountGetActiveOffers) Params() Params {
 	params := make(Params)
 	if req.Offset != 0 {
 		params["offset"] = req.Offset
@@ -76,7 +111,18 @@ type AccountGetAppPermissions struct {
 	UserID int64 // User ID whose settings information shall be got. By default: current user.
 }
 
-func (req AccountGetAppPermissions) params() Params {
+// Method returns the API method name.
+func (req AccountGetAppPermissions) Method() string {
+	return "account.getAppPermissions"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountGetAppPermissions) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountGetAppPermissions) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -94,7 +140,18 @@ type AccountGetBanned struct {
 	Count  int64 // Number of results to return.
 }
 
-func (req AccountGetBanned) params() Params {
+// Method returns the API method name.
+func (req AccountGetBanned) Method() string {
+	return "account.getBanned"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountGetBanned) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountGetBanned) Params() Params {
 	params := make(Params)
 	if req.Offset != 0 {
 		params["offset"] = req.Offset
@@ -114,7 +171,18 @@ type AccountGetCounters struct {
 	Filter []string // Counters to be returned.
 }
 
-func (req AccountGetCounters) params() Params {
+// Method returns the API method name.
+func (req AccountGetCounters) Method() string {
+	return "account.getCounters"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountGetCounters) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountGetCounters) Params() Params {
 	params := make(Params)
 	if len(req.Filter) > 0 {
 		params["filter"] = req.Filter
@@ -131,7 +199,18 @@ type AccountGetInfo struct {
 	Fields []string // Fields to return. Possible values: *'country' — user country,, *'https_required' — is "HTTPS only" option enabled,, *'own_posts_default' — is "Show my posts only" option is enabled,, *'no_wall_replies' — are wall replies disabled or not,, *'intro' — is intro passed by user or not,, *'lang' — user language. By default: all.
 }
 
-func (req AccountGetInfo) params() Params {
+// Method returns the API method name.
+func (req AccountGetInfo) Method() string {
+	return "account.getInfo"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountGetInfo) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountGetInfo) Params() Params {
 	params := make(Params)
 	if len(req.Fields) > 0 {
 		params["fields"] = req.Fields
@@ -147,7 +226,18 @@ func (req AccountGetInfo) params() Params {
 type AccountGetProfileInfo struct {
 }
 
-func (req AccountGetProfileInfo) params() Params {
+// Method returns the API method name.
+func (req AccountGetProfileInfo) Method() string {
+	return "account.getProfileInfo"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountGetProfileInfo) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountGetProfileInfo) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -161,7 +251,18 @@ type AccountGetPushSettings struct {
 	DeviceID string // Unique device ID.
 }
 
-func (req AccountGetPushSettings) params() Params {
+// Method returns the API method name.
+func (req AccountGetPushSettings) Method() string {
+	return "account.getPushSettings"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountGetPushSettings) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountGetPushSettings) Params() Params {
 	params := make(Params)
 	if req.DeviceID != "" {
 		params["device_id"] = req.DeviceID
@@ -184,7 +285,18 @@ type AccountRegisterDevice struct {
 	Sandbox       bool
 }
 
-func (req AccountRegisterDevice) params() Params {
+// Method returns the API method name.
+func (req AccountRegisterDevice) Method() string {
+	return "account.registerDevice"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountRegisterDevice) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountRegisterDevice) Params() Params {
 	params := make(Params)
 	if req.Token != "" {
 		params["token"] = req.Token
@@ -232,7 +344,18 @@ type AccountSaveProfileInfo struct {
 	Status            string // Status text.
 }
 
-func (req AccountSaveProfileInfo) params() Params {
+// Method returns the API method name.
+func (req AccountSaveProfileInfo) Method() string {
+	return "account.saveProfileInfo"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountSaveProfileInfo) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountSaveProfileInfo) Params() Params {
 	params := make(Params)
 	if req.FirstName != "" {
 		params["first_name"] = req.FirstName
@@ -289,7 +412,18 @@ type AccountSetInfo struct {
 	Value string // Setting value.
 }
 
-func (req AccountSetInfo) params() Params {
+// Method returns the API method name.
+func (req AccountSetInfo) Method() string {
+	return "account.setInfo"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountSetInfo) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountSetInfo) Params() Params {
 	params := make(Params)
 	if req.Name != "" {
 		params["name"] = req.Name
@@ -310,7 +444,18 @@ type AccountSetNameInMenu struct {
 	Name   string // Application screen name.
 }
 
-func (req AccountSetNameInMenu) params() Params {
+// Method returns the API method name.
+func (req AccountSetNameInMenu) Method() string {
+	return "account.setNameInMenu"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountSetNameInMenu) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountSetNameInMenu) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -329,7 +474,18 @@ func (req AccountSetNameInMenu) params() Params {
 type AccountSetOffline struct {
 }
 
-func (req AccountSetOffline) params() Params {
+// Method returns the API method name.
+func (req AccountSetOffline) Method() string {
+	return "account.setOffline"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountSetOffline) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountSetOffline) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -343,7 +499,18 @@ type AccountSetOnline struct {
 	Voip bool // '1' if videocalls are available for current device.
 }
 
-func (req AccountSetOnline) params() Params {
+// Method returns the API method name.
+func (req AccountSetOnline) Method() string {
+	return "account.setOnline"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountSetOnline) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountSetOnline) Params() Params {
 	params := make(Params)
 	if req.Voip {
 		params["voip"] = req.Voip
@@ -363,7 +530,18 @@ type AccountSetPushSettings struct {
 	Value    []string // New value for the key in a [vk.com/dev/push_settings|special format].
 }
 
-func (req AccountSetPushSettings) params() Params {
+// Method returns the API method name.
+func (req AccountSetPushSettings) Method() string {
+	return "account.setPushSettings"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountSetPushSettings) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountSetPushSettings) Params() Params {
 	params := make(Params)
 	if req.DeviceID != "" {
 		params["device_id"] = req.DeviceID
@@ -386,13 +564,24 @@ func (req AccountSetPushSettings) params() Params {
 //
 // https://vk.com/dev/account.setSilenceMode
 type AccountSetSilenceMode struct {
-	DeviceID string // Unique device ID.
-	Time     int64  // Time in seconds for what notifications should be disabled. '-1' to disable forever.
-	PeerID   int64  // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'Chat ID', e.g. '2000000001'. For community: '- Community ID', e.g. '-12345'. "
-	Sound    int64  // '1' — to enable sound in this dialog, '0' — to disable sound. Only if 'peer_id' contains user or community ID.
+	DeviceID string         // Unique device ID.
+	Time     int64          // Time in seconds for what notifications should be disabled. '-1' to disable forever.
+	PeerID   runtime.PeerID // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'Chat ID', e.g. '2000000001'. For community: '- Community ID', e.g. '-12345'. "
+	Sound    int64          // '1' — to enable sound in this dialog, '0' — to disable sound. Only if 'peer_id' contains user or community ID.
+}
+
+// Method returns the API method name.
+func (req AccountSetSilenceMode) Method() string {
+	return "account.setSilenceMode"
 }
 
-func (req AccountSetSilenceMode) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req AccountSetSilenceMode) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountSetSilenceMode) Params() Params {
 	params := make(Params)
 	if req.DeviceID != "" {
 		params["device_id"] = req.DeviceID
@@ -413,10 +602,21 @@ func (req AccountSetSilenceMode) params() Params {
 //
 // https://vk.com/dev/account.unban
 type AccountUnban struct {
-	OwnerID int64
+	OwnerID runtime.OwnerID
+}
+
+// Method returns the API method name.
+func (req AccountUnban) Method() string {
+	return "account.unban"
 }
 
-func (req AccountUnban) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req AccountUnban) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountUnban) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -434,7 +634,18 @@ type AccountUnregisterDevice struct {
 	Sandbox  bool
 }
 
-func (req AccountUnregisterDevice) params() Params {
+// Method returns the API method name.
+func (req AccountUnregisterDevice) Method() string {
+	return "account.unregisterDevice"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AccountUnregisterDevice) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AccountUnregisterDevice) Params() Params {
 	params := make(Params)
 	if req.DeviceID != "" {
 		params["device_id"] = req.DeviceID
@@ -455,7 +666,18 @@ type AdsAddOfficeUsers struct {
 	Data      string // Serialized JSON array of objects that describe added managers. Description of 'user_specification' objects see below.
 }
 
-func (req AdsAddOfficeUsers) params() Params {
+// Method returns the API method name.
+func (req AdsAddOfficeUsers) Method() string {
+	return "ads.addOfficeUsers"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsAddOfficeUsers) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsAddOfficeUsers) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -478,7 +700,18 @@ type AdsCheckLink struct {
 	CampaignID int64  // Campaign ID
 }
 
-func (req AdsCheckLink) params() Params {
+// Method returns the API method name.
+func (req AdsCheckLink) Method() string {
+	return "ads.checkLink"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsCheckLink) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsCheckLink) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -505,7 +738,18 @@ type AdsCreateAds struct {
 	Data      string // Serialized JSON array of objects that describe created ads. Description of 'ad_specification' objects see below.
 }
 
-func (req AdsCreateAds) params() Params {
+// Method returns the API method name.
+func (req AdsCreateAds) Method() string {
+	return "ads.createAds"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsCreateAds) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsCreateAds) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -526,7 +770,18 @@ type AdsCreateCampaigns struct {
 	Data      string // Serialized JSON array of objects that describe created campaigns. Description of 'campaign_specification' objects see below.
 }
 
-func (req AdsCreateCampaigns) params() Params {
+// Method returns the API method name.
+func (req AdsCreateCampaigns) Method() string {
+	return "ads.createCampaigns"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsCreateCampaigns) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsCreateCampaigns) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -547,7 +802,18 @@ type AdsCreateClients struct {
 	Data      string // Serialized JSON array of objects that describe created campaigns. Description of 'client_specification' objects see below.
 }
 
-func (req AdsCreateClients) params() Params {
+// Method returns the API method name.
+func (req AdsCreateClients) Method() string {
+	return "ads.createClients"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsCreateClients) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsCreateClients) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -572,7 +838,18 @@ type AdsCreateTargetGroup struct {
 	TargetPixelRules string
 }
 
-func (req AdsCreateTargetGroup) params() Params {
+// Method returns the API method name.
+func (req AdsCreateTargetGroup) Method() string {
+	return "ads.createTargetGroup"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsCreateTargetGroup) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsCreateTargetGroup) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -605,7 +882,18 @@ type AdsDeleteAds struct {
 	IDs       string // Serialized JSON array with ad IDs.
 }
 
-func (req AdsDeleteAds) params() Params {
+// Method returns the API method name.
+func (req AdsDeleteAds) Method() string {
+	return "ads.deleteAds"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsDeleteAds) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsDeleteAds) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -626,7 +914,18 @@ type AdsDeleteCampaigns struct {
 	IDs       string // Serialized JSON array with IDs of deleted campaigns.
 }
 
-func (req AdsDeleteCampaigns) params() Params {
+// Method returns the API method name.
+func (req AdsDeleteCampaigns) Method() string {
+	return "ads.deleteCampaigns"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsDeleteCampaigns) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsDeleteCampaigns) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -647,7 +946,18 @@ type AdsDeleteClients struct {
 	IDs       string // Serialized JSON array with IDs of deleted clients.
 }
 
-func (req AdsDeleteClients) params() Params {
+// Method returns the API method name.
+func (req AdsDeleteClients) Method() string {
+	return "ads.deleteClients"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsDeleteClients) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsDeleteClients) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -669,7 +979,18 @@ type AdsDeleteTargetGroup struct {
 	TargetGroupID int64 // Group ID.
 }
 
-func (req AdsDeleteTargetGroup) params() Params {
+// Method returns the API method name.
+func (req AdsDeleteTargetGroup) Method() string {
+	return "ads.deleteTargetGroup"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsDeleteTargetGroup) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsDeleteTargetGroup) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -691,7 +1012,18 @@ func (req AdsDeleteTargetGroup) params() Params {
 type AdsGetAccounts struct {
 }
 
-func (req AdsGetAccounts) params() Params {
+// Method returns the API method name.
+func (req AdsGetAccounts) Method() string {
+	return "ads.getAccounts"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetAccounts) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetAccounts) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -712,7 +1044,18 @@ type AdsGetAds struct {
 	Offset         int64  // Offset. Used in the same cases as 'limit' parameter.
 }
 
-func (req AdsGetAds) params() Params {
+// Method returns the API method name.
+func (req AdsGetAds) Method() string {
+	return "ads.getAds"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetAds) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetAds) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -756,7 +1099,18 @@ type AdsGetAdsLayout struct {
 	Offset         int64  // Offset. Used in the same cases as 'limit' parameter.
 }
 
-func (req AdsGetAdsLayout) params() Params {
+// Method returns the API method name.
+func (req AdsGetAdsLayout) Method() string {
+	return "ads.getAdsLayout"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetAdsLayout) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetAdsLayout) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -797,7 +1151,18 @@ type AdsGetAdsTargeting struct {
 	Offset         int64  // Offset needed to return a specific subset of results.
 }
 
-func (req AdsGetAdsTargeting) params() Params {
+// Method returns the API method name.
+func (req AdsGetAdsTargeting) Method() string {
+	return "ads.getAdsTargeting"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetAdsTargeting) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetAdsTargeting) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -832,7 +1197,18 @@ type AdsGetBudget struct {
 	AccountID int64 // Advertising account ID.
 }
 
-func (req AdsGetBudget) params() Params {
+// Method returns the API method name.
+func (req AdsGetBudget) Method() string {
+	return "ads.getBudget"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetBudget) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetBudget) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -853,7 +1229,18 @@ type AdsGetCampaigns struct {
 	Fields         []string
 }
 
-func (req AdsGetCampaigns) params() Params {
+// Method returns the API method name.
+func (req AdsGetCampaigns) Method() string {
+	return "ads.getCampaigns"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetCampaigns) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetCampaigns) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -882,7 +1269,18 @@ type AdsGetCategories struct {
 	Lang string // Language. The full list of supported languages is [vk.com/dev/api_requests|here].
 }
 
-func (req AdsGetCategories) params() Params {
+// Method returns the API method name.
+func (req AdsGetCategories) Method() string {
+	return "ads.getCategories"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetCategories) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetCategories) Params() Params {
 	params := make(Params)
 	if req.Lang != "" {
 		params["lang"] = req.Lang
@@ -899,7 +1297,18 @@ type AdsGetClients struct {
 	AccountID int64 // Advertising account ID.
 }
 
-func (req AdsGetClients) params() Params {
+// Method returns the API method name.
+func (req AdsGetClients) Method() string {
+	return "ads.getClients"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetClients) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetClients) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -921,7 +1330,18 @@ type AdsGetDemographics struct {
 	DateTo    string // Date to show statistics to. For different value of 'period' different date format is used: *day: YYYY-MM-DD, example: 2011-09-27 — September 27, 2011, **0 — current day,, *month: YYYY-MM, example: 2011-09 — September 2011, **0 — current month,, *overall: 0.
 }
 
-func (req AdsGetDemographics) params() Params {
+// Method returns the API method name.
+func (req AdsGetDemographics) Method() string {
+	return "ads.getDemographics"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetDemographics) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetDemographics) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -953,7 +1373,18 @@ type AdsGetFloodStats struct {
 	AccountID int64 // Advertising account ID.
 }
 
-func (req AdsGetFloodStats) params() Params {
+// Method returns the API method name.
+func (req AdsGetFloodStats) Method() string {
+	return "ads.getFloodStats"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetFloodStats) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetFloodStats) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -973,7 +1404,18 @@ type AdsGetLookalikeRequests struct {
 	SortBy      string
 }
 
-func (req AdsGetLookalikeRequests) params() Params {
+// Method returns the API method name.
+func (req AdsGetLookalikeRequests) Method() string {
+	return "ads.getLookalikeRequests"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetLookalikeRequests) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetLookalikeRequests) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1003,7 +1445,18 @@ type AdsGetMusicians struct {
 	ArtistName string
 }
 
-func (req AdsGetMusicians) params() Params {
+// Method returns the API method name.
+func (req AdsGetMusicians) Method() string {
+	return "ads.getMusicians"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetMusicians) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetMusicians) Params() Params {
 	params := make(Params)
 	if req.ArtistName != "" {
 		params["artist_name"] = req.ArtistName
@@ -1020,7 +1473,18 @@ type AdsGetOfficeUsers struct {
 	AccountID int64 // Advertising account ID.
 }
 
-func (req AdsGetOfficeUsers) params() Params {
+// Method returns the API method name.
+func (req AdsGetOfficeUsers) Method() string {
+	return "ads.getOfficeUsers"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetOfficeUsers) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetOfficeUsers) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1039,7 +1503,18 @@ type AdsGetPostsReach struct {
 	IDs       string // IDs requested ads or campaigns, separated with a comma, depending on the value set in 'ids_type'. Maximum 100 objects.
 }
 
-func (req AdsGetPostsReach) params() Params {
+// Method returns the API method name.
+func (req AdsGetPostsReach) Method() string {
+	return "ads.getPostsReach"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetPostsReach) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetPostsReach) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1063,7 +1538,18 @@ type AdsGetRejectionReason struct {
 	AdID      int64 // Ad ID.
 }
 
-func (req AdsGetRejectionReason) params() Params {
+// Method returns the API method name.
+func (req AdsGetRejectionReason) Method() string {
+	return "ads.getRejectionReason"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetRejectionReason) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetRejectionReason) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1089,7 +1575,18 @@ type AdsGetStatistics struct {
 	StatsFields []string // Additional fields to add to statistics
 }
 
-func (req AdsGetStatistics) params() Params {
+// Method returns the API method name.
+func (req AdsGetStatistics) Method() string {
+	return "ads.getStatistics"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetStatistics) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetStatistics) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1129,7 +1626,18 @@ type AdsGetSuggestions struct {
 	Lang    string // Language of the returned string values. Supported languages: *ru — Russian,, *ua — Ukrainian,, *en — English.
 }
 
-func (req AdsGetSuggestions) params() Params {
+// Method returns the API method name.
+func (req AdsGetSuggestions) Method() string {
+	return "ads.getSuggestions"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetSuggestions) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetSuggestions) Params() Params {
 	params := make(Params)
 	if req.Section != "" {
 		params["section"] = req.Section
@@ -1163,7 +1671,18 @@ type AdsGetTargetGroups struct {
 	Extended  bool  // '1' — to return pixel code.
 }
 
-func (req AdsGetTargetGroups) params() Params {
+// Method returns the API method name.
+func (req AdsGetTargetGroups) Method() string {
+	return "ads.getTargetGroups"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetTargetGroups) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetTargetGroups) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1196,7 +1715,18 @@ type AdsGetTargetingStats struct {
 	NeedPrecise           bool   // Additionally return recommended cpc and cpm to reach 5,10..95 percents of audience.
 }
 
-func (req AdsGetTargetingStats) params() Params {
+// Method returns the API method name.
+func (req AdsGetTargetingStats) Method() string {
+	return "ads.getTargetingStats"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetTargetingStats) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetTargetingStats) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1244,7 +1774,18 @@ type AdsGetUploadURL struct {
 	Icon     int64
 }
 
-func (req AdsGetUploadURL) params() Params {
+// Method returns the API method name.
+func (req AdsGetUploadURL) Method() string {
+	return "ads.getUploadURL"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetUploadURL) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetUploadURL) Params() Params {
 	params := make(Params)
 	if req.AdFormat != 0 {
 		params["ad_format"] = req.AdFormat
@@ -1263,7 +1804,18 @@ func (req AdsGetUploadURL) params() Params {
 type AdsGetVideoUploadURL struct {
 }
 
-func (req AdsGetVideoUploadURL) params() Params {
+// Method returns the API method name.
+func (req AdsGetVideoUploadURL) Method() string {
+	return "ads.getVideoUploadURL"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsGetVideoUploadURL) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsGetVideoUploadURL) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -1280,7 +1832,18 @@ type AdsImportTargetContacts struct {
 	Contacts      string // List of phone numbers, emails or user IDs separated with a comma.
 }
 
-func (req AdsImportTargetContacts) params() Params {
+// Method returns the API method name.
+func (req AdsImportTargetContacts) Method() string {
+	return "ads.importTargetContacts"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsImportTargetContacts) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsImportTargetContacts) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1307,7 +1870,18 @@ type AdsRemoveOfficeUsers struct {
 	IDs       string // Serialized JSON array with IDs of deleted managers.
 }
 
-func (req AdsRemoveOfficeUsers) params() Params {
+// Method returns the API method name.
+func (req AdsRemoveOfficeUsers) Method() string {
+	return "ads.removeOfficeUsers"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsRemoveOfficeUsers) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsRemoveOfficeUsers) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1328,7 +1902,18 @@ type AdsUpdateAds struct {
 	Data      string // Serialized JSON array of objects that describe changes in ads. Description of 'ad_edit_specification' objects see below.
 }
 
-func (req AdsUpdateAds) params() Params {
+// Method returns the API method name.
+func (req AdsUpdateAds) Method() string {
+	return "ads.updateAds"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsUpdateAds) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsUpdateAds) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1349,7 +1934,18 @@ type AdsUpdateCampaigns struct {
 	Data      string // Serialized JSON array of objects that describe changes in campaigns. Description of 'campaign_mod' objects see below.
 }
 
-func (req AdsUpdateCampaigns) params() Params {
+// Method returns the API method name.
+func (req AdsUpdateCampaigns) Method() string {
+	return "ads.updateCampaigns"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsUpdateCampaigns) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsUpdateCampaigns) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1370,7 +1966,18 @@ type AdsUpdateClients struct {
 	Data      string // Serialized JSON array of objects that describe changes in clients. Description of 'client_mod' objects see below.
 }
 
-func (req AdsUpdateClients) params() Params {
+// Method returns the API method name.
+func (req AdsUpdateClients) Method() string {
+	return "ads.updateClients"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsUpdateClients) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsUpdateClients) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1397,7 +2004,18 @@ type AdsUpdateTargetGroup struct {
 	TargetPixelRules string
 }
 
-func (req AdsUpdateTargetGroup) params() Params {
+// Method returns the API method name.
+func (req AdsUpdateTargetGroup) Method() string {
+	return "ads.updateTargetGroup"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AdsUpdateTargetGroup) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AdsUpdateTargetGroup) Params() Params {
 	params := make(Params)
 	if req.AccountID != 0 {
 		params["account_id"] = req.AccountID
@@ -1436,7 +2054,18 @@ type AppWidgetsUpdate struct {
 	Type string
 }
 
-func (req AppWidgetsUpdate) params() Params {
+// Method returns the API method name.
+func (req AppWidgetsUpdate) Method() string {
+	return "appWidgets.update"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AppWidgetsUpdate) AccessTokenType() []string {
+	return []string{"group"}
+}
+
+// Params returns the set parameters.
+func (req AppWidgetsUpdate) Params() Params {
 	params := make(Params)
 	if req.Code != "" {
 		params["code"] = req.Code
@@ -1455,7 +2084,18 @@ func (req AppWidgetsUpdate) params() Params {
 type AppsDeleteAppRequests struct {
 }
 
-func (req AppsDeleteAppRequests) params() Params {
+// Method returns the API method name.
+func (req AppsDeleteAppRequests) Method() string {
+	return "apps.deleteAppRequests"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AppsDeleteAppRequests) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AppsDeleteAppRequests) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -1475,7 +2115,18 @@ type AppsGet struct {
 	NameCase      string        // Case for declension of user name and surname: 'nom' — nominative (default),, 'gen' — genitive,, 'dat' — dative,, 'acc' — accusative,, 'ins' — instrumental,, 'abl' — prepositional. (only if 'return_friends' = '1')
 }
 
-func (req AppsGet) params() Params {
+// Method returns the API method name.
+func (req AppsGet) Method() string {
+	return "apps.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AppsGet) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req AppsGet) Params() Params {
 	params := make(Params)
 	if req.AppID != 0 {
 		params["app_id"] = req.AppID
@@ -1520,7 +2171,18 @@ type AppsGetCatalog struct {
 	Filter        string // 'installed' — to return list of installed apps (only for mobile platform).
 }
 
-func (req AppsGetCatalog) params() Params {
+// Method returns the API method name.
+func (req AppsGetCatalog) Method() string {
+	return "apps.getCatalog"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AppsGetCatalog) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req AppsGetCatalog) Params() Params {
 	params := make(Params)
 	if req.Sort != "" {
 		params["sort"] = req.Sort
@@ -1571,7 +2233,18 @@ type AppsGetFriendsList struct {
 	Fields   []UsersFields // Additional profile fields, see [vk.com/dev/fields|description].
 }
 
-func (req AppsGetFriendsList) params() Params {
+// Method returns the API method name.
+func (req AppsGetFriendsList) Method() string {
+	return "apps.getFriendsList"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AppsGetFriendsList) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AppsGetFriendsList) Params() Params {
 	params := make(Params)
 	if req.Extended {
 		params["extended"] = req.Extended
@@ -1602,7 +2275,18 @@ type AppsGetLeaderboard struct {
 	Extended bool   // 1 — to return additional info about users
 }
 
-func (req AppsGetLeaderboard) params() Params {
+// Method returns the API method name.
+func (req AppsGetLeaderboard) Method() string {
+	return "apps.getLeaderboard"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AppsGetLeaderboard) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AppsGetLeaderboard) Params() Params {
 	params := make(Params)
 	if req.Type != "" {
 		params["type"] = req.Type
@@ -1625,7 +2309,18 @@ type AppsGetScopes struct {
 	Type string
 }
 
-func (req AppsGetScopes) params() Params {
+// Method returns the API method name.
+func (req AppsGetScopes) Method() string {
+	return "apps.getScopes"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AppsGetScopes) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AppsGetScopes) Params() Params {
 	params := make(Params)
 	if req.Type != "" {
 		params["type"] = req.Type
@@ -1642,9 +2337,20 @@ type AppsGetScore struct {
 	UserID int64
 }
 
-func (req AppsGetScore) params() Params {
-	params := make(Params)
-	if req.UserID != 0 {
+// Method returns the API method name.
+func (req AppsGetScore) Method() string {
+	return "apps.getScore"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AppsGetScore) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AppsGetScore) Params() Params {
+	params := make(Params)
+	if req.UserID != 0 {
 		params["user_id"] = req.UserID
 	}
 	return params
@@ -1658,7 +2364,18 @@ type AppsPromoHasActiveGift struct {
 	UserID  int64
 }
 
-func (req AppsPromoHasActiveGift) params() Params {
+// Method returns the API method name.
+func (req AppsPromoHasActiveGift) Method() string {
+	return "apps.promoHasActiveGift"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AppsPromoHasActiveGift) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AppsPromoHasActiveGift) Params() Params {
 	params := make(Params)
 	if req.PromoID != 0 {
 		params["promo_id"] = req.PromoID
@@ -1677,7 +2394,18 @@ type AppsPromoUseGift struct {
 	UserID  int64
 }
 
-func (req AppsPromoUseGift) params() Params {
+// Method returns the API method name.
+func (req AppsPromoUseGift) Method() string {
+	return "apps.promoUseGift"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AppsPromoUseGift) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AppsPromoUseGift) Params() Params {
 	params := make(Params)
 	if req.PromoID != 0 {
 		params["promo_id"] = req.PromoID
@@ -1702,7 +2430,18 @@ type AppsSendRequest struct {
 	Separate bool
 }
 
-func (req AppsSendRequest) params() Params {
+// Method returns the API method name.
+func (req AppsSendRequest) Method() string {
+	return "apps.sendRequest"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AppsSendRequest) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req AppsSendRequest) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -1737,7 +2476,18 @@ type AuthCheckPhone struct {
 	AuthByPhone  bool
 }
 
-func (req AuthCheckPhone) params() Params {
+// Method returns the API method name.
+func (req AuthCheckPhone) Method() string {
+	return "auth.checkPhone"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AuthCheckPhone) AccessTokenType() []string {
+	return []string{"user", "open"}
+}
+
+// Params returns the set parameters.
+func (req AuthCheckPhone) Params() Params {
 	params := make(Params)
 	if req.Phone != "" {
 		params["phone"] = req.Phone
@@ -1764,7 +2514,18 @@ type AuthRestore struct {
 	LastName string // User last name.
 }
 
-func (req AuthRestore) params() Params {
+// Method returns the API method name.
+func (req AuthRestore) Method() string {
+	return "auth.restore"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req AuthRestore) AccessTokenType() []string {
+	return []string{"user", "open"}
+}
+
+// Params returns the set parameters.
+func (req AuthRestore) Params() Params {
 	params := make(Params)
 	if req.Phone != "" {
 		params["phone"] = req.Phone
@@ -1788,7 +2549,18 @@ type BoardAddTopic struct {
 	Attachments []string // List of media objects attached to the topic, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media object: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — ID of the media owner. '<media_id>' — Media ID. Example: "photo100172_166443618,photo66748_265827614", , "NOTE: If you try to attach more than one reference, an error will be thrown.",
 }
 
-func (req BoardAddTopic) params() Params {
+// Method returns the API method name.
+func (req BoardAddTopic) Method() string {
+	return "board.addTopic"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardAddTopic) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req BoardAddTopic) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -1818,7 +2590,18 @@ type BoardCloseTopic struct {
 	TopicID int64 // Topic ID.
 }
 
-func (req BoardCloseTopic) params() Params {
+// Method returns the API method name.
+func (req BoardCloseTopic) Method() string {
+	return "board.closeTopic"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardCloseTopic) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req BoardCloseTopic) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -1844,7 +2627,18 @@ type BoardCreateComment struct {
 	Guid        string   // Unique identifier to avoid repeated comments.
 }
 
-func (req BoardCreateComment) params() Params {
+// Method returns the API method name.
+func (req BoardCreateComment) Method() string {
+	return "board.createComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardCreateComment) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req BoardCreateComment) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -1881,7 +2675,18 @@ type BoardDeleteComment struct {
 	CommentID int64 // Comment ID.
 }
 
-func (req BoardDeleteComment) params() Params {
+// Method returns the API method name.
+func (req BoardDeleteComment) Method() string {
+	return "board.deleteComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardDeleteComment) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req BoardDeleteComment) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -1905,7 +2710,18 @@ type BoardDeleteTopic struct {
 	TopicID int64 // Topic ID.
 }
 
-func (req BoardDeleteTopic) params() Params {
+// Method returns the API method name.
+func (req BoardDeleteTopic) Method() string {
+	return "board.deleteTopic"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardDeleteTopic) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req BoardDeleteTopic) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -1929,7 +2745,18 @@ type BoardEditComment struct {
 	Attachments []string // (Required if 'message' is not set.) List of media objects attached to the comment, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media object: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — ID of the media owner. '<media_id>' — Media ID. Example: "photo100172_166443618,photo66748_265827614"
 }
 
-func (req BoardEditComment) params() Params {
+// Method returns the API method name.
+func (req BoardEditComment) Method() string {
+	return "board.editComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardEditComment) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req BoardEditComment) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -1960,7 +2787,18 @@ type BoardEditTopic struct {
 	Title   string // New title of the topic.
 }
 
-func (req BoardEditTopic) params() Params {
+// Method returns the API method name.
+func (req BoardEditTopic) Method() string {
+	return "board.editTopic"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardEditTopic) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req BoardEditTopic) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -1984,7 +2822,18 @@ type BoardFixTopic struct {
 	TopicID int64 // Topic ID.
 }
 
-func (req BoardFixTopic) params() Params {
+// Method returns the API method name.
+func (req BoardFixTopic) Method() string {
+	return "board.fixTopic"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardFixTopic) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req BoardFixTopic) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -2011,7 +2860,18 @@ type BoardGetComments struct {
 	Sort           string // Sort order: 'asc' — by creation date in chronological order, 'desc' — by creation date in reverse chronological order,
 }
 
-func (req BoardGetComments) params() Params {
+// Method returns the API method name.
+func (req BoardGetComments) Method() string {
+	return "board.getComments"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardGetComments) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req BoardGetComments) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -2056,7 +2916,18 @@ type BoardGetTopics struct {
 	PreviewLength int64   // Number of characters after which to truncate the previewed comment. To preview the full comment, specify '0'.
 }
 
-func (req BoardGetTopics) params() Params {
+// Method returns the API method name.
+func (req BoardGetTopics) Method() string {
+	return "board.getTopics"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardGetTopics) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req BoardGetTopics) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -2095,7 +2966,18 @@ type BoardOpenTopic struct {
 	TopicID int64 // Topic ID.
 }
 
-func (req BoardOpenTopic) params() Params {
+// Method returns the API method name.
+func (req BoardOpenTopic) Method() string {
+	return "board.openTopic"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardOpenTopic) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req BoardOpenTopic) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -2117,7 +2999,18 @@ type BoardRestoreComment struct {
 	CommentID int64 // Comment ID.
 }
 
-func (req BoardRestoreComment) params() Params {
+// Method returns the API method name.
+func (req BoardRestoreComment) Method() string {
+	return "board.restoreComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardRestoreComment) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req BoardRestoreComment) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -2141,7 +3034,18 @@ type BoardUnfixTopic struct {
 	TopicID int64 // Topic ID.
 }
 
-func (req BoardUnfixTopic) params() Params {
+// Method returns the API method name.
+func (req BoardUnfixTopic) Method() string {
+	return "board.unfixTopic"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req BoardUnfixTopic) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req BoardUnfixTopic) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -2163,7 +3067,18 @@ type DatabaseGetChairs struct {
 	Count     int64 // amount of chairs to get
 }
 
-func (req DatabaseGetChairs) params() Params {
+// Method returns the API method name.
+func (req DatabaseGetChairs) Method() string {
+	return "database.getChairs"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DatabaseGetChairs) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req DatabaseGetChairs) Params() Params {
 	params := make(Params)
 	if req.FacultyID != 0 {
 		params["faculty_id"] = req.FacultyID
@@ -2191,7 +3106,18 @@ type DatabaseGetCities struct {
 	Count     int64  // Number of cities to return.
 }
 
-func (req DatabaseGetCities) params() Params {
+// Method returns the API method name.
+func (req DatabaseGetCities) Method() string {
+	return "database.getCities"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DatabaseGetCities) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req DatabaseGetCities) Params() Params {
 	params := make(Params)
 	if req.CountryID != 0 {
 		params["country_id"] = req.CountryID
@@ -2223,7 +3149,18 @@ type DatabaseGetCitiesByID struct {
 	CityIDs []int64 // City IDs.
 }
 
-func (req DatabaseGetCitiesByID) params() Params {
+// Method returns the API method name.
+func (req DatabaseGetCitiesByID) Method() string {
+	return "database.getCitiesById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DatabaseGetCitiesByID) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req DatabaseGetCitiesByID) Params() Params {
 	params := make(Params)
 	if len(req.CityIDs) > 0 {
 		params["city_ids"] = req.CityIDs
@@ -2243,7 +3180,18 @@ type DatabaseGetCountries struct {
 	Count   int64  // Number of countries to return.
 }
 
-func (req DatabaseGetCountries) params() Params {
+// Method returns the API method name.
+func (req DatabaseGetCountries) Method() string {
+	return "database.getCountries"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DatabaseGetCountries) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req DatabaseGetCountries) Params() Params {
 	params := make(Params)
 	if req.NeedAll {
 		params["need_all"] = req.NeedAll
@@ -2269,7 +3217,18 @@ type DatabaseGetCountriesByID struct {
 	CountryIDs []int64 // Country IDs.
 }
 
-func (req DatabaseGetCountriesByID) params() Params {
+// Method returns the API method name.
+func (req DatabaseGetCountriesByID) Method() string {
+	return "database.getCountriesById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DatabaseGetCountriesByID) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req DatabaseGetCountriesByID) Params() Params {
 	params := make(Params)
 	if len(req.CountryIDs) > 0 {
 		params["country_ids"] = req.CountryIDs
@@ -2288,7 +3247,18 @@ type DatabaseGetFaculties struct {
 	Count        int64 // Number of faculties to return.
 }
 
-func (req DatabaseGetFaculties) params() Params {
+// Method returns the API method name.
+func (req DatabaseGetFaculties) Method() string {
+	return "database.getFaculties"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DatabaseGetFaculties) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req DatabaseGetFaculties) Params() Params {
 	params := make(Params)
 	if req.UniversityID != 0 {
 		params["university_id"] = req.UniversityID
@@ -2314,7 +3284,18 @@ type DatabaseGetMetroStations struct {
 	Extended bool
 }
 
-func (req DatabaseGetMetroStations) params() Params {
+// Method returns the API method name.
+func (req DatabaseGetMetroStations) Method() string {
+	return "database.getMetroStations"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DatabaseGetMetroStations) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req DatabaseGetMetroStations) Params() Params {
 	params := make(Params)
 	if req.CityID != 0 {
 		params["city_id"] = req.CityID
@@ -2340,7 +3321,18 @@ type DatabaseGetMetroStationsByID struct {
 	StationIDs []int64
 }
 
-func (req DatabaseGetMetroStationsByID) params() Params {
+// Method returns the API method name.
+func (req DatabaseGetMetroStationsByID) Method() string {
+	return "database.getMetroStationsById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DatabaseGetMetroStationsByID) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req DatabaseGetMetroStationsByID) Params() Params {
 	params := make(Params)
 	if len(req.StationIDs) > 0 {
 		params["station_ids"] = req.StationIDs
@@ -2360,7 +3352,18 @@ type DatabaseGetRegions struct {
 	Count     int64  // Number of regions to return.
 }
 
-func (req DatabaseGetRegions) params() Params {
+// Method returns the API method name.
+func (req DatabaseGetRegions) Method() string {
+	return "database.getRegions"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DatabaseGetRegions) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req DatabaseGetRegions) Params() Params {
 	params := make(Params)
 	if req.CountryID != 0 {
 		params["country_id"] = req.CountryID
@@ -2386,7 +3389,18 @@ type DatabaseGetSchoolClasses struct {
 	CountryID int64 // Country ID.
 }
 
-func (req DatabaseGetSchoolClasses) params() Params {
+// Method returns the API method name.
+func (req DatabaseGetSchoolClasses) Method() string {
+	return "database.getSchoolClasses"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DatabaseGetSchoolClasses) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req DatabaseGetSchoolClasses) Params() Params {
 	params := make(Params)
 	if req.CountryID != 0 {
 		params["country_id"] = req.CountryID
@@ -2406,7 +3420,18 @@ type DatabaseGetSchools struct {
 	Count  int64  // Number of schools to return.
 }
 
-func (req DatabaseGetSchools) params() Params {
+// Method returns the API method name.
+func (req DatabaseGetSchools) Method() string {
+	return "database.getSchools"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DatabaseGetSchools) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req DatabaseGetSchools) Params() Params {
 	params := make(Params)
 	if req.Q != "" {
 		params["q"] = req.Q
@@ -2436,7 +3461,18 @@ type DatabaseGetUniversities struct {
 	Count     int64  // Number of universities to return.
 }
 
-func (req DatabaseGetUniversities) params() Params {
+// Method returns the API method name.
+func (req DatabaseGetUniversities) Method() string {
+	return "database.getUniversities"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DatabaseGetUniversities) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req DatabaseGetUniversities) Params() Params {
 	params := make(Params)
 	if req.Q != "" {
 		params["q"] = req.Q
@@ -2462,12 +3498,23 @@ func (req DatabaseGetUniversities) params() Params {
 //
 // https://vk.com/dev/docs.add
 type DocsAdd struct {
-	OwnerID   int64  // ID of the user or community that owns the document. Use a negative value to designate a community ID.
-	DocID     int64  // Document ID.
-	AccessKey string // Access key. This parameter is required if 'access_key' was returned with the document's data.
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the document. Use a negative value to designate a community ID.
+	DocID     int64           // Document ID.
+	AccessKey string          // Access key. This parameter is required if 'access_key' was returned with the document's data.
+}
+
+// Method returns the API method name.
+func (req DocsAdd) Method() string {
+	return "docs.add"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DocsAdd) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req DocsAdd) params() Params {
+// Params returns the set parameters.
+func (req DocsAdd) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -2487,11 +3534,22 @@ func (req DocsAdd) params() Params {
 //
 // https://vk.com/dev/docs.delete
 type DocsDelete struct {
-	OwnerID int64 // ID of the user or community that owns the document. Use a negative value to designate a community ID.
-	DocID   int64 // Document ID.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the document. Use a negative value to designate a community ID.
+	DocID   int64           // Document ID.
 }
 
-func (req DocsDelete) params() Params {
+// Method returns the API method name.
+func (req DocsDelete) Method() string {
+	return "docs.delete"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DocsDelete) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req DocsDelete) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -2508,13 +3566,24 @@ func (req DocsDelete) params() Params {
 //
 // https://vk.com/dev/docs.edit
 type DocsEdit struct {
-	OwnerID int64    // User ID or community ID. Use a negative value to designate a community ID.
-	DocID   int64    // Document ID.
-	Title   string   // Document title.
-	Tags    []string // Document tags.
+	OwnerID runtime.OwnerID // User ID or community ID. Use a negative value to designate a community ID.
+	DocID   int64           // Document ID.
+	Title   string          // Document title.
+	Tags    []string        // Document tags.
+}
+
+// Method returns the API method name.
+func (req DocsEdit) Method() string {
+	return "docs.edit"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DocsEdit) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req DocsEdit) params() Params {
+// Params returns the set parameters.
+func (req DocsEdit) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -2540,11 +3609,22 @@ type DocsGet struct {
 	Count      int64 // Number of documents to return. By default, all documents.
 	Offset     int64 // Offset needed to return a specific subset of documents.
 	Type       int64
-	OwnerID    int64 // ID of the user or community that owns the documents. Use a negative value to designate a community ID.
+	OwnerID    runtime.OwnerID // ID of the user or community that owns the documents. Use a negative value to designate a community ID.
 	ReturnTags bool
 }
 
-func (req DocsGet) params() Params {
+// Method returns the API method name.
+func (req DocsGet) Method() string {
+	return "docs.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DocsGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req DocsGet) Params() Params {
 	params := make(Params)
 	if req.Count != 0 {
 		params["count"] = req.Count
@@ -2574,7 +3654,18 @@ type DocsGetByID struct {
 	ReturnTags bool
 }
 
-func (req DocsGetByID) params() Params {
+// Method returns the API method name.
+func (req DocsGetByID) Method() string {
+	return "docs.getById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DocsGetByID) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req DocsGetByID) Params() Params {
 	params := make(Params)
 	if len(req.Docs) > 0 {
 		params["docs"] = req.Docs
@@ -2591,11 +3682,22 @@ func (req DocsGetByID) params() Params {
 //
 // https://vk.com/dev/docs.getMessagesUploadServer
 type DocsGetMessagesUploadServer struct {
-	Type   string // Document type.
-	PeerID int64  // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'Chat ID', e.g. '2000000001'. For community: '- Community ID', e.g. '-12345'. "
+	Type   string         // Document type.
+	PeerID runtime.PeerID // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'Chat ID', e.g. '2000000001'. For community: '- Community ID', e.g. '-12345'. "
+}
+
+// Method returns the API method name.
+func (req DocsGetMessagesUploadServer) Method() string {
+	return "docs.getMessagesUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DocsGetMessagesUploadServer) AccessTokenType() []string {
+	return []string{"user", "group"}
 }
 
-func (req DocsGetMessagesUploadServer) params() Params {
+// Params returns the set parameters.
+func (req DocsGetMessagesUploadServer) Params() Params {
 	params := make(Params)
 	if req.Type != "" {
 		params["type"] = req.Type
@@ -2612,10 +3714,21 @@ func (req DocsGetMessagesUploadServer) params() Params {
 //
 // https://vk.com/dev/docs.getTypes
 type DocsGetTypes struct {
-	OwnerID int64 // ID of the user or community that owns the documents. Use a negative value to designate a community ID.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the documents. Use a negative value to designate a community ID.
 }
 
-func (req DocsGetTypes) params() Params {
+// Method returns the API method name.
+func (req DocsGetTypes) Method() string {
+	return "docs.getTypes"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DocsGetTypes) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req DocsGetTypes) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -2632,7 +3745,18 @@ type DocsGetUploadServer struct {
 	GroupID int64 // Community ID (if the document will be uploaded to the community).
 }
 
-func (req DocsGetUploadServer) params() Params {
+// Method returns the API method name.
+func (req DocsGetUploadServer) Method() string {
+	return "docs.getUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DocsGetUploadServer) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req DocsGetUploadServer) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -2649,7 +3773,18 @@ type DocsGetWallUploadServer struct {
 	GroupID int64 // Community ID (if the document will be uploaded to the community).
 }
 
-func (req DocsGetWallUploadServer) params() Params {
+// Method returns the API method name.
+func (req DocsGetWallUploadServer) Method() string {
+	return "docs.getWallUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DocsGetWallUploadServer) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req DocsGetWallUploadServer) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -2669,7 +3804,18 @@ type DocsSave struct {
 	ReturnTags bool
 }
 
-func (req DocsSave) params() Params {
+// Method returns the API method name.
+func (req DocsSave) Method() string {
+	return "docs.save"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DocsSave) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req DocsSave) Params() Params {
 	params := make(Params)
 	if req.File != "" {
 		params["file"] = req.File
@@ -2699,7 +3845,18 @@ type DocsSearch struct {
 	ReturnTags bool
 }
 
-func (req DocsSearch) params() Params {
+// Method returns the API method name.
+func (req DocsSearch) Method() string {
+	return "docs.search"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DocsSearch) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req DocsSearch) Params() Params {
 	params := make(Params)
 	if req.Q != "" {
 		params["q"] = req.Q
@@ -2726,7 +3883,18 @@ type DownloadedGamesGetPaidStatus struct {
 	UserID int64
 }
 
-func (req DownloadedGamesGetPaidStatus) params() Params {
+// Method returns the API method name.
+func (req DownloadedGamesGetPaidStatus) Method() string {
+	return "downloadedGames.getPaidStatus"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req DownloadedGamesGetPaidStatus) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req DownloadedGamesGetPaidStatus) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -2741,7 +3909,18 @@ type FaveAddArticle struct {
 	URL string
 }
 
-func (req FaveAddArticle) params() Params {
+// Method returns the API method name.
+func (req FaveAddArticle) Method() string {
+	return "fave.addArticle"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveAddArticle) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveAddArticle) Params() Params {
 	params := make(Params)
 	if req.URL != "" {
 		params["url"] = req.URL
@@ -2758,7 +3937,18 @@ type FaveAddLink struct {
 	Link string // Link URL.
 }
 
-func (req FaveAddLink) params() Params {
+// Method returns the API method name.
+func (req FaveAddLink) Method() string {
+	return "fave.addLink"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveAddLink) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveAddLink) Params() Params {
 	params := make(Params)
 	if req.Link != "" {
 		params["link"] = req.Link
@@ -2774,7 +3964,18 @@ type FaveAddPage struct {
 	GroupID int64
 }
 
-func (req FaveAddPage) params() Params {
+// Method returns the API method name.
+func (req FaveAddPage) Method() string {
+	return "fave.addPage"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveAddPage) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveAddPage) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -2789,12 +3990,23 @@ func (req FaveAddPage) params() Params {
 //
 // https://vk.com/dev/fave.addPost
 type FaveAddPost struct {
-	OwnerID   int64
+	OwnerID   runtime.OwnerID
 	ID        int64
 	AccessKey string
 }
 
-func (req FaveAddPost) params() Params {
+// Method returns the API method name.
+func (req FaveAddPost) Method() string {
+	return "fave.addPost"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveAddPost) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveAddPost) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -2812,12 +4024,23 @@ func (req FaveAddPost) params() Params {
 //
 // https://vk.com/dev/fave.addProduct
 type FaveAddProduct struct {
-	OwnerID   int64
+	OwnerID   runtime.OwnerID
 	ID        int64
 	AccessKey string
 }
 
-func (req FaveAddProduct) params() Params {
+// Method returns the API method name.
+func (req FaveAddProduct) Method() string {
+	return "fave.addProduct"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveAddProduct) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveAddProduct) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -2839,7 +4062,18 @@ type FaveAddTag struct {
 	Position string
 }
 
-func (req FaveAddTag) params() Params {
+// Method returns the API method name.
+func (req FaveAddTag) Method() string {
+	return "fave.addTag"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveAddTag) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveAddTag) Params() Params {
 	params := make(Params)
 	if req.Name != "" {
 		params["name"] = req.Name
@@ -2854,12 +4088,23 @@ func (req FaveAddTag) params() Params {
 //
 // https://vk.com/dev/fave.addVideo
 type FaveAddVideo struct {
-	OwnerID   int64
+	OwnerID   runtime.OwnerID
 	ID        int64
 	AccessKey string
 }
 
-func (req FaveAddVideo) params() Params {
+// Method returns the API method name.
+func (req FaveAddVideo) Method() string {
+	return "fave.addVideo"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveAddVideo) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveAddVideo) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -2881,7 +4126,18 @@ type FaveEditTag struct {
 	Name string
 }
 
-func (req FaveEditTag) params() Params {
+// Method returns the API method name.
+func (req FaveEditTag) Method() string {
+	return "fave.editTag"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveEditTag) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveEditTag) Params() Params {
 	params := make(Params)
 	if req.ID != 0 {
 		params["id"] = req.ID
@@ -2905,7 +4161,18 @@ type FaveGet struct {
 	IsFromSnackbar bool
 }
 
-func (req FaveGet) params() Params {
+// Method returns the API method name.
+func (req FaveGet) Method() string {
+	return "fave.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveGet) Params() Params {
 	params := make(Params)
 	if req.Extended {
 		params["extended"] = req.Extended
@@ -2942,7 +4209,18 @@ type FaveGetPages struct {
 	TagID  int64
 }
 
-func (req FaveGetPages) params() Params {
+// Method returns the API method name.
+func (req FaveGetPages) Method() string {
+	return "fave.getPages"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveGetPages) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveGetPages) Params() Params {
 	params := make(Params)
 	if req.Offset != 0 {
 		params["offset"] = req.Offset
@@ -2968,7 +4246,18 @@ func (req FaveGetPages) params() Params {
 type FaveGetTags struct {
 }
 
-func (req FaveGetTags) params() Params {
+// Method returns the API method name.
+func (req FaveGetTags) Method() string {
+	return "fave.getTags"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveGetTags) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveGetTags) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -2979,7 +4268,18 @@ func (req FaveGetTags) params() Params {
 type FaveMarkSeen struct {
 }
 
-func (req FaveMarkSeen) params() Params {
+// Method returns the API method name.
+func (req FaveMarkSeen) Method() string {
+	return "fave.markSeen"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveMarkSeen) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveMarkSeen) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -2988,11 +4288,22 @@ func (req FaveMarkSeen) params() Params {
 //
 // https://vk.com/dev/fave.removeArticle
 type FaveRemoveArticle struct {
-	OwnerID   int64
+	OwnerID   runtime.OwnerID
 	ArticleID int64
 }
 
-func (req FaveRemoveArticle) params() Params {
+// Method returns the API method name.
+func (req FaveRemoveArticle) Method() string {
+	return "fave.removeArticle"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveRemoveArticle) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveRemoveArticle) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -3013,7 +4324,18 @@ type FaveRemoveLink struct {
 	Link   string // Link URL
 }
 
-func (req FaveRemoveLink) params() Params {
+// Method returns the API method name.
+func (req FaveRemoveLink) Method() string {
+	return "fave.removeLink"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveRemoveLink) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveRemoveLink) Params() Params {
 	params := make(Params)
 	if req.LinkID != "" {
 		params["link_id"] = req.LinkID
@@ -3032,7 +4354,18 @@ type FaveRemovePage struct {
 	GroupID int64
 }
 
-func (req FaveRemovePage) params() Params {
+// Method returns the API method name.
+func (req FaveRemovePage) Method() string {
+	return "fave.removePage"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveRemovePage) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveRemovePage) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -3047,11 +4380,22 @@ func (req FaveRemovePage) params() Params {
 //
 // https://vk.com/dev/fave.removePost
 type FaveRemovePost struct {
-	OwnerID int64
+	OwnerID runtime.OwnerID
 	ID      int64
 }
 
-func (req FaveRemovePost) params() Params {
+// Method returns the API method name.
+func (req FaveRemovePost) Method() string {
+	return "fave.removePost"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveRemovePost) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveRemovePost) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -3066,11 +4410,22 @@ func (req FaveRemovePost) params() Params {
 //
 // https://vk.com/dev/fave.removeProduct
 type FaveRemoveProduct struct {
-	OwnerID int64
+	OwnerID runtime.OwnerID
 	ID      int64
 }
 
-func (req FaveRemoveProduct) params() Params {
+// Method returns the API method name.
+func (req FaveRemoveProduct) Method() string {
+	return "fave.removeProduct"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveRemoveProduct) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveRemoveProduct) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -3088,7 +4443,18 @@ type FaveRemoveTag struct {
 	ID int64
 }
 
-func (req FaveRemoveTag) params() Params {
+// Method returns the API method name.
+func (req FaveRemoveTag) Method() string {
+	return "fave.removeTag"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveRemoveTag) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveRemoveTag) Params() Params {
 	params := make(Params)
 	if req.ID != 0 {
 		params["id"] = req.ID
@@ -3103,7 +4469,18 @@ type FaveReorderTags struct {
 	IDs []int64
 }
 
-func (req FaveReorderTags) params() Params {
+// Method returns the API method name.
+func (req FaveReorderTags) Method() string {
+	return "fave.reorderTags"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveReorderTags) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveReorderTags) Params() Params {
 	params := make(Params)
 	if len(req.IDs) > 0 {
 		params["ids"] = req.IDs
@@ -3120,7 +4497,18 @@ type FaveSetPageTags struct {
 	TagIDs  []int64
 }
 
-func (req FaveSetPageTags) params() Params {
+// Method returns the API method name.
+func (req FaveSetPageTags) Method() string {
+	return "fave.setPageTags"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveSetPageTags) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveSetPageTags) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -3146,7 +4534,18 @@ type FaveSetTags struct {
 	LinkURL     string
 }
 
-func (req FaveSetTags) params() Params {
+// Method returns the API method name.
+func (req FaveSetTags) Method() string {
+	return "fave.setTags"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveSetTags) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveSetTags) Params() Params {
 	params := make(Params)
 	if req.ItemType != "" {
 		params["item_type"] = req.ItemType
@@ -3177,7 +4576,18 @@ type FaveTrackPageInteraction struct {
 	GroupID int64
 }
 
-func (req FaveTrackPageInteraction) params() Params {
+// Method returns the API method name.
+func (req FaveTrackPageInteraction) Method() string {
+	return "fave.trackPageInteraction"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FaveTrackPageInteraction) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FaveTrackPageInteraction) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -3199,7 +4609,18 @@ type FriendsAdd struct {
 	Follow bool   // '1' to pass an incoming request to followers list.
 }
 
-func (req FriendsAdd) params() Params {
+// Method returns the API method name.
+func (req FriendsAdd) Method() string {
+	return "friends.add"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsAdd) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsAdd) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -3223,7 +4644,18 @@ type FriendsAddList struct {
 	UserIDs []int64 // IDs of users to be added to the friend list.
 }
 
-func (req FriendsAddList) params() Params {
+// Method returns the API method name.
+func (req FriendsAddList) Method() string {
+	return "friends.addList"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsAddList) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsAddList) Params() Params {
 	params := make(Params)
 	if req.Name != "" {
 		params["name"] = req.Name
@@ -3245,7 +4677,18 @@ type FriendsAreFriends struct {
 	Extended bool    // Return friend request read_state field
 }
 
-func (req FriendsAreFriends) params() Params {
+// Method returns the API method name.
+func (req FriendsAreFriends) Method() string {
+	return "friends.areFriends"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsAreFriends) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsAreFriends) Params() Params {
 	params := make(Params)
 	if len(req.UserIDs) > 0 {
 		params["user_ids"] = req.UserIDs
@@ -3268,7 +4711,18 @@ type FriendsDelete struct {
 	UserID int64 // ID of the user whose friend request is to be declined or who is to be deleted from the current user's friend list.
 }
 
-func (req FriendsDelete) params() Params {
+// Method returns the API method name.
+func (req FriendsDelete) Method() string {
+	return "friends.delete"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsDelete) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsDelete) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -3284,7 +4738,18 @@ func (req FriendsDelete) params() Params {
 type FriendsDeleteAllRequests struct {
 }
 
-func (req FriendsDeleteAllRequests) params() Params {
+// Method returns the API method name.
+func (req FriendsDeleteAllRequests) Method() string {
+	return "friends.deleteAllRequests"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsDeleteAllRequests) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsDeleteAllRequests) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -3298,7 +4763,18 @@ type FriendsDeleteList struct {
 	ListID int64 // ID of the friend list to delete.
 }
 
-func (req FriendsDeleteList) params() Params {
+// Method returns the API method name.
+func (req FriendsDeleteList) Method() string {
+	return "friends.deleteList"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsDeleteList) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsDeleteList) Params() Params {
 	params := make(Params)
 	if req.ListID != 0 {
 		params["list_id"] = req.ListID
@@ -3316,7 +4792,18 @@ type FriendsEdit struct {
 	ListIDs []int64 // IDs of the friend lists to which to add the user.
 }
 
-func (req FriendsEdit) params() Params {
+// Method returns the API method name.
+func (req FriendsEdit) Method() string {
+	return "friends.edit"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsEdit) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsEdit) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -3340,7 +4827,18 @@ type FriendsEditList struct {
 	DeleteUserIDs []int64 // (Applies if 'user_ids' parameter is not set.), User IDs to delete from the friend list.
 }
 
-func (req FriendsEditList) params() Params {
+// Method returns the API method name.
+func (req FriendsEditList) Method() string {
+	return "friends.editList"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsEditList) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsEditList) Params() Params {
 	params := make(Params)
 	if req.Name != "" {
 		params["name"] = req.Name
@@ -3376,7 +4874,18 @@ type FriendsGet struct {
 	Ref      string
 }
 
-func (req FriendsGet) params() Params {
+// Method returns the API method name.
+func (req FriendsGet) Method() string {
+	return "friends.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsGet) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req FriendsGet) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -3413,7 +4922,18 @@ func (req FriendsGet) params() Params {
 type FriendsGetAppUsers struct {
 }
 
-func (req FriendsGetAppUsers) params() Params {
+// Method returns the API method name.
+func (req FriendsGetAppUsers) Method() string {
+	return "friends.getAppUsers"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsGetAppUsers) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsGetAppUsers) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -3428,7 +4948,18 @@ type FriendsGetByPhones struct {
 	Fields []UsersFields // Profile fields to return. Sample values: 'nickname', 'screen_name', 'sex', 'bdate' (birthdate), 'city', 'country', 'timezone', 'photo', 'photo_medium', 'photo_big', 'has_mobile', 'rate', 'contacts', 'education', 'online, counters'.
 }
 
-func (req FriendsGetByPhones) params() Params {
+// Method returns the API method name.
+func (req FriendsGetByPhones) Method() string {
+	return "friends.getByPhones"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsGetByPhones) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsGetByPhones) Params() Params {
 	params := make(Params)
 	if len(req.Phones) > 0 {
 		params["phones"] = req.Phones
@@ -3449,7 +4980,18 @@ type FriendsGetLists struct {
 	ReturnSystem bool  // '1' — to return system friend lists. By default: '0'.
 }
 
-func (req FriendsGetLists) params() Params {
+// Method returns the API method name.
+func (req FriendsGetLists) Method() string {
+	return "friends.getLists"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsGetLists) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsGetLists) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -3474,7 +5016,18 @@ type FriendsGetMutual struct {
 	Offset     int64   // Offset needed to return a specific subset of mutual friends.
 }
 
-func (req FriendsGetMutual) params() Params {
+// Method returns the API method name.
+func (req FriendsGetMutual) Method() string {
+	return "friends.getMutual"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsGetMutual) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsGetMutual) Params() Params {
 	params := make(Params)
 	if req.SourceUid != 0 {
 		params["source_uid"] = req.SourceUid
@@ -3511,7 +5064,18 @@ type FriendsGetOnline struct {
 	Offset       int64  // Offset needed to return a specific subset of friends.
 }
 
-func (req FriendsGetOnline) params() Params {
+// Method returns the API method name.
+func (req FriendsGetOnline) Method() string {
+	return "friends.getOnline"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsGetOnline) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsGetOnline) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -3543,7 +5107,18 @@ type FriendsGetRecent struct {
 	Count int64 // Number of recently added friends to return.
 }
 
-func (req FriendsGetRecent) params() Params {
+// Method returns the API method name.
+func (req FriendsGetRecent) Method() string {
+	return "friends.getRecent"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsGetRecent) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsGetRecent) Params() Params {
 	params := make(Params)
 	if req.Count != 0 {
 		params["count"] = req.Count
@@ -3569,7 +5144,18 @@ type FriendsGetRequests struct {
 	Fields     []UsersFields
 }
 
-func (req FriendsGetRequests) params() Params {
+// Method returns the API method name.
+func (req FriendsGetRequests) Method() string {
+	return "friends.getRequests"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsGetRequests) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsGetRequests) Params() Params {
 	params := make(Params)
 	if req.Offset != 0 {
 		params["offset"] = req.Offset
@@ -3617,7 +5203,18 @@ type FriendsGetSuggestions struct {
 	NameCase string        // Case for declension of user name and surname: , 'nom' — nominative (default) , 'gen' — genitive , 'dat' — dative , 'acc' — accusative , 'ins' — instrumental , 'abl' — prepositional
 }
 
-func (req FriendsGetSuggestions) params() Params {
+// Method returns the API method name.
+func (req FriendsGetSuggestions) Method() string {
+	return "friends.getSuggestions"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsGetSuggestions) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsGetSuggestions) Params() Params {
 	params := make(Params)
 	if len(req.Filter) > 0 {
 		params["filter"] = req.Filter
@@ -3651,7 +5248,18 @@ type FriendsSearch struct {
 	Count    int64         // Number of friends to return.
 }
 
-func (req FriendsSearch) params() Params {
+// Method returns the API method name.
+func (req FriendsSearch) Method() string {
+	return "friends.search"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req FriendsSearch) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req FriendsSearch) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -3685,7 +5293,18 @@ type GiftsGet struct {
 	Offset int64 // Offset needed to return a specific subset of results.
 }
 
-func (req GiftsGet) params() Params {
+// Method returns the API method name.
+func (req GiftsGet) Method() string {
+	return "gifts.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GiftsGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GiftsGet) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -3718,7 +5337,18 @@ type GroupsAddAddress struct {
 	IsMainAddress     bool
 }
 
-func (req GroupsAddAddress) params() Params {
+// Method returns the API method name.
+func (req GroupsAddAddress) Method() string {
+	return "groups.addAddress"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsAddAddress) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsAddAddress) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -3772,7 +5402,18 @@ type GroupsAddCallbackServer struct {
 	SecretKey string
 }
 
-func (req GroupsAddCallbackServer) params() Params {
+// Method returns the API method name.
+func (req GroupsAddCallbackServer) Method() string {
+	return "groups.addCallbackServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsAddCallbackServer) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsAddCallbackServer) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -3800,7 +5441,18 @@ type GroupsAddLink struct {
 	Text    string // Description text for the link.
 }
 
-func (req GroupsAddLink) params() Params {
+// Method returns the API method name.
+func (req GroupsAddLink) Method() string {
+	return "groups.addLink"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsAddLink) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsAddLink) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -3824,7 +5476,18 @@ type GroupsApproveRequest struct {
 	UserID  int64 // User ID.
 }
 
-func (req GroupsApproveRequest) params() Params {
+// Method returns the API method name.
+func (req GroupsApproveRequest) Method() string {
+	return "groups.approveRequest"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsApproveRequest) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsApproveRequest) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -3840,14 +5503,25 @@ func (req GroupsApproveRequest) params() Params {
 // https://vk.com/dev/groups.ban
 type GroupsBan struct {
 	GroupID        int64
-	OwnerID        int64
+	OwnerID        runtime.OwnerID
 	EndDate        int64
 	Reason         int64
 	Comment        string
 	CommentVisible bool
 }
 
-func (req GroupsBan) params() Params {
+// Method returns the API method name.
+func (req GroupsBan) Method() string {
+	return "groups.ban"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsBan) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsBan) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -3883,7 +5557,18 @@ type GroupsCreate struct {
 	Subtype        int64  // Public page subtype. Possible values: *'1' – place or small business,, *'2' – company, organization or website,, *'3' – famous person or group of people,, *'4' – product or work of art.
 }
 
-func (req GroupsCreate) params() Params {
+// Method returns the API method name.
+func (req GroupsCreate) Method() string {
+	return "groups.create"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsCreate) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsCreate) Params() Params {
 	params := make(Params)
 	if req.Title != "" {
 		params["title"] = req.Title
@@ -3911,7 +5596,18 @@ type GroupsDeleteCallbackServer struct {
 	ServerID int64
 }
 
-func (req GroupsDeleteCallbackServer) params() Params {
+// Method returns the API method name.
+func (req GroupsDeleteCallbackServer) Method() string {
+	return "groups.deleteCallbackServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsDeleteCallbackServer) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsDeleteCallbackServer) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -3932,7 +5628,18 @@ type GroupsDeleteLink struct {
 	LinkID  int64 // Link ID.
 }
 
-func (req GroupsDeleteLink) params() Params {
+// Method returns the API method name.
+func (req GroupsDeleteLink) Method() string {
+	return "groups.deleteLink"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsDeleteLink) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsDeleteLink) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -3950,7 +5657,18 @@ type GroupsDisableOnline struct {
 	GroupID int64
 }
 
-func (req GroupsDisableOnline) params() Params {
+// Method returns the API method name.
+func (req GroupsDisableOnline) Method() string {
+	return "groups.disableOnline"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsDisableOnline) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsDisableOnline) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4011,7 +5729,18 @@ type GroupsEdit struct {
 	City              int64 // City of the community.
 }
 
-func (req GroupsEdit) params() Params {
+// Method returns the API method name.
+func (req GroupsEdit) Method() string {
+	return "groups.edit"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsEdit) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsEdit) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4171,7 +5900,18 @@ type GroupsEditAddress struct {
 	IsMainAddress     bool
 }
 
-func (req GroupsEditAddress) params() Params {
+// Method returns the API method name.
+func (req GroupsEditAddress) Method() string {
+	return "groups.editAddress"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsEditAddress) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsEditAddress) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4229,7 +5969,18 @@ type GroupsEditCallbackServer struct {
 	SecretKey string
 }
 
-func (req GroupsEditCallbackServer) params() Params {
+// Method returns the API method name.
+func (req GroupsEditCallbackServer) Method() string {
+	return "groups.editCallbackServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsEditCallbackServer) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsEditCallbackServer) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4260,7 +6011,18 @@ type GroupsEditLink struct {
 	Text    string // New description text for the link.
 }
 
-func (req GroupsEditLink) params() Params {
+// Method returns the API method name.
+func (req GroupsEditLink) Method() string {
+	return "groups.editLink"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsEditLink) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsEditLink) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4289,7 +6051,18 @@ type GroupsEditManager struct {
 	ContactEmail    string           // Contact e-mail.
 }
 
-func (req GroupsEditManager) params() Params {
+// Method returns the API method name.
+func (req GroupsEditManager) Method() string {
+	return "groups.editManager"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsEditManager) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsEditManager) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4322,7 +6095,18 @@ type GroupsEnableOnline struct {
 	GroupID int64
 }
 
-func (req GroupsEnableOnline) params() Params {
+// Method returns the API method name.
+func (req GroupsEnableOnline) Method() string {
+	return "groups.enableOnline"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsEnableOnline) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsEnableOnline) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4344,7 +6128,18 @@ type GroupsGet struct {
 	Count    int64          // Number of communities to return.
 }
 
-func (req GroupsGet) params() Params {
+// Method returns the API method name.
+func (req GroupsGet) Method() string {
+	return "groups.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGet) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -4382,7 +6177,18 @@ type GroupsGetAddresses struct {
 	Fields     []AddressesFields // Address fields
 }
 
-func (req GroupsGetAddresses) params() Params {
+// Method returns the API method name.
+func (req GroupsGetAddresses) Method() string {
+	return "groups.getAddresses"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetAddresses) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetAddresses) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4418,10 +6224,21 @@ type GroupsGetBanned struct {
 	Offset  int64 // Offset needed to return a specific subset of users.
 	Count   int64 // Number of users to return.
 	Fields  []BaseUserGroupFields
-	OwnerID int64
+	OwnerID runtime.OwnerID
+}
+
+// Method returns the API method name.
+func (req GroupsGetBanned) Method() string {
+	return "groups.getBanned"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetBanned) AccessTokenType() []string {
+	return []string{"user", "group"}
 }
 
-func (req GroupsGetBanned) params() Params {
+// Params returns the set parameters.
+func (req GroupsGetBanned) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4452,7 +6269,18 @@ type GroupsGetByID struct {
 	Fields   []GroupsFields // Group fields to return.
 }
 
-func (req GroupsGetByID) params() Params {
+// Method returns the API method name.
+func (req GroupsGetByID) Method() string {
+	return "groups.getById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetByID) AccessTokenType() []string {
+	return []string{"user", "group", "service"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetByID) Params() Params {
 	params := make(Params)
 	if len(req.GroupIDs) > 0 {
 		params["group_ids"] = req.GroupIDs
@@ -4475,7 +6303,18 @@ type GroupsGetCallbackConfirmationCode struct {
 	GroupID int64 // Community ID.
 }
 
-func (req GroupsGetCallbackConfirmationCode) params() Params {
+// Method returns the API method name.
+func (req GroupsGetCallbackConfirmationCode) Method() string {
+	return "groups.getCallbackConfirmationCode"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetCallbackConfirmationCode) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetCallbackConfirmationCode) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4491,7 +6330,18 @@ type GroupsGetCallbackServers struct {
 	ServerIDs []int64
 }
 
-func (req GroupsGetCallbackServers) params() Params {
+// Method returns the API method name.
+func (req GroupsGetCallbackServers) Method() string {
+	return "groups.getCallbackServers"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetCallbackServers) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetCallbackServers) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4512,7 +6362,18 @@ type GroupsGetCallbackSettings struct {
 	ServerID int64 // Server ID.
 }
 
-func (req GroupsGetCallbackSettings) params() Params {
+// Method returns the API method name.
+func (req GroupsGetCallbackSettings) Method() string {
+	return "groups.getCallbackSettings"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetCallbackSettings) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetCallbackSettings) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4533,7 +6394,18 @@ type GroupsGetCatalog struct {
 	SubcategoryID int64 // Subcategory id received from [vk.com/dev/groups.getCatalogInfo|groups.getCatalogInfo].
 }
 
-func (req GroupsGetCatalog) params() Params {
+// Method returns the API method name.
+func (req GroupsGetCatalog) Method() string {
+	return "groups.getCatalog"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetCatalog) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetCatalog) Params() Params {
 	params := make(Params)
 	if req.CategoryID != 0 {
 		params["category_id"] = req.CategoryID
@@ -4554,7 +6426,18 @@ type GroupsGetCatalogInfo struct {
 	Subcategories bool // 1 – to return subcategories info. By default: 0.
 }
 
-func (req GroupsGetCatalogInfo) params() Params {
+// Method returns the API method name.
+func (req GroupsGetCatalogInfo) Method() string {
+	return "groups.getCatalogInfo"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetCatalogInfo) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetCatalogInfo) Params() Params {
 	params := make(Params)
 	if req.Extended {
 		params["extended"] = req.Extended
@@ -4578,7 +6461,18 @@ type GroupsGetInvitedUsers struct {
 	NameCase string        // Case for declension of user name and surname. Possible values: *'nom' — nominative (default),, *'gen' — genitive,, *'dat' — dative,, *'acc' — accusative, , *'ins' — instrumental,, *'abl' — prepositional.
 }
 
-func (req GroupsGetInvitedUsers) params() Params {
+// Method returns the API method name.
+func (req GroupsGetInvitedUsers) Method() string {
+	return "groups.getInvitedUsers"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetInvitedUsers) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetInvitedUsers) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4609,7 +6503,18 @@ type GroupsGetInvites struct {
 	Extended bool  // '1' — to return additional [vk.com/dev/fields_groups|fields] for communities..
 }
 
-func (req GroupsGetInvites) params() Params {
+// Method returns the API method name.
+func (req GroupsGetInvites) Method() string {
+	return "groups.getInvites"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetInvites) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetInvites) Params() Params {
 	params := make(Params)
 	if req.Offset != 0 {
 		params["offset"] = req.Offset
@@ -4632,7 +6537,18 @@ type GroupsGetLongPollServer struct {
 	GroupID int64 // Community ID
 }
 
-func (req GroupsGetLongPollServer) params() Params {
+// Method returns the API method name.
+func (req GroupsGetLongPollServer) Method() string {
+	return "groups.getLongPollServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetLongPollServer) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetLongPollServer) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4649,7 +6565,18 @@ type GroupsGetLongPollSettings struct {
 	GroupID int64 // Community ID.
 }
 
-func (req GroupsGetLongPollSettings) params() Params {
+// Method returns the API method name.
+func (req GroupsGetLongPollSettings) Method() string {
+	return "groups.getLongPollSettings"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetLongPollSettings) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetLongPollSettings) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4671,7 +6598,18 @@ type GroupsGetMembers struct {
 	Filter  string        // *'friends' – only friends in this community will be returned,, *'unsure' – only those who pressed 'I may attend' will be returned (if it's an event).
 }
 
-func (req GroupsGetMembers) params() Params {
+// Method returns the API method name.
+func (req GroupsGetMembers) Method() string {
+	return "groups.getMembers"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetMembers) AccessTokenType() []string {
+	return []string{"user", "group", "service"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetMembers) Params() Params {
 	params := make(Params)
 	if req.GroupID != "" {
 		params["group_id"] = req.GroupID
@@ -4706,7 +6644,18 @@ type GroupsGetRequests struct {
 	Fields  []UsersFields // Profile fields to return.
 }
 
-func (req GroupsGetRequests) params() Params {
+// Method returns the API method name.
+func (req GroupsGetRequests) Method() string {
+	return "groups.getRequests"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetRequests) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetRequests) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4732,7 +6681,18 @@ type GroupsGetSettings struct {
 	GroupID int64 // Community ID.
 }
 
-func (req GroupsGetSettings) params() Params {
+// Method returns the API method name.
+func (req GroupsGetSettings) Method() string {
+	return "groups.getSettings"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetSettings) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetSettings) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4746,7 +6706,18 @@ func (req GroupsGetSettings) params() Params {
 type GroupsGetTokenPermissions struct {
 }
 
-func (req GroupsGetTokenPermissions) params() Params {
+// Method returns the API method name.
+func (req GroupsGetTokenPermissions) Method() string {
+	return "groups.getTokenPermissions"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsGetTokenPermissions) AccessTokenType() []string {
+	return []string{"group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsGetTokenPermissions) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -4761,7 +6732,18 @@ type GroupsInvite struct {
 	UserID  int64 // User ID.
 }
 
-func (req GroupsInvite) params() Params {
+// Method returns the API method name.
+func (req GroupsInvite) Method() string {
+	return "groups.invite"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsInvite) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsInvite) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4784,7 +6766,18 @@ type GroupsIsMember struct {
 	Extended bool    // '1' — to return an extended response with additional fields. By default: '0'.
 }
 
-func (req GroupsIsMember) params() Params {
+// Method returns the API method name.
+func (req GroupsIsMember) Method() string {
+	return "groups.isMember"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsIsMember) AccessTokenType() []string {
+	return []string{"user", "group", "service"}
+}
+
+// Params returns the set parameters.
+func (req GroupsIsMember) Params() Params {
 	params := make(Params)
 	if req.GroupID != "" {
 		params["group_id"] = req.GroupID
@@ -4811,7 +6804,18 @@ type GroupsJoin struct {
 	NotSure string // Optional parameter which is taken into account when 'gid' belongs to the event: '1' — Perhaps I will attend, '0' — I will be there for sure (default), ,
 }
 
-func (req GroupsJoin) params() Params {
+// Method returns the API method name.
+func (req GroupsJoin) Method() string {
+	return "groups.join"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsJoin) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsJoin) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4831,7 +6835,18 @@ type GroupsLeave struct {
 	GroupID int64 // ID or screen name of the community.
 }
 
-func (req GroupsLeave) params() Params {
+// Method returns the API method name.
+func (req GroupsLeave) Method() string {
+	return "groups.leave"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsLeave) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsLeave) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4849,7 +6864,18 @@ type GroupsRemoveUser struct {
 	UserID  int64 // User ID.
 }
 
-func (req GroupsRemoveUser) params() Params {
+// Method returns the API method name.
+func (req GroupsRemoveUser) Method() string {
+	return "groups.removeUser"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsRemoveUser) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsRemoveUser) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4871,7 +6897,18 @@ type GroupsReorderLink struct {
 	After   int64 // ID of the link after which to place the link with 'link_id'.
 }
 
-func (req GroupsReorderLink) params() Params {
+// Method returns the API method name.
+func (req GroupsReorderLink) Method() string {
+	return "groups.reorderLink"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsReorderLink) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsReorderLink) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -4902,7 +6939,18 @@ type GroupsSearch struct {
 	Count     int64  // Number of communities to return. "Note that you can not receive more than first thousand of results, regardless of 'count' and 'offset' values."
 }
 
-func (req GroupsSearch) params() Params {
+// Method returns the API method name.
+func (req GroupsSearch) Method() string {
+	return "groups.search"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsSearch) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req GroupsSearch) Params() Params {
 	params := make(Params)
 	if req.Q != "" {
 		params["q"] = req.Q
@@ -4988,7 +7036,18 @@ type GroupsSetCallbackSettings struct {
 	MessageEvent         bool
 }
 
-func (req GroupsSetCallbackSettings) params() Params {
+// Method returns the API method name.
+func (req GroupsSetCallbackSettings) Method() string {
+	return "groups.setCallbackSettings"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsSetCallbackSettings) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsSetCallbackSettings) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -5184,7 +7243,18 @@ type GroupsSetLongPollSettings struct {
 	MessageEvent         bool
 }
 
-func (req GroupsSetLongPollSettings) params() Params {
+// Method returns the API method name.
+func (req GroupsSetLongPollSettings) Method() string {
+	return "groups.setLongPollSettings"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsSetLongPollSettings) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req GroupsSetLongPollSettings) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -5329,10 +7399,21 @@ func (req GroupsSetLongPollSettings) params() Params {
 // https://vk.com/dev/groups.unban
 type GroupsUnban struct {
 	GroupID int64
-	OwnerID int64
+	OwnerID runtime.OwnerID
+}
+
+// Method returns the API method name.
+func (req GroupsUnban) Method() string {
+	return "groups.unban"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req GroupsUnban) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req GroupsUnban) params() Params {
+// Params returns the set parameters.
+func (req GroupsUnban) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -5357,7 +7438,18 @@ type LeadsCheckUser struct {
 	Country    string // User country code.
 }
 
-func (req LeadsCheckUser) params() Params {
+// Method returns the API method name.
+func (req LeadsCheckUser) Method() string {
+	return "leads.checkUser"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req LeadsCheckUser) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req LeadsCheckUser) Params() Params {
 	params := make(Params)
 	if req.LeadID != 0 {
 		params["lead_id"] = req.LeadID
@@ -5391,7 +7483,18 @@ type LeadsComplete struct {
 	Comment string // Comment text.
 }
 
-func (req LeadsComplete) params() Params {
+// Method returns the API method name.
+func (req LeadsComplete) Method() string {
+	return "leads.complete"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req LeadsComplete) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req LeadsComplete) Params() Params {
 	params := make(Params)
 	if req.VKSid != "" {
 		params["vk_sid"] = req.VKSid
@@ -5417,7 +7520,18 @@ type LeadsGetStats struct {
 	DateEnd   string // Day to finish stats (YYYY_MM_DD, e.g.2011-09-17).
 }
 
-func (req LeadsGetStats) params() Params {
+// Method returns the API method name.
+func (req LeadsGetStats) Method() string {
+	return "leads.getStats"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req LeadsGetStats) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req LeadsGetStats) Params() Params {
 	params := make(Params)
 	if req.LeadID != 0 {
 		params["lead_id"] = req.LeadID
@@ -5448,7 +7562,18 @@ type LeadsGetUsers struct {
 	Reverse bool   // Sort order. Possible values: *'1' — chronological,, *'0' — reverse chronological.
 }
 
-func (req LeadsGetUsers) params() Params {
+// Method returns the API method name.
+func (req LeadsGetUsers) Method() string {
+	return "leads.getUsers"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req LeadsGetUsers) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req LeadsGetUsers) Params() Params {
 	params := make(Params)
 	if req.OfferID != 0 {
 		params["offer_id"] = req.OfferID
@@ -5480,7 +7605,18 @@ type LeadsMetricHit struct {
 	Data string // Metric data obtained in the lead interface.
 }
 
-func (req LeadsMetricHit) params() Params {
+// Method returns the API method name.
+func (req LeadsMetricHit) Method() string {
+	return "leads.metricHit"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req LeadsMetricHit) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req LeadsMetricHit) Params() Params {
 	params := make(Params)
 	if req.Data != "" {
 		params["data"] = req.Data
@@ -5502,7 +7638,18 @@ type LeadsStart struct {
 	Force    bool
 }
 
-func (req LeadsStart) params() Params {
+// Method returns the API method name.
+func (req LeadsStart) Method() string {
+	return "leads.start"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req LeadsStart) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req LeadsStart) Params() Params {
 	params := make(Params)
 	if req.LeadID != 0 {
 		params["lead_id"] = req.LeadID
@@ -5531,13 +7678,24 @@ func (req LeadsStart) params() Params {
 //
 // https://vk.com/dev/likes.add
 type LikesAdd struct {
-	Type      *LikesType // Object type: 'post' — post on user or community wall, 'comment' — comment on a wall post, 'photo' — photo, 'audio' — audio, 'video' — video, 'note' — note, 'photo_comment' — comment on the photo, 'video_comment' — comment on the video, 'topic_comment' — comment in the discussion, 'sitepage' — page of the site where the [vk.com/dev/Like|Like widget] is installed
-	OwnerID   int64      // ID of the user or community that owns the object.
-	ItemID    int64      // Object ID.
-	AccessKey string     // Access key required for an object owned by a private entity.
+	Type      *LikesType      // Object type: 'post' — post on user or community wall, 'comment' — comment on a wall post, 'photo' — photo, 'audio' — audio, 'video' — video, 'note' — note, 'photo_comment' — comment on the photo, 'video_comment' — comment on the video, 'topic_comment' — comment in the discussion, 'sitepage' — page of the site where the [vk.com/dev/Like|Like widget] is installed
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the object.
+	ItemID    int64           // Object ID.
+	AccessKey string          // Access key required for an object owned by a private entity.
+}
+
+// Method returns the API method name.
+func (req LikesAdd) Method() string {
+	return "likes.add"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req LikesAdd) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req LikesAdd) params() Params {
+// Params returns the set parameters.
+func (req LikesAdd) Params() Params {
 	params := make(Params)
 	if req.Type != nil {
 		params["type"] = req.Type
@@ -5560,13 +7718,24 @@ func (req LikesAdd) params() Params {
 //
 // https://vk.com/dev/likes.delete
 type LikesDelete struct {
-	Type      *LikesType // Object type: 'post' — post on user or community wall, 'comment' — comment on a wall post, 'photo' — photo, 'audio' — audio, 'video' — video, 'note' — note, 'photo_comment' — comment on the photo, 'video_comment' — comment on the video, 'topic_comment' — comment in the discussion, 'sitepage' — page of the site where the [vk.com/dev/Like|Like widget] is installed
-	OwnerID   int64      // ID of the user or community that owns the object.
-	ItemID    int64      // Object ID.
-	AccessKey string     // Access key required for an object owned by a private entity.
+	Type      *LikesType      // Object type: 'post' — post on user or community wall, 'comment' — comment on a wall post, 'photo' — photo, 'audio' — audio, 'video' — video, 'note' — note, 'photo_comment' — comment on the photo, 'video_comment' — comment on the video, 'topic_comment' — comment in the discussion, 'sitepage' — page of the site where the [vk.com/dev/Like|Like widget] is installed
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the object.
+	ItemID    int64           // Object ID.
+	AccessKey string          // Access key required for an object owned by a private entity.
+}
+
+// Method returns the API method name.
+func (req LikesDelete) Method() string {
+	return "likes.delete"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req LikesDelete) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req LikesDelete) params() Params {
+// Params returns the set parameters.
+func (req LikesDelete) Params() Params {
 	params := make(Params)
 	if req.Type != nil {
 		params["type"] = req.Type
@@ -5589,19 +7758,30 @@ func (req LikesDelete) params() Params {
 //
 // https://vk.com/dev/likes.getList
 type LikesGetList struct {
-	Type        *LikesType // , Object type: 'post' — post on user or community wall, 'comment' — comment on a wall post, 'photo' — photo, 'audio' — audio, 'video' — video, 'note' — note, 'photo_comment' — comment on the photo, 'video_comment' — comment on the video, 'topic_comment' — comment in the discussion, 'sitepage' — page of the site where the [vk.com/dev/Like|Like widget] is installed
-	OwnerID     int64      // ID of the user, community, or application that owns the object. If the 'type' parameter is set as 'sitepage', the application ID is passed as 'owner_id'. Use negative value for a community id. If the 'type' parameter is not set, the 'owner_id' is assumed to be either the current user or the same application ID as if the 'type' parameter was set to 'sitepage'.
-	ItemID      int64      // Object ID. If 'type' is set as 'sitepage', 'item_id' can include the 'page_id' parameter value used during initialization of the [vk.com/dev/Like|Like widget].
-	PageURL     string     // URL of the page where the [vk.com/dev/Like|Like widget] is installed. Used instead of the 'item_id' parameter.
-	Filter      string     // Filters to apply: 'likes' — returns information about all users who liked the object (default), 'copies' — returns information only about users who told their friends about the object
-	FriendsOnly int64      // Specifies which users are returned: '1' — to return only the current user's friends, '0' — to return all users (default)
-	Extended    bool       // Specifies whether extended information will be returned. '1' — to return extended information about users and communities from the 'Likes' list, '0' — to return no additional information (default)
-	Offset      int64      // Offset needed to select a specific subset of users.
-	Count       int64      // Number of user IDs to return (maximum '1000'). Default is '100' if 'friends_only' is set to '0', otherwise, the default is '10' if 'friends_only' is set to '1'.
+	Type        *LikesType      // , Object type: 'post' — post on user or community wall, 'comment' — comment on a wall post, 'photo' — photo, 'audio' — audio, 'video' — video, 'note' — note, 'photo_comment' — comment on the photo, 'video_comment' — comment on the video, 'topic_comment' — comment in the discussion, 'sitepage' — page of the site where the [vk.com/dev/Like|Like widget] is installed
+	OwnerID     runtime.OwnerID // ID of the user, community, or application that owns the object. If the 'type' parameter is set as 'sitepage', the application ID is passed as 'owner_id'. Use negative value for a community id. If the 'type' parameter is not set, the 'owner_id' is assumed to be either the current user or the same application ID as if the 'type' parameter was set to 'sitepage'.
+	ItemID      int64           // Object ID. If 'type' is set as 'sitepage', 'item_id' can include the 'page_id' parameter value used during initialization of the [vk.com/dev/Like|Like widget].
+	PageURL     string          // URL of the page where the [vk.com/dev/Like|Like widget] is installed. Used instead of the 'item_id' parameter.
+	Filter      string          // Filters to apply: 'likes' — returns information about all users who liked the object (default), 'copies' — returns information only about users who told their friends about the object
+	FriendsOnly int64           // Specifies which users are returned: '1' — to return only the current user's friends, '0' — to return all users (default)
+	Extended    bool            // Specifies whether extended information will be returned. '1' — to return extended information about users and communities from the 'Likes' list, '0' — to return no additional information (default)
+	Offset      int64           // Offset needed to select a specific subset of users.
+	Count       int64           // Number of user IDs to return (maximum '1000'). Default is '100' if 'friends_only' is set to '0', otherwise, the default is '10' if 'friends_only' is set to '1'.
 	SkipOwn     bool
 }
 
-func (req LikesGetList) params() Params {
+// Method returns the API method name.
+func (req LikesGetList) Method() string {
+	return "likes.getList"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req LikesGetList) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req LikesGetList) Params() Params {
 	params := make(Params)
 	if req.Type != nil {
 		params["type"] = req.Type
@@ -5642,13 +7822,24 @@ func (req LikesGetList) params() Params {
 //
 // https://vk.com/dev/likes.isLiked
 type LikesIsLiked struct {
-	UserID  int64      // User ID.
-	Type    *LikesType // Object type: 'post' — post on user or community wall, 'comment' — comment on a wall post, 'photo' — photo, 'audio' — audio, 'video' — video, 'note' — note, 'photo_comment' — comment on the photo, 'video_comment' — comment on the video, 'topic_comment' — comment in the discussion
-	OwnerID int64      // ID of the user or community that owns the object.
-	ItemID  int64      // Object ID.
+	UserID  int64           // User ID.
+	Type    *LikesType      // Object type: 'post' — post on user or community wall, 'comment' — comment on a wall post, 'photo' — photo, 'audio' — audio, 'video' — video, 'note' — note, 'photo_comment' — comment on the photo, 'video_comment' — comment on the video, 'topic_comment' — comment in the discussion
+	OwnerID runtime.OwnerID // ID of the user or community that owns the object.
+	ItemID  int64           // Object ID.
+}
+
+// Method returns the API method name.
+func (req LikesIsLiked) Method() string {
+	return "likes.isLiked"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req LikesIsLiked) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req LikesIsLiked) params() Params {
+// Params returns the set parameters.
+func (req LikesIsLiked) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -5671,11 +7862,11 @@ func (req LikesIsLiked) params() Params {
 //
 // https://vk.com/dev/market.add
 type MarketAdd struct {
-	OwnerID         int64   // ID of an item owner community.
-	Name            string  // Item name.
-	Description     string  // Item description.
-	CategoryID      int64   // Item category ID.
-	Price           float64 // Item price.
+	OwnerID         runtime.OwnerID // ID of an item owner community.
+	Name            string          // Item name.
+	Description     string          // Item description.
+	CategoryID      int64           // Item category ID.
+	Price           float64         // Item price.
 	OldPrice        float64
 	Deleted         bool    // Item status ('1' — deleted, '0' — not deleted).
 	MainPhotoID     int64   // Cover photo ID.
@@ -5687,7 +7878,18 @@ type MarketAdd struct {
 	Weight          int64
 }
 
-func (req MarketAdd) params() Params {
+// Method returns the API method name.
+func (req MarketAdd) Method() string {
+	return "market.add"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketAdd) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MarketAdd) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -5740,13 +7942,24 @@ func (req MarketAdd) params() Params {
 //
 // https://vk.com/dev/market.addAlbum
 type MarketAddAlbum struct {
-	OwnerID   int64  // ID of an item owner community.
-	Title     string // Collection title.
-	PhotoID   int64  // Cover photo ID.
-	MainAlbum bool   // Set as main ('1' – set, '0' – no).
+	OwnerID   runtime.OwnerID // ID of an item owner community.
+	Title     string          // Collection title.
+	PhotoID   int64           // Cover photo ID.
+	MainAlbum bool            // Set as main ('1' – set, '0' – no).
+}
+
+// Method returns the API method name.
+func (req MarketAddAlbum) Method() string {
+	return "market.addAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketAddAlbum) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketAddAlbum) params() Params {
+// Params returns the set parameters.
+func (req MarketAddAlbum) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -5769,12 +7982,23 @@ func (req MarketAddAlbum) params() Params {
 //
 // https://vk.com/dev/market.addToAlbum
 type MarketAddToAlbum struct {
-	OwnerID  int64   // ID of an item owner community.
-	ItemID   int64   // Item ID.
-	AlbumIDs []int64 // Collections IDs to add item to.
+	OwnerID  runtime.OwnerID // ID of an item owner community.
+	ItemID   int64           // Item ID.
+	AlbumIDs []int64         // Collections IDs to add item to.
+}
+
+// Method returns the API method name.
+func (req MarketAddToAlbum) Method() string {
+	return "market.addToAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketAddToAlbum) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketAddToAlbum) params() Params {
+// Params returns the set parameters.
+func (req MarketAddToAlbum) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -5794,17 +8018,28 @@ func (req MarketAddToAlbum) params() Params {
 //
 // https://vk.com/dev/market.createComment
 type MarketCreateComment struct {
-	OwnerID        int64    // ID of an item owner community.
-	ItemID         int64    // Item ID.
-	Message        string   // Comment text (required if 'attachments' parameter is not specified)
-	Attachments    []string // Comma-separated list of objects attached to a comment. The field is submitted the following way: , "'<owner_id>_<media_id>,<owner_id>_<media_id>'", , '' - media attachment type: "'photo' - photo, 'video' - video, 'audio' - audio, 'doc' - document", , '<owner_id>' - media owner id, '<media_id>' - media attachment id, , For example: "photo100172_166443618,photo66748_265827614",
-	FromGroup      bool     // '1' - comment will be published on behalf of a community, '0' - on behalf of a user (by default).
-	ReplyToComment int64    // ID of a comment to reply with current comment to.
-	StickerID      int64    // Sticker ID.
-	Guid           string   // Random value to avoid resending one comment.
+	OwnerID        runtime.OwnerID // ID of an item owner community.
+	ItemID         int64           // Item ID.
+	Message        string          // Comment text (required if 'attachments' parameter is not specified)
+	Attachments    []string        // Comma-separated list of objects attached to a comment. The field is submitted the following way: , "'<owner_id>_<media_id>,<owner_id>_<media_id>'", , '' - media attachment type: "'photo' - photo, 'video' - video, 'audio' - audio, 'doc' - document", , '<owner_id>' - media owner id, '<media_id>' - media attachment id, , For example: "photo100172_166443618,photo66748_265827614",
+	FromGroup      bool            // '1' - comment will be published on behalf of a community, '0' - on behalf of a user (by default).
+	ReplyToComment int64           // ID of a comment to reply with current comment to.
+	StickerID      int64           // Sticker ID.
+	Guid           string          // Random value to avoid resending one comment.
+}
+
+// Method returns the API method name.
+func (req MarketCreateComment) Method() string {
+	return "market.createComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketCreateComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketCreateComment) params() Params {
+// Params returns the set parameters.
+func (req MarketCreateComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -5839,11 +8074,22 @@ func (req MarketCreateComment) params() Params {
 //
 // https://vk.com/dev/market.delete
 type MarketDelete struct {
-	OwnerID int64 // ID of an item owner community.
-	ItemID  int64 // Item ID.
+	OwnerID runtime.OwnerID // ID of an item owner community.
+	ItemID  int64           // Item ID.
+}
+
+// Method returns the API method name.
+func (req MarketDelete) Method() string {
+	return "market.delete"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketDelete) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketDelete) params() Params {
+// Params returns the set parameters.
+func (req MarketDelete) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -5860,11 +8106,22 @@ func (req MarketDelete) params() Params {
 //
 // https://vk.com/dev/market.deleteAlbum
 type MarketDeleteAlbum struct {
-	OwnerID int64 // ID of an collection owner community.
-	AlbumID int64 // Collection ID.
+	OwnerID runtime.OwnerID // ID of an collection owner community.
+	AlbumID int64           // Collection ID.
+}
+
+// Method returns the API method name.
+func (req MarketDeleteAlbum) Method() string {
+	return "market.deleteAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketDeleteAlbum) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketDeleteAlbum) params() Params {
+// Params returns the set parameters.
+func (req MarketDeleteAlbum) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -5881,11 +8138,22 @@ func (req MarketDeleteAlbum) params() Params {
 //
 // https://vk.com/dev/market.deleteComment
 type MarketDeleteComment struct {
-	OwnerID   int64 // identifier of an item owner community, "Note that community id in the 'owner_id' parameter should be negative number. For example 'owner_id'=-1 matches the [vk.com/apiclub|VK API] community "
-	CommentID int64 // comment id
+	OwnerID   runtime.OwnerID // identifier of an item owner community, "Note that community id in the 'owner_id' parameter should be negative number. For example 'owner_id'=-1 matches the [vk.com/apiclub|VK API] community "
+	CommentID int64           // comment id
+}
+
+// Method returns the API method name.
+func (req MarketDeleteComment) Method() string {
+	return "market.deleteComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketDeleteComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketDeleteComment) params() Params {
+// Params returns the set parameters.
+func (req MarketDeleteComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -5902,19 +8170,30 @@ func (req MarketDeleteComment) params() Params {
 //
 // https://vk.com/dev/market.edit
 type MarketEdit struct {
-	OwnerID     int64   // ID of an item owner community.
-	ItemID      int64   // Item ID.
-	Name        string  // Item name.
-	Description string  // Item description.
-	CategoryID  int64   // Item category ID.
-	Price       float64 // Item price.
-	Deleted     bool    // Item status ('1' — deleted, '0' — not deleted).
-	MainPhotoID int64   // Cover photo ID.
-	PhotoIDs    []int64 // IDs of additional photos.
-	URL         string  // Url for button in market item.
+	OwnerID     runtime.OwnerID // ID of an item owner community.
+	ItemID      int64           // Item ID.
+	Name        string          // Item name.
+	Description string          // Item description.
+	CategoryID  int64           // Item category ID.
+	Price       float64         // Item price.
+	Deleted     bool            // Item status ('1' — deleted, '0' — not deleted).
+	MainPhotoID int64           // Cover photo ID.
+	PhotoIDs    []int64         // IDs of additional photos.
+	URL         string          // Url for button in market item.
+}
+
+// Method returns the API method name.
+func (req MarketEdit) Method() string {
+	return "market.edit"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketEdit) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketEdit) params() Params {
+// Params returns the set parameters.
+func (req MarketEdit) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -5955,14 +8234,25 @@ func (req MarketEdit) params() Params {
 //
 // https://vk.com/dev/market.editAlbum
 type MarketEditAlbum struct {
-	OwnerID   int64  // ID of an collection owner community.
-	AlbumID   int64  // Collection ID.
-	Title     string // Collection title.
-	PhotoID   int64  // Cover photo id
-	MainAlbum bool   // Set as main ('1' – set, '0' – no).
+	OwnerID   runtime.OwnerID // ID of an collection owner community.
+	AlbumID   int64           // Collection ID.
+	Title     string          // Collection title.
+	PhotoID   int64           // Cover photo id
+	MainAlbum bool            // Set as main ('1' – set, '0' – no).
+}
+
+// Method returns the API method name.
+func (req MarketEditAlbum) Method() string {
+	return "market.editAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketEditAlbum) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketEditAlbum) params() Params {
+// Params returns the set parameters.
+func (req MarketEditAlbum) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -5988,13 +8278,24 @@ func (req MarketEditAlbum) params() Params {
 //
 // https://vk.com/dev/market.editComment
 type MarketEditComment struct {
-	OwnerID     int64    // ID of an item owner community.
-	CommentID   int64    // Comment ID.
-	Message     string   // New comment text (required if 'attachments' are not specified), , 2048 symbols maximum.
-	Attachments []string // Comma-separated list of objects attached to a comment. The field is submitted the following way: , "'<owner_id>_<media_id>,<owner_id>_<media_id>'", , '' - media attachment type: "'photo' - photo, 'video' - video, 'audio' - audio, 'doc' - document", , '<owner_id>' - media owner id, '<media_id>' - media attachment id, , For example: "photo100172_166443618,photo66748_265827614",
+	OwnerID     runtime.OwnerID // ID of an item owner community.
+	CommentID   int64           // Comment ID.
+	Message     string          // New comment text (required if 'attachments' are not specified), , 2048 symbols maximum.
+	Attachments []string        // Comma-separated list of objects attached to a comment. The field is submitted the following way: , "'<owner_id>_<media_id>,<owner_id>_<media_id>'", , '' - media attachment type: "'photo' - photo, 'video' - video, 'audio' - audio, 'doc' - document", , '<owner_id>' - media owner id, '<media_id>' - media attachment id, , For example: "photo100172_166443618,photo66748_265827614",
+}
+
+// Method returns the API method name.
+func (req MarketEditComment) Method() string {
+	return "market.editComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketEditComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketEditComment) params() Params {
+// Params returns the set parameters.
+func (req MarketEditComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6017,14 +8318,25 @@ func (req MarketEditComment) params() Params {
 //
 // https://vk.com/dev/market.get
 type MarketGet struct {
-	OwnerID  int64 // ID of an item owner community, "Note that community id in the 'owner_id' parameter should be negative number. For example 'owner_id'=-1 matches the [vk.com/apiclub|VK API] community "
+	OwnerID  runtime.OwnerID // ID of an item owner community, "Note that community id in the 'owner_id' parameter should be negative number. For example 'owner_id'=-1 matches the [vk.com/apiclub|VK API] community "
 	AlbumID  int64
 	Count    int64 // Number of items to return.
 	Offset   int64 // Offset needed to return a specific subset of results.
 	Extended bool  // '1' – method will return additional fields: 'likes, can_comment, car_repost, photos'. These parameters are not returned by default.
 }
 
-func (req MarketGet) params() Params {
+// Method returns the API method name.
+func (req MarketGet) Method() string {
+	return "market.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MarketGet) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6050,11 +8362,22 @@ func (req MarketGet) params() Params {
 //
 // https://vk.com/dev/market.getAlbumById
 type MarketGetAlbumByID struct {
-	OwnerID  int64   // identifier of an album owner community, "Note that community id in the 'owner_id' parameter should be negative number. For example 'owner_id'=-1 matches the [vk.com/apiclub|VK API] community "
-	AlbumIDs []int64 // collections identifiers to obtain data from
+	OwnerID  runtime.OwnerID // identifier of an album owner community, "Note that community id in the 'owner_id' parameter should be negative number. For example 'owner_id'=-1 matches the [vk.com/apiclub|VK API] community "
+	AlbumIDs []int64         // collections identifiers to obtain data from
+}
+
+// Method returns the API method name.
+func (req MarketGetAlbumByID) Method() string {
+	return "market.getAlbumById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketGetAlbumByID) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketGetAlbumByID) params() Params {
+// Params returns the set parameters.
+func (req MarketGetAlbumByID) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6071,12 +8394,23 @@ func (req MarketGetAlbumByID) params() Params {
 //
 // https://vk.com/dev/market.getAlbums
 type MarketGetAlbums struct {
-	OwnerID int64 // ID of an items owner community.
-	Offset  int64 // Offset needed to return a specific subset of results.
-	Count   int64 // Number of items to return.
+	OwnerID runtime.OwnerID // ID of an items owner community.
+	Offset  int64           // Offset needed to return a specific subset of results.
+	Count   int64           // Number of items to return.
+}
+
+// Method returns the API method name.
+func (req MarketGetAlbums) Method() string {
+	return "market.getAlbums"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketGetAlbums) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketGetAlbums) params() Params {
+// Params returns the set parameters.
+func (req MarketGetAlbums) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6100,7 +8434,18 @@ type MarketGetByID struct {
 	Extended bool     // '1' – to return additional fields: 'likes, can_comment, car_repost, photos'. By default: '0'.
 }
 
-func (req MarketGetByID) params() Params {
+// Method returns the API method name.
+func (req MarketGetByID) Method() string {
+	return "market.getById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketGetByID) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MarketGetByID) Params() Params {
 	params := make(Params)
 	if len(req.ItemIDs) > 0 {
 		params["item_ids"] = req.ItemIDs
@@ -6121,7 +8466,18 @@ type MarketGetCategories struct {
 	Offset int64 // Offset needed to return a specific subset of results.
 }
 
-func (req MarketGetCategories) params() Params {
+// Method returns the API method name.
+func (req MarketGetCategories) Method() string {
+	return "market.getCategories"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketGetCategories) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MarketGetCategories) Params() Params {
 	params := make(Params)
 	if req.Count != 0 {
 		params["count"] = req.Count
@@ -6138,10 +8494,10 @@ func (req MarketGetCategories) params() Params {
 //
 // https://vk.com/dev/market.getComments
 type MarketGetComments struct {
-	OwnerID        int64 // ID of an item owner community
-	ItemID         int64 // Item ID.
-	NeedLikes      bool  // '1' — to return likes info.
-	StartCommentID int64 // ID of a comment to start a list from (details below).
+	OwnerID        runtime.OwnerID // ID of an item owner community
+	ItemID         int64           // Item ID.
+	NeedLikes      bool            // '1' — to return likes info.
+	StartCommentID int64           // ID of a comment to start a list from (details below).
 	Offset         int64
 	Count          int64         // Number of results to return.
 	Sort           string        // Sort order ('asc' — from old to new, 'desc' — from new to old)
@@ -6149,7 +8505,18 @@ type MarketGetComments struct {
 	Fields         []UsersFields // List of additional profile fields to return. See the [vk.com/dev/fields|details]
 }
 
-func (req MarketGetComments) params() Params {
+// Method returns the API method name.
+func (req MarketGetComments) Method() string {
+	return "market.getComments"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketGetComments) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MarketGetComments) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6187,12 +8554,23 @@ func (req MarketGetComments) params() Params {
 //
 // https://vk.com/dev/market.removeFromAlbum
 type MarketRemoveFromAlbum struct {
-	OwnerID  int64   // ID of an item owner community.
-	ItemID   int64   // Item ID.
-	AlbumIDs []int64 // Collections IDs to remove item from.
+	OwnerID  runtime.OwnerID // ID of an item owner community.
+	ItemID   int64           // Item ID.
+	AlbumIDs []int64         // Collections IDs to remove item from.
+}
+
+// Method returns the API method name.
+func (req MarketRemoveFromAlbum) Method() string {
+	return "market.removeFromAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketRemoveFromAlbum) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketRemoveFromAlbum) params() Params {
+// Params returns the set parameters.
+func (req MarketRemoveFromAlbum) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6212,13 +8590,24 @@ func (req MarketRemoveFromAlbum) params() Params {
 //
 // https://vk.com/dev/market.reorderAlbums
 type MarketReorderAlbums struct {
-	OwnerID int64 // ID of an item owner community.
-	AlbumID int64 // Collection ID.
-	Before  int64 // ID of a collection to place current collection before it.
-	After   int64 // ID of a collection to place current collection after it.
+	OwnerID runtime.OwnerID // ID of an item owner community.
+	AlbumID int64           // Collection ID.
+	Before  int64           // ID of a collection to place current collection before it.
+	After   int64           // ID of a collection to place current collection after it.
+}
+
+// Method returns the API method name.
+func (req MarketReorderAlbums) Method() string {
+	return "market.reorderAlbums"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketReorderAlbums) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketReorderAlbums) params() Params {
+// Params returns the set parameters.
+func (req MarketReorderAlbums) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6241,14 +8630,25 @@ func (req MarketReorderAlbums) params() Params {
 //
 // https://vk.com/dev/market.reorderItems
 type MarketReorderItems struct {
-	OwnerID int64 // ID of an item owner community.
-	AlbumID int64 // ID of a collection to reorder items in. Set 0 to reorder full items list.
-	ItemID  int64 // Item ID.
-	Before  int64 // ID of an item to place current item before it.
-	After   int64 // ID of an item to place current item after it.
+	OwnerID runtime.OwnerID // ID of an item owner community.
+	AlbumID int64           // ID of a collection to reorder items in. Set 0 to reorder full items list.
+	ItemID  int64           // Item ID.
+	Before  int64           // ID of an item to place current item before it.
+	After   int64           // ID of an item to place current item after it.
+}
+
+// Method returns the API method name.
+func (req MarketReorderItems) Method() string {
+	return "market.reorderItems"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketReorderItems) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketReorderItems) params() Params {
+// Params returns the set parameters.
+func (req MarketReorderItems) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6274,12 +8674,23 @@ func (req MarketReorderItems) params() Params {
 //
 // https://vk.com/dev/market.report
 type MarketReport struct {
-	OwnerID int64 // ID of an item owner community.
-	ItemID  int64 // Item ID.
-	Reason  int64 // Complaint reason. Possible values: *'0' — spam,, *'1' — child porn,, *'2' — extremism,, *'3' — violence,, *'4' — drugs propaganda,, *'5' — adult materials,, *'6' — insult.
+	OwnerID runtime.OwnerID // ID of an item owner community.
+	ItemID  int64           // Item ID.
+	Reason  int64           // Complaint reason. Possible values: *'0' — spam,, *'1' — child porn,, *'2' — extremism,, *'3' — violence,, *'4' — drugs propaganda,, *'5' — adult materials,, *'6' — insult.
+}
+
+// Method returns the API method name.
+func (req MarketReport) Method() string {
+	return "market.report"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketReport) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req MarketReport) params() Params {
+// Params returns the set parameters.
+func (req MarketReport) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6299,12 +8710,23 @@ func (req MarketReport) params() Params {
 //
 // https://vk.com/dev/market.reportComment
 type MarketReportComment struct {
-	OwnerID   int64 // ID of an item owner community.
-	CommentID int64 // Comment ID.
-	Reason    int64 // Complaint reason. Possible values: *'0' — spam,, *'1' — child porn,, *'2' — extremism,, *'3' — violence,, *'4' — drugs propaganda,, *'5' — adult materials,, *'6' — insult.
+	OwnerID   runtime.OwnerID // ID of an item owner community.
+	CommentID int64           // Comment ID.
+	Reason    int64           // Complaint reason. Possible values: *'0' — spam,, *'1' — child porn,, *'2' — extremism,, *'3' — violence,, *'4' — drugs propaganda,, *'5' — adult materials,, *'6' — insult.
+}
+
+// Method returns the API method name.
+func (req MarketReportComment) Method() string {
+	return "market.reportComment"
 }
 
-func (req MarketReportComment) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req MarketReportComment) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MarketReportComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6324,11 +8746,22 @@ func (req MarketReportComment) params() Params {
 //
 // https://vk.com/dev/market.restore
 type MarketRestore struct {
-	OwnerID int64 // ID of an item owner community.
-	ItemID  int64 // Deleted item ID.
+	OwnerID runtime.OwnerID // ID of an item owner community.
+	ItemID  int64           // Deleted item ID.
+}
+
+// Method returns the API method name.
+func (req MarketRestore) Method() string {
+	return "market.restore"
 }
 
-func (req MarketRestore) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req MarketRestore) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MarketRestore) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6345,11 +8778,22 @@ func (req MarketRestore) params() Params {
 //
 // https://vk.com/dev/market.restoreComment
 type MarketRestoreComment struct {
-	OwnerID   int64 // identifier of an item owner community, "Note that community id in the 'owner_id' parameter should be negative number. For example 'owner_id'=-1 matches the [vk.com/apiclub|VK API] community "
-	CommentID int64 // deleted comment id
+	OwnerID   runtime.OwnerID // identifier of an item owner community, "Note that community id in the 'owner_id' parameter should be negative number. For example 'owner_id'=-1 matches the [vk.com/apiclub|VK API] community "
+	CommentID int64           // deleted comment id
+}
+
+// Method returns the API method name.
+func (req MarketRestoreComment) Method() string {
+	return "market.restoreComment"
 }
 
-func (req MarketRestoreComment) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req MarketRestoreComment) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MarketRestoreComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6366,7 +8810,7 @@ func (req MarketRestoreComment) params() Params {
 //
 // https://vk.com/dev/market.search
 type MarketSearch struct {
-	OwnerID   int64 // ID of an items owner community.
+	OwnerID   runtime.OwnerID // ID of an items owner community.
 	AlbumID   int64
 	Q         string // Search query, for example "pink slippers".
 	PriceFrom int64  // Minimum item price value.
@@ -6379,7 +8823,18 @@ type MarketSearch struct {
 	Status    int64
 }
 
-func (req MarketSearch) params() Params {
+// Method returns the API method name.
+func (req MarketSearch) Method() string {
+	return "market.search"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MarketSearch) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MarketSearch) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -6428,7 +8883,18 @@ type MessagesAddChatUser struct {
 	VisibleMessagesCount int64
 }
 
-func (req MessagesAddChatUser) params() Params {
+// Method returns the API method name.
+func (req MessagesAddChatUser) Method() string {
+	return "messages.addChatUser"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesAddChatUser) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MessagesAddChatUser) Params() Params {
 	params := make(Params)
 	if req.ChatID != 0 {
 		params["chat_id"] = req.ChatID
@@ -6452,7 +8918,18 @@ type MessagesAllowMessagesFromGroup struct {
 	Key     string
 }
 
-func (req MessagesAllowMessagesFromGroup) params() Params {
+// Method returns the API method name.
+func (req MessagesAllowMessagesFromGroup) Method() string {
+	return "messages.allowMessagesFromGroup"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesAllowMessagesFromGroup) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MessagesAllowMessagesFromGroup) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -6474,7 +8951,18 @@ type MessagesCreateChat struct {
 	GroupID int64
 }
 
-func (req MessagesCreateChat) params() Params {
+// Method returns the API method name.
+func (req MessagesCreateChat) Method() string {
+	return "messages.createChat"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesCreateChat) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesCreateChat) Params() Params {
 	params := make(Params)
 	if len(req.UserIDs) > 0 {
 		params["user_ids"] = req.UserIDs
@@ -6500,7 +8988,18 @@ type MessagesDelete struct {
 	DeleteForAll bool    // '1' — delete message for for all.
 }
 
-func (req MessagesDelete) params() Params {
+// Method returns the API method name.
+func (req MessagesDelete) Method() string {
+	return "messages.delete"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesDelete) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesDelete) Params() Params {
 	params := make(Params)
 	if len(req.MessageIDs) > 0 {
 		params["message_ids"] = req.MessageIDs
@@ -6527,7 +9026,18 @@ type MessagesDeleteChatPhoto struct {
 	GroupID int64
 }
 
-func (req MessagesDeleteChatPhoto) params() Params {
+// Method returns the API method name.
+func (req MessagesDeleteChatPhoto) Method() string {
+	return "messages.deleteChatPhoto"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesDeleteChatPhoto) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesDeleteChatPhoto) Params() Params {
 	params := make(Params)
 	if req.ChatID != 0 {
 		params["chat_id"] = req.ChatID
@@ -6544,12 +9054,23 @@ func (req MessagesDeleteChatPhoto) params() Params {
 //
 // https://vk.com/dev/messages.deleteConversation
 type MessagesDeleteConversation struct {
-	UserID  int64 // User ID. To clear a chat history use 'chat_id'
-	PeerID  int64 // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
-	GroupID int64 // Group ID (for group messages with user access token)
+	UserID  int64          // User ID. To clear a chat history use 'chat_id'
+	PeerID  runtime.PeerID // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
+	GroupID int64          // Group ID (for group messages with user access token)
+}
+
+// Method returns the API method name.
+func (req MessagesDeleteConversation) Method() string {
+	return "messages.deleteConversation"
 }
 
-func (req MessagesDeleteConversation) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesDeleteConversation) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesDeleteConversation) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -6572,7 +9093,18 @@ type MessagesDenyMessagesFromGroup struct {
 	GroupID int64 // Group ID.
 }
 
-func (req MessagesDenyMessagesFromGroup) params() Params {
+// Method returns the API method name.
+func (req MessagesDenyMessagesFromGroup) Method() string {
+	return "messages.denyMessagesFromGroup"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesDenyMessagesFromGroup) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MessagesDenyMessagesFromGroup) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -6586,14 +9118,14 @@ func (req MessagesDenyMessagesFromGroup) params() Params {
 //
 // https://vk.com/dev/messages.edit
 type MessagesEdit struct {
-	PeerID                int64   // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
-	Message               string  // (Required if 'attachments' is not set.) Text of the message.
-	Lat                   float64 // Geographical latitude of a check-in, in degrees (from -90 to 90).
-	Long                  float64 // Geographical longitude of a check-in, in degrees (from -180 to 180).
-	Attachment            string  // (Required if 'message' is not set.) List of objects attached to the message, separated by commas, in the following format: "<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, 'wall' — wall post, '<owner_id>' — ID of the media attachment owner. '<media_id>' — media attachment ID. Example: "photo100172_166443618"
-	KeepForwardMessages   bool    // '1' — to keep forwarded, messages.
-	KeepSnippets          bool    // '1' — to keep attached snippets.
-	GroupID               int64   // Group ID (for group messages with user access token)
+	PeerID                runtime.PeerID // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
+	Message               string         // (Required if 'attachments' is not set.) Text of the message.
+	Lat                   float64        // Geographical latitude of a check-in, in degrees (from -90 to 90).
+	Long                  float64        // Geographical longitude of a check-in, in degrees (from -180 to 180).
+	Attachment            string         // (Required if 'message' is not set.) List of objects attached to the message, separated by commas, in the following format: "<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, 'wall' — wall post, '<owner_id>' — ID of the media attachment owner. '<media_id>' — media attachment ID. Example: "photo100172_166443618"
+	KeepForwardMessages   bool           // '1' — to keep forwarded, messages.
+	KeepSnippets          bool           // '1' — to keep attached snippets.
+	GroupID               int64          // Group ID (for group messages with user access token)
 	DontParseLinks        bool
 	MessageID             int64
 	ConversationMessageID int64
@@ -6601,7 +9133,18 @@ type MessagesEdit struct {
 	Keyboard              string
 }
 
-func (req MessagesEdit) params() Params {
+// Method returns the API method name.
+func (req MessagesEdit) Method() string {
+	return "messages.edit"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesEdit) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesEdit) Params() Params {
 	params := make(Params)
 	if req.PeerID != 0 {
 		params["peer_id"] = req.PeerID
@@ -6655,7 +9198,18 @@ type MessagesEditChat struct {
 	Title  string // New title of the chat.
 }
 
-func (req MessagesEditChat) params() Params {
+// Method returns the API method name.
+func (req MessagesEditChat) Method() string {
+	return "messages.editChat"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesEditChat) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesEditChat) Params() Params {
 	params := make(Params)
 	if req.ChatID != 0 {
 		params["chat_id"] = req.ChatID
@@ -6672,14 +9226,25 @@ func (req MessagesEditChat) params() Params {
 //
 // https://vk.com/dev/messages.getByConversationMessageId
 type MessagesGetByConversationMessageID struct {
-	PeerID                 int64         // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
-	ConversationMessageIDs []int64       // Conversation message IDs.
-	Extended               bool          // Information whether the response should be extended
-	Fields                 []UsersFields // Profile fields to return.
-	GroupID                int64         // Group ID (for group messages with group access token)
+	PeerID                 runtime.PeerID // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
+	ConversationMessageIDs []int64        // Conversation message IDs.
+	Extended               bool           // Information whether the response should be extended
+	Fields                 []UsersFields  // Profile fields to return.
+	GroupID                int64          // Group ID (for group messages with group access token)
+}
+
+// Method returns the API method name.
+func (req MessagesGetByConversationMessageID) Method() string {
+	return "messages.getByConversationMessageId"
 }
 
-func (req MessagesGetByConversationMessageID) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesGetByConversationMessageID) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesGetByConversationMessageID) Params() Params {
 	params := make(Params)
 	if req.PeerID != 0 {
 		params["peer_id"] = req.PeerID
@@ -6712,7 +9277,18 @@ type MessagesGetByID struct {
 	GroupID       int64         // Group ID (for group messages with group access token)
 }
 
-func (req MessagesGetByID) params() Params {
+// Method returns the API method name.
+func (req MessagesGetByID) Method() string {
+	return "messages.getById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesGetByID) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesGetByID) Params() Params {
 	params := make(Params)
 	if len(req.MessageIDs) > 0 {
 		params["message_ids"] = req.MessageIDs
@@ -6736,12 +9312,23 @@ func (req MessagesGetByID) params() Params {
 //
 // https://vk.com/dev/messages.getChatPreview
 type MessagesGetChatPreview struct {
-	PeerID int64
+	PeerID runtime.PeerID
 	Link   string        // Invitation link.
 	Fields []UsersFields // Profile fields to return.
 }
 
-func (req MessagesGetChatPreview) params() Params {
+// Method returns the API method name.
+func (req MessagesGetChatPreview) Method() string {
+	return "messages.getChatPreview"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesGetChatPreview) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MessagesGetChatPreview) Params() Params {
 	params := make(Params)
 	if req.PeerID != 0 {
 		params["peer_id"] = req.PeerID
@@ -6761,12 +9348,23 @@ func (req MessagesGetChatPreview) params() Params {
 //
 // https://vk.com/dev/messages.getConversationMembers
 type MessagesGetConversationMembers struct {
-	PeerID  int64         // Peer ID.
-	Fields  []UsersFields // Profile fields to return.
-	GroupID int64         // Group ID (for group messages with group access token)
+	PeerID  runtime.PeerID // Peer ID.
+	Fields  []UsersFields  // Profile fields to return.
+	GroupID int64          // Group ID (for group messages with group access token)
+}
+
+// Method returns the API method name.
+func (req MessagesGetConversationMembers) Method() string {
+	return "messages.getConversationMembers"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesGetConversationMembers) AccessTokenType() []string {
+	return []string{"user", "group"}
 }
 
-func (req MessagesGetConversationMembers) params() Params {
+// Params returns the set parameters.
+func (req MessagesGetConversationMembers) Params() Params {
 	params := make(Params)
 	if req.PeerID != 0 {
 		params["peer_id"] = req.PeerID
@@ -6795,7 +9393,18 @@ type MessagesGetConversations struct {
 	GroupID        int64                 // Group ID (for group messages with group access token)
 }
 
-func (req MessagesGetConversations) params() Params {
+// Method returns the API method name.
+func (req MessagesGetConversations) Method() string {
+	return "messages.getConversations"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesGetConversations) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesGetConversations) Params() Params {
 	params := make(Params)
 	if req.Offset != 0 {
 		params["offset"] = req.Offset
@@ -6827,13 +9436,24 @@ func (req MessagesGetConversations) params() Params {
 //
 // https://vk.com/dev/messages.getConversationsById
 type MessagesGetConversationsByID struct {
-	PeerIDs  []int64               // Destination IDs. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
+	PeerIDs  []runtime.PeerID      // Destination IDs. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
 	Extended bool                  // Return extended properties
 	Fields   []BaseUserGroupFields // Profile and communities fields to return.
 	GroupID  int64                 // Group ID (for group messages with group access token)
 }
 
-func (req MessagesGetConversationsByID) params() Params {
+// Method returns the API method name.
+func (req MessagesGetConversationsByID) Method() string {
+	return "messages.getConversationsById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesGetConversationsByID) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesGetConversationsByID) Params() Params {
 	params := make(Params)
 	if len(req.PeerIDs) > 0 {
 		params["peer_ids"] = req.PeerIDs
@@ -6859,7 +9479,7 @@ type MessagesGetHistory struct {
 	Offset         int64 // Offset needed to return a specific subset of messages.
 	Count          int64 // Number of messages to return.
 	UserID         int64 // ID of the user whose message history you want to return.
-	PeerID         int64
+	PeerID         runtime.PeerID
 	StartMessageID int64         // Starting message ID from which to return history.
 	Rev            int64         // Sort order: '1' — return messages in chronological order. '0' — return messages in reverse chronological order.
 	Extended       bool          // Information whether the response should be extended
@@ -6867,7 +9487,18 @@ type MessagesGetHistory struct {
 	GroupID        int64         // Group ID (for group messages with group access token)
 }
 
-func (req MessagesGetHistory) params() Params {
+// Method returns the API method name.
+func (req MessagesGetHistory) Method() string {
+	return "messages.getHistory"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesGetHistory) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesGetHistory) Params() Params {
 	params := make(Params)
 	if req.Offset != 0 {
 		params["offset"] = req.Offset
@@ -6905,18 +9536,29 @@ func (req MessagesGetHistory) params() Params {
 //
 // https://vk.com/dev/messages.getHistoryAttachments
 type MessagesGetHistoryAttachments struct {
-	PeerID           int64         // Peer ID. ", For group chat: '2000000000 + chat ID' , , For community: '-community ID'"
-	MediaType        string        // Type of media files to return: *'photo',, *'video',, *'audio',, *'doc',, *'link'.,*'market'.,*'wall'.,*'share'
-	StartFrom        string        // Message ID to start return results from.
-	Count            int64         // Number of objects to return.
-	PhotoSizes       bool          // '1' — to return photo sizes in a
-	Fields           []UsersFields // Additional profile [vk.com/dev/fields|fields] to return.
-	GroupID          int64         // Group ID (for group messages with group access token)
+	PeerID           runtime.PeerID // Peer ID. ", For group chat: '2000000000 + chat ID' , , For community: '-community ID'"
+	MediaType        string         // Type of media files to return: *'photo',, *'video',, *'audio',, *'doc',, *'link'.,*'market'.,*'wall'.,*'share'
+	StartFrom        string         // Message ID to start return results from.
+	Count            int64          // Number of objects to return.
+	PhotoSizes       bool           // '1' — to return photo sizes in a
+	Fields           []UsersFields  // Additional profile [vk.com/dev/fields|fields] to return.
+	GroupID          int64          // Group ID (for group messages with group access token)
 	PreserveOrder    bool
 	MaxForwardsLevel int64
 }
 
-func (req MessagesGetHistoryAttachments) params() Params {
+// Method returns the API method name.
+func (req MessagesGetHistoryAttachments) Method() string {
+	return "messages.getHistoryAttachments"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesGetHistoryAttachments) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesGetHistoryAttachments) Params() Params {
 	params := make(Params)
 	if req.PeerID != 0 {
 		params["peer_id"] = req.PeerID
@@ -6952,12 +9594,23 @@ func (req MessagesGetHistoryAttachments) params() Params {
 //
 // https://vk.com/dev/messages.getInviteLink
 type MessagesGetInviteLink struct {
-	PeerID  int64 // Destination ID.
-	Reset   bool  // 1 — to generate new link (revoke previous), 0 — to return previous link.
-	GroupID int64 // Group ID
+	PeerID  runtime.PeerID // Destination ID.
+	Reset   bool           // 1 — to generate new link (revoke previous), 0 — to return previous link.
+	GroupID int64          // Group ID
+}
+
+// Method returns the API method name.
+func (req MessagesGetInviteLink) Method() string {
+	return "messages.getInviteLink"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesGetInviteLink) AccessTokenType() []string {
+	return []string{"user", "group"}
 }
 
-func (req MessagesGetInviteLink) params() Params {
+// Params returns the set parameters.
+func (req MessagesGetInviteLink) Params() Params {
 	params := make(Params)
 	if req.PeerID != 0 {
 		params["peer_id"] = req.PeerID
@@ -6980,7 +9633,18 @@ type MessagesGetLastActivity struct {
 	UserID int64 // User ID.
 }
 
-func (req MessagesGetLastActivity) params() Params {
+// Method returns the API method name.
+func (req MessagesGetLastActivity) Method() string {
+	return "messages.getLastActivity"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesGetLastActivity) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MessagesGetLastActivity) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -7008,7 +9672,18 @@ type MessagesGetLongPollHistory struct {
 	Credentials   bool
 }
 
-func (req MessagesGetLongPollHistory) params() Params {
+// Method returns the API method name.
+func (req MessagesGetLongPollHistory) Method() string {
+	return "messages.getLongPollHistory"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesGetLongPollHistory) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesGetLongPollHistory) Params() Params {
 	params := make(Params)
 	if req.Ts != 0 {
 		params["ts"] = req.Ts
@@ -7060,7 +9735,18 @@ type MessagesGetLongPollServer struct {
 	LpVersion int64 // Long poll version
 }
 
-func (req MessagesGetLongPollServer) params() Params {
+// Method returns the API method name.
+func (req MessagesGetLongPollServer) Method() string {
+	return "messages.getLongPollServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesGetLongPollServer) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesGetLongPollServer) Params() Params {
 	params := make(Params)
 	if req.NeedPts {
 		params["need_pts"] = req.NeedPts
@@ -7084,7 +9770,18 @@ type MessagesIsMessagesFromGroupAllowed struct {
 	UserID  int64 // User ID.
 }
 
-func (req MessagesIsMessagesFromGroupAllowed) params() Params {
+// Method returns the API method name.
+func (req MessagesIsMessagesFromGroupAllowed) Method() string {
+	return "messages.isMessagesFromGroupAllowed"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesIsMessagesFromGroupAllowed) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesIsMessagesFromGroupAllowed) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -7102,7 +9799,18 @@ type MessagesJoinChatByInviteLink struct {
 	Link string // Invitation link.
 }
 
-func (req MessagesJoinChatByInviteLink) params() Params {
+// Method returns the API method name.
+func (req MessagesJoinChatByInviteLink) Method() string {
+	return "messages.joinChatByInviteLink"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesJoinChatByInviteLink) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MessagesJoinChatByInviteLink) Params() Params {
 	params := make(Params)
 	if req.Link != "" {
 		params["link"] = req.Link
@@ -7116,12 +9824,23 @@ func (req MessagesJoinChatByInviteLink) params() Params {
 //
 // https://vk.com/dev/messages.markAsAnsweredConversation
 type MessagesMarkAsAnsweredConversation struct {
-	PeerID   int64 // ID of conversation to mark as important.
-	Answered bool  // '1' — to mark as answered, '0' — to remove the mark
-	GroupID  int64 // Group ID (for group messages with group access token)
+	PeerID   runtime.PeerID // ID of conversation to mark as important.
+	Answered bool           // '1' — to mark as answered, '0' — to remove the mark
+	GroupID  int64          // Group ID (for group messages with group access token)
+}
+
+// Method returns the API method name.
+func (req MessagesMarkAsAnsweredConversation) Method() string {
+	return "messages.markAsAnsweredConversation"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesMarkAsAnsweredConversation) AccessTokenType() []string {
+	return []string{"user", "group"}
 }
 
-func (req MessagesMarkAsAnsweredConversation) params() Params {
+// Params returns the set parameters.
+func (req MessagesMarkAsAnsweredConversation) Params() Params {
 	params := make(Params)
 	if req.PeerID != 0 {
 		params["peer_id"] = req.PeerID
@@ -7145,7 +9864,18 @@ type MessagesMarkAsImportant struct {
 	Important  int64   // '1' — to add a star (mark as important), '0' — to remove the star
 }
 
-func (req MessagesMarkAsImportant) params() Params {
+// Method returns the API method name.
+func (req MessagesMarkAsImportant) Method() string {
+	return "messages.markAsImportant"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesMarkAsImportant) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req MessagesMarkAsImportant) Params() Params {
 	params := make(Params)
 	if len(req.MessageIDs) > 0 {
 		params["message_ids"] = req.MessageIDs
@@ -7162,12 +9892,23 @@ func (req MessagesMarkAsImportant) params() Params {
 //
 // https://vk.com/dev/messages.markAsImportantConversation
 type MessagesMarkAsImportantConversation struct {
-	PeerID    int64 // ID of conversation to mark as important.
-	Important bool  // '1' — to add a star (mark as important), '0' — to remove the star
-	GroupID   int64 // Group ID (for group messages with group access token)
+	PeerID    runtime.PeerID // ID of conversation to mark as important.
+	Important bool           // '1' — to add a star (mark as important), '0' — to remove the star
+	GroupID   int64          // Group ID (for group messages with group access token)
+}
+
+// Method returns the API method name.
+func (req MessagesMarkAsImportantConversation) Method() string {
+	return "messages.markAsImportantConversation"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesMarkAsImportantConversation) AccessTokenType() []string {
+	return []string{"user", "group"}
 }
 
-func (req MessagesMarkAsImportantConversation) params() Params {
+// Params returns the set parameters.
+func (req MessagesMarkAsImportantConversation) Params() Params {
 	params := make(Params)
 	if req.PeerID != 0 {
 		params["peer_id"] = req.PeerID
@@ -7187,14 +9928,25 @@ func (req MessagesMarkAsImportantConversation) params() Params {
 //
 // https://vk.com/dev/messages.markAsRead
 type MessagesMarkAsRead struct {
-	MessageIDs             []int64 // IDs of messages to mark as read.
-	PeerID                 int64   // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
-	StartMessageID         int64   // Message ID to start from.
-	GroupID                int64   // Group ID (for group messages with user access token)
+	MessageIDs             []int64        // IDs of messages to mark as read.
+	PeerID                 runtime.PeerID // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
+	StartMessageID         int64          // Message ID to start from.
+	GroupID                int64          // Group ID (for group messages with user access token)
 	MarkConversationAsRead bool
 }
 
-func (req MessagesMarkAsRead) params() Params {
+// Method returns the API method name.
+func (req MessagesMarkAsRead) Method() string {
+	return "messages.markAsRead"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesMarkAsRead) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesMarkAsRead) Params() Params {
 	params := make(Params)
 	if len(req.MessageIDs) > 0 {
 		params["message_ids"] = req.MessageIDs
@@ -7220,11 +9972,22 @@ func (req MessagesMarkAsRead) params() Params {
 //
 // https://vk.com/dev/messages.pin
 type MessagesPin struct {
-	PeerID    int64 // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'Chat ID', e.g. '2000000001'. For community: '- Community ID', e.g. '-12345'. "
+	PeerID    runtime.PeerID // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'Chat ID', e.g. '2000000001'. For community: '- Community ID', e.g. '-12345'. "
 	MessageID int64
 }
 
-func (req MessagesPin) params() Params {
+// Method returns the API method name.
+func (req MessagesPin) Method() string {
+	return "messages.pin"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesPin) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesPin) Params() Params {
 	params := make(Params)
 	if req.PeerID != 0 {
 		params["peer_id"] = req.PeerID
@@ -7246,7 +10009,18 @@ type MessagesRemoveChatUser struct {
 	MemberID int64
 }
 
-func (req MessagesRemoveChatUser) params() Params {
+// Method returns the API method name.
+func (req MessagesRemoveChatUser) Method() string {
+	return "messages.removeChatUser"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesRemoveChatUser) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesRemoveChatUser) Params() Params {
 	params := make(Params)
 	if req.ChatID != 0 {
 		params["chat_id"] = req.ChatID
@@ -7270,7 +10044,18 @@ type MessagesRestore struct {
 	GroupID   int64 // Group ID (for group messages with user access token)
 }
 
-func (req MessagesRestore) params() Params {
+// Method returns the API method name.
+func (req MessagesRestore) Method() string {
+	return "messages.restore"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesRestore) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesRestore) Params() Params {
 	params := make(Params)
 	if req.MessageID != 0 {
 		params["message_id"] = req.MessageID
@@ -7287,18 +10072,29 @@ func (req MessagesRestore) params() Params {
 //
 // https://vk.com/dev/messages.search
 type MessagesSearch struct {
-	Q             string // Search query string.
-	PeerID        int64  // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
-	Date          int64  // Date to search message before in Unixtime.
-	PreviewLength int64  // Number of characters after which to truncate a previewed message. To preview the full message, specify '0'. "NOTE: Messages are not truncated by default. Messages are truncated by words."
-	Offset        int64  // Offset needed to return a specific subset of messages.
-	Count         int64  // Number of messages to return.
+	Q             string         // Search query string.
+	PeerID        runtime.PeerID // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
+	Date          int64          // Date to search message before in Unixtime.
+	PreviewLength int64          // Number of characters after which to truncate a previewed message. To preview the full message, specify '0'. "NOTE: Messages are not truncated by default. Messages are truncated by words."
+	Offset        int64          // Offset needed to return a specific subset of messages.
+	Count         int64          // Number of messages to return.
 	Extended      bool
 	Fields        []string
 	GroupID       int64 // Group ID (for group messages with group access token)
 }
 
-func (req MessagesSearch) params() Params {
+// Method returns the API method name.
+func (req MessagesSearch) Method() string {
+	return "messages.search"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesSearch) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesSearch) Params() Params {
 	params := make(Params)
 	if req.Q != "" {
 		params["q"] = req.Q
@@ -7343,7 +10139,18 @@ type MessagesSearchConversations struct {
 	GroupID  int64         // Group ID (for group messages with user access token)
 }
 
-func (req MessagesSearchConversations) params() Params {
+// Method returns the API method name.
+func (req MessagesSearchConversations) Method() string {
+	return "messages.searchConversations"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesSearchConversations) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesSearchConversations) Params() Params {
 	params := make(Params)
 	if req.Q != "" {
 		params["q"] = req.Q
@@ -7369,16 +10176,16 @@ func (req MessagesSearchConversations) params() Params {
 //
 // https://vk.com/dev/messages.send
 type MessagesSend struct {
-	UserID          int64   // User ID (by default — current user).
-	RandomID        int64   // Unique identifier to avoid resending the message.
-	PeerID          int64   // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
-	Domain          string  // User's short address (for example, 'illarionov').
-	ChatID          int64   // ID of conversation the message will relate to.
-	UserIDs         []int64 // IDs of message recipients (if new conversation shall be started).
-	Message         string  // (Required if 'attachments' is not set.) Text of the message.
-	Lat             float64 // Geographical latitude of a check-in, in degrees (from -90 to 90).
-	Long            float64 // Geographical longitude of a check-in, in degrees (from -180 to 180).
-	Attachment      string  // (Required if 'message' is not set.) List of objects attached to the message, separated by commas, in the following format: "<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, 'wall' — wall post, '<owner_id>' — ID of the media attachment owner. '<media_id>' — media attachment ID. Example: "photo100172_166443618"
+	UserID          int64          // User ID (by default — current user).
+	RandomID        int64          // Unique identifier to avoid resending the message.
+	PeerID          runtime.PeerID // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
+	Domain          string         // User's short address (for example, 'illarionov').
+	ChatID          int64          // ID of conversation the message will relate to.
+	UserIDs         []int64        // IDs of message recipients (if new conversation shall be started).
+	Message         string         // (Required if 'attachments' is not set.) Text of the message.
+	Lat             float64        // Geographical latitude of a check-in, in degrees (from -90 to 90).
+	Long            float64        // Geographical longitude of a check-in, in degrees (from -180 to 180).
+	Attachment      string         // (Required if 'message' is not set.) List of objects attached to the message, separated by commas, in the following format: "<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, 'wall' — wall post, '<owner_id>' — ID of the media attachment owner. '<media_id>' — media attachment ID. Example: "photo100172_166443618"
 	ReplyTo         int64
 	ForwardMessages []int64 // ID of forwarded messages, separated with a comma. Listed messages of the sender will be shown in the message body at the recipient's. Example: "123,431,544"
 	StickerID       int64   // Sticker id.
@@ -7391,7 +10198,18 @@ type MessagesSend struct {
 	SubscribeID     int64
 }
 
-func (req MessagesSend) params() Params {
+// Method returns the API method name.
+func (req MessagesSend) Method() string {
+	return "messages.send"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesSend) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesSend) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -7462,11 +10280,22 @@ func (req MessagesSend) params() Params {
 type MessagesSendMessageEventAnswer struct {
 	EventID   string
 	UserID    int64
-	PeerID    int64
+	PeerID    runtime.PeerID
 	EventData string
 }
 
-func (req MessagesSendMessageEventAnswer) params() Params {
+// Method returns the API method name.
+func (req MessagesSendMessageEventAnswer) Method() string {
+	return "messages.sendMessageEventAnswer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesSendMessageEventAnswer) AccessTokenType() []string {
+	return []string{"group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesSendMessageEventAnswer) Params() Params {
 	params := make(Params)
 	if req.EventID != "" {
 		params["event_id"] = req.EventID
@@ -7489,13 +10318,24 @@ func (req MessagesSendMessageEventAnswer) params() Params {
 //
 // https://vk.com/dev/messages.setActivity
 type MessagesSetActivity struct {
-	UserID  int64  // User ID.
-	Type    string // 'typing' — user has started to type.
-	PeerID  int64  // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
-	GroupID int64  // Group ID (for group messages with group access token)
+	UserID  int64          // User ID.
+	Type    string         // 'typing' — user has started to type.
+	PeerID  runtime.PeerID // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'chat_id', e.g. '2000000001'. For community: '- community ID', e.g. '-12345'. "
+	GroupID int64          // Group ID (for group messages with group access token)
 }
 
-func (req MessagesSetActivity) params() Params {
+// Method returns the API method name.
+func (req MessagesSetActivity) Method() string {
+	return "messages.setActivity"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesSetActivity) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesSetActivity) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -7521,7 +10361,18 @@ type MessagesSetChatPhoto struct {
 	File string // Upload URL from the 'response' field returned by the [vk.com/dev/photos.getChatUploadServer|photos.getChatUploadServer] method upon successfully uploading an image.
 }
 
-func (req MessagesSetChatPhoto) params() Params {
+// Method returns the API method name.
+func (req MessagesSetChatPhoto) Method() string {
+	return "messages.setChatPhoto"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesSetChatPhoto) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesSetChatPhoto) Params() Params {
 	params := make(Params)
 	if req.File != "" {
 		params["file"] = req.File
@@ -7533,11 +10384,22 @@ func (req MessagesSetChatPhoto) params() Params {
 //
 // https://vk.com/dev/messages.unpin
 type MessagesUnpin struct {
-	PeerID  int64
+	PeerID  runtime.PeerID
 	GroupID int64
 }
 
-func (req MessagesUnpin) params() Params {
+// Method returns the API method name.
+func (req MessagesUnpin) Method() string {
+	return "messages.unpin"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req MessagesUnpin) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req MessagesUnpin) Params() Params {
 	params := make(Params)
 	if req.PeerID != 0 {
 		params["peer_id"] = req.PeerID
@@ -7558,7 +10420,18 @@ type NewsfeedAddBan struct {
 	GroupIDs []int64
 }
 
-func (req NewsfeedAddBan) params() Params {
+// Method returns the API method name.
+func (req NewsfeedAddBan) Method() string {
+	return "newsfeed.addBan"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedAddBan) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedAddBan) Params() Params {
 	params := make(Params)
 	if len(req.UserIDs) > 0 {
 		params["user_ids"] = req.UserIDs
@@ -7579,7 +10452,18 @@ type NewsfeedDeleteBan struct {
 	GroupIDs []int64
 }
 
-func (req NewsfeedDeleteBan) params() Params {
+// Method returns the API method name.
+func (req NewsfeedDeleteBan) Method() string {
+	return "newsfeed.deleteBan"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedDeleteBan) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedDeleteBan) Params() Params {
 	params := make(Params)
 	if len(req.UserIDs) > 0 {
 		params["user_ids"] = req.UserIDs
@@ -7597,7 +10481,18 @@ type NewsfeedDeleteList struct {
 	ListID int64
 }
 
-func (req NewsfeedDeleteList) params() Params {
+// Method returns the API method name.
+func (req NewsfeedDeleteList) Method() string {
+	return "newsfeed.deleteList"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedDeleteList) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedDeleteList) Params() Params {
 	params := make(Params)
 	if req.ListID != 0 {
 		params["list_id"] = req.ListID
@@ -7623,7 +10518,18 @@ type NewsfeedGet struct {
 	Section      string
 }
 
-func (req NewsfeedGet) params() Params {
+// Method returns the API method name.
+func (req NewsfeedGet) Method() string {
+	return "newsfeed.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedGet) Params() Params {
 	params := make(Params)
 	if len(req.Filters) > 0 {
 		params["filters"] = req.Filters
@@ -7669,7 +10575,18 @@ type NewsfeedGetBanned struct {
 	NameCase string        // Case for declension of user name and surname: 'nom' — nominative (default), 'gen' — genitive , 'dat' — dative, 'acc' — accusative , 'ins' — instrumental , 'abl' — prepositional
 }
 
-func (req NewsfeedGetBanned) params() Params {
+// Method returns the API method name.
+func (req NewsfeedGetBanned) Method() string {
+	return "newsfeed.getBanned"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedGetBanned) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedGetBanned) Params() Params {
 	params := make(Params)
 	if req.Extended {
 		params["extended"] = req.Extended
@@ -7699,7 +10616,18 @@ type NewsfeedGetComments struct {
 	Fields            []BaseUserGroupFields // Additional fields of [vk.com/dev/fields|profiles] and [vk.com/dev/fields_groups|communities] to return.
 }
 
-func (req NewsfeedGetComments) params() Params {
+// Method returns the API method name.
+func (req NewsfeedGetComments) Method() string {
+	return "newsfeed.getComments"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedGetComments) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedGetComments) Params() Params {
 	params := make(Params)
 	if req.Count != 0 {
 		params["count"] = req.Count
@@ -7738,7 +10666,18 @@ type NewsfeedGetLists struct {
 	Extended bool    // Return additional list info
 }
 
-func (req NewsfeedGetLists) params() Params {
+// Method returns the API method name.
+func (req NewsfeedGetLists) Method() string {
+	return "newsfeed.getLists"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedGetLists) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedGetLists) Params() Params {
 	params := make(Params)
 	if len(req.ListIDs) > 0 {
 		params["list_ids"] = req.ListIDs
@@ -7755,14 +10694,25 @@ func (req NewsfeedGetLists) params() Params {
 //
 // https://vk.com/dev/newsfeed.getMentions
 type NewsfeedGetMentions struct {
-	OwnerID   int64 // Owner ID.
-	StartTime int64 // Earliest timestamp (in Unix time) of a post to return. By default, 24 hours ago.
-	EndTime   int64 // Latest timestamp (in Unix time) of a post to return. By default, the current time.
-	Offset    int64 // Offset needed to return a specific subset of posts.
-	Count     int64 // Number of posts to return.
+	OwnerID   runtime.OwnerID // Owner ID.
+	StartTime int64           // Earliest timestamp (in Unix time) of a post to return. By default, 24 hours ago.
+	EndTime   int64           // Latest timestamp (in Unix time) of a post to return. By default, the current time.
+	Offset    int64           // Offset needed to return a specific subset of posts.
+	Count     int64           // Number of posts to return.
 }
 
-func (req NewsfeedGetMentions) params() Params {
+// Method returns the API method name.
+func (req NewsfeedGetMentions) Method() string {
+	return "newsfeed.getMentions"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedGetMentions) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedGetMentions) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -7796,7 +10746,18 @@ type NewsfeedGetRecommended struct {
 	Fields    []BaseUserGroupFields // Additional fields of [vk.com/dev/fields|profiles] and [vk.com/dev/fields_groups|communities] to return.
 }
 
-func (req NewsfeedGetRecommended) params() Params {
+// Method returns the API method name.
+func (req NewsfeedGetRecommended) Method() string {
+	return "newsfeed.getRecommended"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedGetRecommended) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedGetRecommended) Params() Params {
 	params := make(Params)
 	if req.StartTime != 0 {
 		params["start_time"] = req.StartTime
@@ -7831,7 +10792,18 @@ type NewsfeedGetSuggestedSources struct {
 	Fields  []BaseUserGroupFields // list of extra fields to be returned. See available fields for [vk.com/dev/fields|users] and [vk.com/dev/fields_groups|communities].
 }
 
-func (req NewsfeedGetSuggestedSources) params() Params {
+// Method returns the API method name.
+func (req NewsfeedGetSuggestedSources) Method() string {
+	return "newsfeed.getSuggestedSources"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedGetSuggestedSources) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedGetSuggestedSources) Params() Params {
 	params := make(Params)
 	if req.Offset != 0 {
 		params["offset"] = req.Offset
@@ -7855,11 +10827,22 @@ func (req NewsfeedGetSuggestedSources) params() Params {
 // https://vk.com/dev/newsfeed.ignoreItem
 type NewsfeedIgnoreItem struct {
 	Type    *NewsfeedIgnoreItemType // Item type. Possible values: *'wall' – post on the wall,, *'tag' – tag on a photo,, *'profilephoto' – profile photo,, *'video' – video,, *'audio' – audio.
-	OwnerID int64                   // Item owner's identifier (user or community), "Note that community id must be negative. 'owner_id=1' – user , 'owner_id=-1' – community "
+	OwnerID runtime.OwnerID         // Item owner's identifier (user or community), "Note that community id must be negative. 'owner_id=1' – user , 'owner_id=-1' – community "
 	ItemID  int64                   // Item identifier
 }
 
-func (req NewsfeedIgnoreItem) params() Params {
+// Method returns the API method name.
+func (req NewsfeedIgnoreItem) Method() string {
+	return "newsfeed.ignoreItem"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedIgnoreItem) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedIgnoreItem) Params() Params {
 	params := make(Params)
 	if req.Type != nil {
 		params["type"] = req.Type
@@ -7885,7 +10868,18 @@ type NewsfeedSaveList struct {
 	NoReposts bool    // reposts display on and off ('1' is for off).
 }
 
-func (req NewsfeedSaveList) params() Params {
+// Method returns the API method name.
+func (req NewsfeedSaveList) Method() string {
+	return "newsfeed.saveList"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedSaveList) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedSaveList) Params() Params {
 	params := make(Params)
 	if req.ListID != 0 {
 		params["list_id"] = req.ListID
@@ -7919,7 +10913,18 @@ type NewsfeedSearch struct {
 	Fields    []BaseUserGroupFields // Additional fields of [vk.com/dev/fields|profiles] and [vk.com/dev/fields_groups|communities] to return.
 }
 
-func (req NewsfeedSearch) params() Params {
+// Method returns the API method name.
+func (req NewsfeedSearch) Method() string {
+	return "newsfeed.search"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedSearch) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedSearch) Params() Params {
 	params := make(Params)
 	if req.Q != "" {
 		params["q"] = req.Q
@@ -7958,12 +10963,23 @@ func (req NewsfeedSearch) params() Params {
 // https://vk.com/dev/newsfeed.unignoreItem
 type NewsfeedUnignoreItem struct {
 	Type      *NewsfeedIgnoreItemType // Item type. Possible values: *'wall' – post on the wall,, *'tag' – tag on a photo,, *'profilephoto' – profile photo,, *'video' – video,, *'audio' – audio.
-	OwnerID   int64                   // Item owner's identifier (user or community), "Note that community id must be negative. 'owner_id=1' – user , 'owner_id=-1' – community "
+	OwnerID   runtime.OwnerID         // Item owner's identifier (user or community), "Note that community id must be negative. 'owner_id=1' – user , 'owner_id=-1' – community "
 	ItemID    int64                   // Item identifier
 	TrackCode string                  // Track code of unignored item
 }
 
-func (req NewsfeedUnignoreItem) params() Params {
+// Method returns the API method name.
+func (req NewsfeedUnignoreItem) Method() string {
+	return "newsfeed.unignoreItem"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedUnignoreItem) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NewsfeedUnignoreItem) Params() Params {
 	params := make(Params)
 	if req.Type != nil {
 		params["type"] = req.Type
@@ -7986,12 +11002,23 @@ func (req NewsfeedUnignoreItem) params() Params {
 //
 // https://vk.com/dev/newsfeed.unsubscribe
 type NewsfeedUnsubscribe struct {
-	Type    string // Type of object from which to unsubscribe: 'note' — note, 'photo' — photo, 'post' — post on user wall or community wall, 'topic' — topic, 'video' — video
-	OwnerID int64  // Object owner ID.
-	ItemID  int64  // Object ID.
+	Type    string          // Type of object from which to unsubscribe: 'note' — note, 'photo' — photo, 'post' — post on user wall or community wall, 'topic' — topic, 'video' — video
+	OwnerID runtime.OwnerID // Object owner ID.
+	ItemID  int64           // Object ID.
+}
+
+// Method returns the API method name.
+func (req NewsfeedUnsubscribe) Method() string {
+	return "newsfeed.unsubscribe"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NewsfeedUnsubscribe) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req NewsfeedUnsubscribe) params() Params {
+// Params returns the set parameters.
+func (req NewsfeedUnsubscribe) Params() Params {
 	params := make(Params)
 	if req.Type != "" {
 		params["type"] = req.Type
@@ -8017,7 +11044,18 @@ type NotesAdd struct {
 	PrivacyComment []string
 }
 
-func (req NotesAdd) params() Params {
+// Method returns the API method name.
+func (req NotesAdd) Method() string {
+	return "notes.add"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NotesAdd) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NotesAdd) Params() Params {
 	params := make(Params)
 	if req.Title != "" {
 		params["title"] = req.Title
@@ -8040,14 +11078,25 @@ func (req NotesAdd) params() Params {
 //
 // https://vk.com/dev/notes.createComment
 type NotesCreateComment struct {
-	NoteID  int64  // Note ID.
-	OwnerID int64  // Note owner ID.
-	ReplyTo int64  // ID of the user to whom the reply is addressed (if the comment is a reply to another comment).
-	Message string // Comment text.
+	NoteID  int64           // Note ID.
+	OwnerID runtime.OwnerID // Note owner ID.
+	ReplyTo int64           // ID of the user to whom the reply is addressed (if the comment is a reply to another comment).
+	Message string          // Comment text.
 	Guid    string
 }
 
-func (req NotesCreateComment) params() Params {
+// Method returns the API method name.
+func (req NotesCreateComment) Method() string {
+	return "notes.createComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NotesCreateComment) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NotesCreateComment) Params() Params {
 	params := make(Params)
 	if req.NoteID != 0 {
 		params["note_id"] = req.NoteID
@@ -8076,7 +11125,18 @@ type NotesDelete struct {
 	NoteID int64 // Note ID.
 }
 
-func (req NotesDelete) params() Params {
+// Method returns the API method name.
+func (req NotesDelete) Method() string {
+	return "notes.delete"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NotesDelete) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NotesDelete) Params() Params {
 	params := make(Params)
 	if req.NoteID != 0 {
 		params["note_id"] = req.NoteID
@@ -8090,11 +11150,22 @@ func (req NotesDelete) params() Params {
 //
 // https://vk.com/dev/notes.deleteComment
 type NotesDeleteComment struct {
-	CommentID int64 // Comment ID.
-	OwnerID   int64 // Note owner ID.
+	CommentID int64           // Comment ID.
+	OwnerID   runtime.OwnerID // Note owner ID.
+}
+
+// Method returns the API method name.
+func (req NotesDeleteComment) Method() string {
+	return "notes.deleteComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NotesDeleteComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req NotesDeleteComment) params() Params {
+// Params returns the set parameters.
+func (req NotesDeleteComment) Params() Params {
 	params := make(Params)
 	if req.CommentID != 0 {
 		params["comment_id"] = req.CommentID
@@ -8118,7 +11189,18 @@ type NotesEdit struct {
 	PrivacyComment []string
 }
 
-func (req NotesEdit) params() Params {
+// Method returns the API method name.
+func (req NotesEdit) Method() string {
+	return "notes.edit"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NotesEdit) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NotesEdit) Params() Params {
 	params := make(Params)
 	if req.NoteID != 0 {
 		params["note_id"] = req.NoteID
@@ -8144,12 +11226,23 @@ func (req NotesEdit) params() Params {
 //
 // https://vk.com/dev/notes.editComment
 type NotesEditComment struct {
-	CommentID int64  // Comment ID.
-	OwnerID   int64  // Note owner ID.
-	Message   string // New comment text.
+	CommentID int64           // Comment ID.
+	OwnerID   runtime.OwnerID // Note owner ID.
+	Message   string          // New comment text.
+}
+
+// Method returns the API method name.
+func (req NotesEditComment) Method() string {
+	return "notes.editComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NotesEditComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req NotesEditComment) params() Params {
+// Params returns the set parameters.
+func (req NotesEditComment) Params() Params {
 	params := make(Params)
 	if req.CommentID != 0 {
 		params["comment_id"] = req.CommentID
@@ -8176,7 +11269,18 @@ type NotesGet struct {
 	Sort    int64
 }
 
-func (req NotesGet) params() Params {
+// Method returns the API method name.
+func (req NotesGet) Method() string {
+	return "notes.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NotesGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NotesGet) Params() Params {
 	params := make(Params)
 	if len(req.NoteIDs) > 0 {
 		params["note_ids"] = req.NoteIDs
@@ -8202,12 +11306,23 @@ func (req NotesGet) params() Params {
 //
 // https://vk.com/dev/notes.getById
 type NotesGetByID struct {
-	NoteID   int64 // Note ID.
-	OwnerID  int64 // Note owner ID.
+	NoteID   int64           // Note ID.
+	OwnerID  runtime.OwnerID // Note owner ID.
 	NeedWiki bool
 }
 
-func (req NotesGetByID) params() Params {
+// Method returns the API method name.
+func (req NotesGetByID) Method() string {
+	return "notes.getById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NotesGetByID) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NotesGetByID) Params() Params {
 	params := make(Params)
 	if req.NoteID != 0 {
 		params["note_id"] = req.NoteID
@@ -8227,14 +11342,25 @@ func (req NotesGetByID) params() Params {
 //
 // https://vk.com/dev/notes.getComments
 type NotesGetComments struct {
-	NoteID  int64 // Note ID.
-	OwnerID int64 // Note owner ID.
+	NoteID  int64           // Note ID.
+	OwnerID runtime.OwnerID // Note owner ID.
 	Sort    int64
 	Offset  int64
 	Count   int64 // Number of comments to return.
 }
 
-func (req NotesGetComments) params() Params {
+// Method returns the API method name.
+func (req NotesGetComments) Method() string {
+	return "notes.getComments"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NotesGetComments) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NotesGetComments) Params() Params {
 	params := make(Params)
 	if req.NoteID != 0 {
 		params["note_id"] = req.NoteID
@@ -8260,11 +11386,22 @@ func (req NotesGetComments) params() Params {
 //
 // https://vk.com/dev/notes.restoreComment
 type NotesRestoreComment struct {
-	CommentID int64 // Comment ID.
-	OwnerID   int64 // Note owner ID.
+	CommentID int64           // Comment ID.
+	OwnerID   runtime.OwnerID // Note owner ID.
+}
+
+// Method returns the API method name.
+func (req NotesRestoreComment) Method() string {
+	return "notes.restoreComment"
 }
 
-func (req NotesRestoreComment) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req NotesRestoreComment) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NotesRestoreComment) Params() Params {
 	params := make(Params)
 	if req.CommentID != 0 {
 		params["comment_id"] = req.CommentID
@@ -8288,7 +11425,18 @@ type NotificationsGet struct {
 	EndTime   int64    // Latest timestamp (in Unix time) of a notification to return. By default, the current time.
 }
 
-func (req NotificationsGet) params() Params {
+// Method returns the API method name.
+func (req NotificationsGet) Method() string {
+	return "notifications.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NotificationsGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NotificationsGet) Params() Params {
 	params := make(Params)
 	if req.Count != 0 {
 		params["count"] = req.Count
@@ -8316,7 +11464,18 @@ func (req NotificationsGet) params() Params {
 type NotificationsMarkAsViewed struct {
 }
 
-func (req NotificationsMarkAsViewed) params() Params {
+// Method returns the API method name.
+func (req NotificationsMarkAsViewed) Method() string {
+	return "notifications.markAsViewed"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NotificationsMarkAsViewed) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req NotificationsMarkAsViewed) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -8332,7 +11491,18 @@ type NotificationsSendMessage struct {
 	RandomID int64
 }
 
-func (req NotificationsSendMessage) params() Params {
+// Method returns the API method name.
+func (req NotificationsSendMessage) Method() string {
+	return "notifications.sendMessage"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req NotificationsSendMessage) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req NotificationsSendMessage) Params() Params {
 	params := make(Params)
 	if len(req.UserIDs) > 0 {
 		params["user_ids"] = req.UserIDs
@@ -8361,7 +11531,18 @@ type OrdersCancelSubscription struct {
 	PendingCancel  bool
 }
 
-func (req OrdersCancelSubscription) params() Params {
+// Method returns the API method name.
+func (req OrdersCancelSubscription) Method() string {
+	return "orders.cancelSubscription"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req OrdersCancelSubscription) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req OrdersCancelSubscription) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -8387,7 +11568,18 @@ type OrdersChangeState struct {
 	TestMode   bool   // if this parameter is set to 1, this method returns a list of test mode orders. By default — 0.
 }
 
-func (req OrdersChangeState) params() Params {
+// Method returns the API method name.
+func (req OrdersChangeState) Method() string {
+	return "orders.changeState"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req OrdersChangeState) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req OrdersChangeState) Params() Params {
 	params := make(Params)
 	if req.OrderID != 0 {
 		params["order_id"] = req.OrderID
@@ -8415,7 +11607,18 @@ type OrdersGet struct {
 	TestMode bool  // if this parameter is set to 1, this method returns a list of test mode orders. By default — 0.
 }
 
-func (req OrdersGet) params() Params {
+// Method returns the API method name.
+func (req OrdersGet) Method() string {
+	return "orders.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req OrdersGet) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req OrdersGet) Params() Params {
 	params := make(Params)
 	if req.Offset != 0 {
 		params["offset"] = req.Offset
@@ -8437,7 +11640,18 @@ type OrdersGetAmount struct {
 	Votes  []string
 }
 
-func (req OrdersGetAmount) params() Params {
+// Method returns the API method name.
+func (req OrdersGetAmount) Method() string {
+	return "orders.getAmount"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req OrdersGetAmount) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req OrdersGetAmount) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -8459,7 +11673,18 @@ type OrdersGetByID struct {
 	TestMode bool    // if this parameter is set to 1, this method returns a list of test mode orders. By default — 0.
 }
 
-func (req OrdersGetByID) params() Params {
+// Method returns the API method name.
+func (req OrdersGetByID) Method() string {
+	return "orders.getById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req OrdersGetByID) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req OrdersGetByID) Params() Params {
 	params := make(Params)
 	if req.OrderID != 0 {
 		params["order_id"] = req.OrderID
@@ -8481,7 +11706,18 @@ type OrdersGetUserSubscriptionByID struct {
 	SubscriptionID int64
 }
 
-func (req OrdersGetUserSubscriptionByID) params() Params {
+// Method returns the API method name.
+func (req OrdersGetUserSubscriptionByID) Method() string {
+	return "orders.getUserSubscriptionById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req OrdersGetUserSubscriptionByID) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req OrdersGetUserSubscriptionByID) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -8499,7 +11735,18 @@ type OrdersGetUserSubscriptions struct {
 	UserID int64
 }
 
-func (req OrdersGetUserSubscriptions) params() Params {
+// Method returns the API method name.
+func (req OrdersGetUserSubscriptions) Method() string {
+	return "orders.getUserSubscriptions"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req OrdersGetUserSubscriptions) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req OrdersGetUserSubscriptions) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -8516,7 +11763,18 @@ type OrdersUpdateSubscription struct {
 	Price          int64
 }
 
-func (req OrdersUpdateSubscription) params() Params {
+// Method returns the API method name.
+func (req OrdersUpdateSubscription) Method() string {
+	return "orders.updateSubscription"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req OrdersUpdateSubscription) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req OrdersUpdateSubscription) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -8539,7 +11797,18 @@ type PagesClearCache struct {
 	URL string // Address of the page where you need to refesh the cached version
 }
 
-func (req PagesClearCache) params() Params {
+// Method returns the API method name.
+func (req PagesClearCache) Method() string {
+	return "pages.clearCache"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PagesClearCache) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req PagesClearCache) Params() Params {
 	params := make(Params)
 	if req.URL != "" {
 		params["url"] = req.URL
@@ -8553,16 +11822,27 @@ func (req PagesClearCache) params() Params {
 //
 // https://vk.com/dev/pages.get
 type PagesGet struct {
-	OwnerID     int64  // Page owner ID.
-	PageID      int64  // Wiki page ID.
-	Global      bool   // '1' — to return information about a global wiki page
-	SitePreview bool   // '1' — resulting wiki page is a preview for the attached link
-	Title       string // Wiki page title.
+	OwnerID     runtime.OwnerID // Page owner ID.
+	PageID      int64           // Wiki page ID.
+	Global      bool            // '1' — to return information about a global wiki page
+	SitePreview bool            // '1' — resulting wiki page is a preview for the attached link
+	Title       string          // Wiki page title.
 	NeedSource  bool
 	NeedHtml    bool // '1' — to return the page as HTML,
 }
 
-func (req PagesGet) params() Params {
+// Method returns the API method name.
+func (req PagesGet) Method() string {
+	return "pages.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PagesGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PagesGet) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -8599,7 +11879,18 @@ type PagesGetHistory struct {
 	UserID  int64
 }
 
-func (req PagesGetHistory) params() Params {
+// Method returns the API method name.
+func (req PagesGetHistory) Method() string {
+	return "pages.getHistory"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PagesGetHistory) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PagesGetHistory) Params() Params {
 	params := make(Params)
 	if req.PageID != 0 {
 		params["page_id"] = req.PageID
@@ -8622,7 +11913,18 @@ type PagesGetTitles struct {
 	GroupID int64 // ID of the community that owns the wiki page.
 }
 
-func (req PagesGetTitles) params() Params {
+// Method returns the API method name.
+func (req PagesGetTitles) Method() string {
+	return "pages.getTitles"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PagesGetTitles) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PagesGetTitles) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -8642,7 +11944,18 @@ type PagesGetVersion struct {
 	NeedHtml  bool // '1' — to return the page as HTML
 }
 
-func (req PagesGetVersion) params() Params {
+// Method returns the API method name.
+func (req PagesGetVersion) Method() string {
+	return "pages.getVersion"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PagesGetVersion) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PagesGetVersion) Params() Params {
 	params := make(Params)
 	if req.VersionID != 0 {
 		params["version_id"] = req.VersionID
@@ -8669,7 +11982,18 @@ type PagesParseWiki struct {
 	GroupID int64  // ID of the group in the context of which this markup is interpreted.
 }
 
-func (req PagesParseWiki) params() Params {
+// Method returns the API method name.
+func (req PagesParseWiki) Method() string {
+	return "pages.parseWiki"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PagesParseWiki) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PagesParseWiki) Params() Params {
 	params := make(Params)
 	if req.Text != "" {
 		params["text"] = req.Text
@@ -8693,7 +12017,18 @@ type PagesSave struct {
 	Title   string // Wiki page title.
 }
 
-func (req PagesSave) params() Params {
+// Method returns the API method name.
+func (req PagesSave) Method() string {
+	return "pages.save"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PagesSave) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PagesSave) Params() Params {
 	params := make(Params)
 	if req.Text != "" {
 		params["text"] = req.Text
@@ -8726,7 +12061,18 @@ type PagesSaveAccess struct {
 	Edit    int64 // Who can edit the wiki page: '1' — only community members, '2' — all users can edit the page, '0' — only community managers
 }
 
-func (req PagesSaveAccess) params() Params {
+// Method returns the API method name.
+func (req PagesSaveAccess) Method() string {
+	return "pages.saveAccess"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PagesSaveAccess) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PagesSaveAccess) Params() Params {
 	params := make(Params)
 	if req.PageID != 0 {
 		params["page_id"] = req.PageID
@@ -8752,12 +12098,23 @@ func (req PagesSaveAccess) params() Params {
 //
 // https://vk.com/dev/photos.confirmTag
 type PhotosConfirmTag struct {
-	OwnerID int64  // ID of the user or community that owns the photo.
-	PhotoID string // Photo ID.
-	TagID   int64  // Tag ID.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the photo.
+	PhotoID string          // Photo ID.
+	TagID   int64           // Tag ID.
+}
+
+// Method returns the API method name.
+func (req PhotosConfirmTag) Method() string {
+	return "photos.confirmTag"
 }
 
-func (req PhotosConfirmTag) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosConfirmTag) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosConfirmTag) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -8777,12 +12134,23 @@ func (req PhotosConfirmTag) params() Params {
 //
 // https://vk.com/dev/photos.copy
 type PhotosCopy struct {
-	OwnerID   int64  // photo's owner ID
-	PhotoID   int64  // photo ID
-	AccessKey string // for private photos
+	OwnerID   runtime.OwnerID // photo's owner ID
+	PhotoID   int64           // photo ID
+	AccessKey string          // for private photos
+}
+
+// Method returns the API method name.
+func (req PhotosCopy) Method() string {
+	return "photos.copy"
 }
 
-func (req PhotosCopy) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosCopy) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosCopy) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -8811,7 +12179,18 @@ type PhotosCreateAlbum struct {
 	CommentsDisabled   bool
 }
 
-func (req PhotosCreateAlbum) params() Params {
+// Method returns the API method name.
+func (req PhotosCreateAlbum) Method() string {
+	return "photos.createAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosCreateAlbum) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosCreateAlbum) Params() Params {
 	params := make(Params)
 	if req.Title != "" {
 		params["title"] = req.Title
@@ -8843,18 +12222,29 @@ func (req PhotosCreateAlbum) params() Params {
 //
 // https://vk.com/dev/photos.createComment
 type PhotosCreateComment struct {
-	OwnerID        int64    // ID of the user or community that owns the photo.
-	PhotoID        int64    // Photo ID.
-	Message        string   // Comment text.
-	Attachments    []string // (Required if 'message' is not set.) List of objects attached to the post, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — Media attachment owner ID. '<media_id>' — Media attachment ID. Example: "photo100172_166443618,photo66748_265827614"
-	FromGroup      bool     // '1' — to post a comment from the community
+	OwnerID        runtime.OwnerID // ID of the user or community that owns the photo.
+	PhotoID        int64           // Photo ID.
+	Message        string          // Comment text.
+	Attachments    []string        // (Required if 'message' is not set.) List of objects attached to the post, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — Media attachment owner ID. '<media_id>' — Media attachment ID. Example: "photo100172_166443618,photo66748_265827614"
+	FromGroup      bool            // '1' — to post a comment from the community
 	ReplyToComment int64
 	StickerID      int64
 	AccessKey      string
 	Guid           string
 }
 
-func (req PhotosCreateComment) params() Params {
+// Method returns the API method name.
+func (req PhotosCreateComment) Method() string {
+	return "photos.createComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosCreateComment) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosCreateComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -8892,11 +12282,22 @@ func (req PhotosCreateComment) params() Params {
 //
 // https://vk.com/dev/photos.delete
 type PhotosDelete struct {
-	OwnerID int64 // ID of the user or community that owns the photo.
-	PhotoID int64 // Photo ID.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the photo.
+	PhotoID int64           // Photo ID.
+}
+
+// Method returns the API method name.
+func (req PhotosDelete) Method() string {
+	return "photos.delete"
 }
 
-func (req PhotosDelete) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosDelete) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosDelete) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -8917,7 +12318,18 @@ type PhotosDeleteAlbum struct {
 	GroupID int64 // ID of the community that owns the album.
 }
 
-func (req PhotosDeleteAlbum) params() Params {
+// Method returns the API method name.
+func (req PhotosDeleteAlbum) Method() string {
+	return "photos.deleteAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosDeleteAlbum) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosDeleteAlbum) Params() Params {
 	params := make(Params)
 	if req.AlbumID != 0 {
 		params["album_id"] = req.AlbumID
@@ -8934,11 +12346,22 @@ func (req PhotosDeleteAlbum) params() Params {
 //
 // https://vk.com/dev/photos.deleteComment
 type PhotosDeleteComment struct {
-	OwnerID   int64 // ID of the user or community that owns the photo.
-	CommentID int64 // Comment ID.
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the photo.
+	CommentID int64           // Comment ID.
+}
+
+// Method returns the API method name.
+func (req PhotosDeleteComment) Method() string {
+	return "photos.deleteComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosDeleteComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosDeleteComment) params() Params {
+// Params returns the set parameters.
+func (req PhotosDeleteComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -8955,9 +12378,9 @@ func (req PhotosDeleteComment) params() Params {
 //
 // https://vk.com/dev/photos.edit
 type PhotosEdit struct {
-	OwnerID      int64  // ID of the user or community that owns the photo.
-	PhotoID      int64  // Photo ID.
-	Caption      string // New caption for the photo. If this parameter is not set, it is considered to be equal to an empty string.
+	OwnerID      runtime.OwnerID // ID of the user or community that owns the photo.
+	PhotoID      int64           // Photo ID.
+	Caption      string          // New caption for the photo. If this parameter is not set, it is considered to be equal to an empty string.
 	Latitude     float64
 	Longitude    float64
 	PlaceStr     string
@@ -8965,7 +12388,18 @@ type PhotosEdit struct {
 	DeletePlace  bool
 }
 
-func (req PhotosEdit) params() Params {
+// Method returns the API method name.
+func (req PhotosEdit) Method() string {
+	return "photos.edit"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosEdit) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosEdit) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9000,17 +12434,28 @@ func (req PhotosEdit) params() Params {
 //
 // https://vk.com/dev/photos.editAlbum
 type PhotosEditAlbum struct {
-	AlbumID            int64  // ID of the photo album to be edited.
-	Title              string // New album title.
-	Description        string // New album description.
-	OwnerID            int64  // ID of the user or community that owns the album.
+	AlbumID            int64           // ID of the photo album to be edited.
+	Title              string          // New album title.
+	Description        string          // New album description.
+	OwnerID            runtime.OwnerID // ID of the user or community that owns the album.
 	PrivacyView        []string
 	PrivacyComment     []string
 	UploadByAdminsOnly bool
 	CommentsDisabled   bool
 }
 
-func (req PhotosEditAlbum) params() Params {
+// Method returns the API method name.
+func (req PhotosEditAlbum) Method() string {
+	return "photos.editAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosEditAlbum) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosEditAlbum) Params() Params {
 	params := make(Params)
 	if req.AlbumID != 0 {
 		params["album_id"] = req.AlbumID
@@ -9045,13 +12490,24 @@ func (req PhotosEditAlbum) params() Params {
 //
 // https://vk.com/dev/photos.editComment
 type PhotosEditComment struct {
-	OwnerID     int64    // ID of the user or community that owns the photo.
-	CommentID   int64    // Comment ID.
-	Message     string   // New text of the comment.
-	Attachments []string // (Required if 'message' is not set.) List of objects attached to the post, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — Media attachment owner ID. '<media_id>' — Media attachment ID. Example: "photo100172_166443618,photo66748_265827614"
+	OwnerID     runtime.OwnerID // ID of the user or community that owns the photo.
+	CommentID   int64           // Comment ID.
+	Message     string          // New text of the comment.
+	Attachments []string        // (Required if 'message' is not set.) List of objects attached to the post, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — Media attachment owner ID. '<media_id>' — Media attachment ID. Example: "photo100172_166443618,photo66748_265827614"
+}
+
+// Method returns the API method name.
+func (req PhotosEditComment) Method() string {
+	return "photos.editComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosEditComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosEditComment) params() Params {
+// Params returns the set parameters.
+func (req PhotosEditComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9074,19 +12530,30 @@ func (req PhotosEditComment) params() Params {
 //
 // https://vk.com/dev/photos.get
 type PhotosGet struct {
-	OwnerID    int64    // ID of the user or community that owns the photos. Use a negative value to designate a community ID.
-	AlbumID    string   // Photo album ID. To return information about photos from service albums, use the following string values: 'profile, wall, saved'.
-	PhotoIDs   []string // Photo IDs.
-	Rev        bool     // Sort order: '1' — reverse chronological, '0' — chronological
-	Extended   bool     // '1' — to return additional 'likes', 'comments', and 'tags' fields, '0' — (default)
-	FeedType   string   // Type of feed obtained in 'feed' field of the method.
-	Feed       int64    // unixtime, that can be obtained with [vk.com/dev/newsfeed.get|newsfeed.get] method in date field to get all photos uploaded by the user on a specific day, or photos the user has been tagged on. Also, 'uid' parameter of the user the event happened with shall be specified.
-	PhotoSizes bool     // '1' — to return photo sizes in a [vk.com/dev/photo_sizes|special format]
+	OwnerID    runtime.OwnerID // ID of the user or community that owns the photos. Use a negative value to designate a community ID.
+	AlbumID    string          // Photo album ID. To return information about photos from service albums, use the following string values: 'profile, wall, saved'.
+	PhotoIDs   []string        // Photo IDs.
+	Rev        bool            // Sort order: '1' — reverse chronological, '0' — chronological
+	Extended   bool            // '1' — to return additional 'likes', 'comments', and 'tags' fields, '0' — (default)
+	FeedType   string          // Type of feed obtained in 'feed' field of the method.
+	Feed       int64           // unixtime, that can be obtained with [vk.com/dev/newsfeed.get|newsfeed.get] method in date field to get all photos uploaded by the user on a specific day, or photos the user has been tagged on. Also, 'uid' parameter of the user the event happened with shall be specified.
+	PhotoSizes bool            // '1' — to return photo sizes in a [vk.com/dev/photo_sizes|special format]
 	Offset     int64
 	Count      int64
 }
 
-func (req PhotosGet) params() Params {
+// Method returns the API method name.
+func (req PhotosGet) Method() string {
+	return "photos.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGet) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGet) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9127,16 +12594,27 @@ func (req PhotosGet) params() Params {
 //
 // https://vk.com/dev/photos.getAlbums
 type PhotosGetAlbums struct {
-	OwnerID    int64   // ID of the user or community that owns the albums.
-	AlbumIDs   []int64 // Album IDs.
-	Offset     int64   // Offset needed to return a specific subset of albums.
-	Count      int64   // Number of albums to return.
-	NeedSystem bool    // '1' — to return system albums with negative IDs
-	NeedCovers bool    // '1' — to return an additional 'thumb_src' field, '0' — (default)
-	PhotoSizes bool    // '1' — to return photo sizes in a
+	OwnerID    runtime.OwnerID // ID of the user or community that owns the albums.
+	AlbumIDs   []int64         // Album IDs.
+	Offset     int64           // Offset needed to return a specific subset of albums.
+	Count      int64           // Number of albums to return.
+	NeedSystem bool            // '1' — to return system albums with negative IDs
+	NeedCovers bool            // '1' — to return an additional 'thumb_src' field, '0' — (default)
+	PhotoSizes bool            // '1' — to return photo sizes in a
+}
+
+// Method returns the API method name.
+func (req PhotosGetAlbums) Method() string {
+	return "photos.getAlbums"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetAlbums) AccessTokenType() []string {
+	return []string{"user", "service"}
 }
 
-func (req PhotosGetAlbums) params() Params {
+// Params returns the set parameters.
+func (req PhotosGetAlbums) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9172,7 +12650,18 @@ type PhotosGetAlbumsCount struct {
 	GroupID int64 // Community ID.
 }
 
-func (req PhotosGetAlbumsCount) params() Params {
+// Method returns the API method name.
+func (req PhotosGetAlbumsCount) Method() string {
+	return "photos.getAlbumsCount"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetAlbumsCount) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGetAlbumsCount) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -9189,17 +12678,28 @@ func (req PhotosGetAlbumsCount) params() Params {
 //
 // https://vk.com/dev/photos.getAll
 type PhotosGetAll struct {
-	OwnerID         int64 // ID of a user or community that owns the photos. Use a negative value to designate a community ID.
-	Extended        bool  // '1' — to return detailed information about photos
-	Offset          int64 // Offset needed to return a specific subset of photos. By default, '0'.
-	Count           int64 // Number of photos to return.
-	PhotoSizes      bool  // '1' – to return image sizes in [vk.com/dev/photo_sizes|special format].
-	NoServiceAlbums bool  // '1' – to return photos only from standard albums, '0' – to return all photos including those in service albums, e.g., 'My wall photos' (default)
-	NeedHidden      bool  // '1' – to show information about photos being hidden from the block above the wall.
-	SkipHidden      bool  // '1' – not to return photos being hidden from the block above the wall. Works only with owner_id>0, no_service_albums is ignored.
+	OwnerID         runtime.OwnerID // ID of a user or community that owns the photos. Use a negative value to designate a community ID.
+	Extended        bool            // '1' — to return detailed information about photos
+	Offset          int64           // Offset needed to return a specific subset of photos. By default, '0'.
+	Count           int64           // Number of photos to return.
+	PhotoSizes      bool            // '1' – to return image sizes in [vk.com/dev/photo_sizes|special format].
+	NoServiceAlbums bool            // '1' – to return photos only from standard albums, '0' – to return all photos including those in service albums, e.g., 'My wall photos' (default)
+	NeedHidden      bool            // '1' – to show information about photos being hidden from the block above the wall.
+	SkipHidden      bool            // '1' – not to return photos being hidden from the block above the wall. Works only with owner_id>0, no_service_albums is ignored.
+}
+
+// Method returns the API method name.
+func (req PhotosGetAll) Method() string {
+	return "photos.getAll"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetAll) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosGetAll) params() Params {
+// Params returns the set parameters.
+func (req PhotosGetAll) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9234,14 +12734,25 @@ func (req PhotosGetAll) params() Params {
 //
 // https://vk.com/dev/photos.getAllComments
 type PhotosGetAllComments struct {
-	OwnerID   int64 // ID of the user or community that owns the album(s).
-	AlbumID   int64 // Album ID. If the parameter is not set, comments on all of the user's albums will be returned.
-	NeedLikes bool  // '1' — to return an additional 'likes' field, '0' — (default)
-	Offset    int64 // Offset needed to return a specific subset of comments. By default, '0'.
-	Count     int64 // Number of comments to return. By default, '20'. Maximum value, '100'.
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the album(s).
+	AlbumID   int64           // Album ID. If the parameter is not set, comments on all of the user's albums will be returned.
+	NeedLikes bool            // '1' — to return an additional 'likes' field, '0' — (default)
+	Offset    int64           // Offset needed to return a specific subset of comments. By default, '0'.
+	Count     int64           // Number of comments to return. By default, '20'. Maximum value, '100'.
+}
+
+// Method returns the API method name.
+func (req PhotosGetAllComments) Method() string {
+	return "photos.getAllComments"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetAllComments) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosGetAllComments) params() Params {
+// Params returns the set parameters.
+func (req PhotosGetAllComments) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9272,7 +12783,18 @@ type PhotosGetByID struct {
 	PhotoSizes bool     // '1' — to return photo sizes in a
 }
 
-func (req PhotosGetByID) params() Params {
+// Method returns the API method name.
+func (req PhotosGetByID) Method() string {
+	return "photos.getById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetByID) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGetByID) Params() Params {
 	params := make(Params)
 	if len(req.Photos) > 0 {
 		params["photos"] = req.Photos
@@ -9298,7 +12820,18 @@ type PhotosGetChatUploadServer struct {
 	CropWidth int64 // Width (in pixels) of the photo after cropping.
 }
 
-func (req PhotosGetChatUploadServer) params() Params {
+// Method returns the API method name.
+func (req PhotosGetChatUploadServer) Method() string {
+	return "photos.getChatUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetChatUploadServer) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGetChatUploadServer) Params() Params {
 	params := make(Params)
 	if req.ChatID != 0 {
 		params["chat_id"] = req.ChatID
@@ -9321,9 +12854,9 @@ func (req PhotosGetChatUploadServer) params() Params {
 //
 // https://vk.com/dev/photos.getComments
 type PhotosGetComments struct {
-	OwnerID        int64 // ID of the user or community that owns the photo.
-	PhotoID        int64 // Photo ID.
-	NeedLikes      bool  // '1' — to return an additional 'likes' field, '0' — (default)
+	OwnerID        runtime.OwnerID // ID of the user or community that owns the photo.
+	PhotoID        int64           // Photo ID.
+	NeedLikes      bool            // '1' — to return an additional 'likes' field, '0' — (default)
 	StartCommentID int64
 	Offset         int64  // Offset needed to return a specific subset of comments. By default, '0'.
 	Count          int64  // Number of comments to return.
@@ -9333,7 +12866,18 @@ type PhotosGetComments struct {
 	Fields         []UsersFields
 }
 
-func (req PhotosGetComments) params() Params {
+// Method returns the API method name.
+func (req PhotosGetComments) Method() string {
+	return "photos.getComments"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetComments) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGetComments) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9377,7 +12921,18 @@ type PhotosGetMarketAlbumUploadServer struct {
 	GroupID int64 // Community ID.
 }
 
-func (req PhotosGetMarketAlbumUploadServer) params() Params {
+// Method returns the API method name.
+func (req PhotosGetMarketAlbumUploadServer) Method() string {
+	return "photos.getMarketAlbumUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetMarketAlbumUploadServer) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGetMarketAlbumUploadServer) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -9398,7 +12953,18 @@ type PhotosGetMarketUploadServer struct {
 	CropWidth int64 // Width of the cropped photo in px.
 }
 
-func (req PhotosGetMarketUploadServer) params() Params {
+// Method returns the API method name.
+func (req PhotosGetMarketUploadServer) Method() string {
+	return "photos.getMarketUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetMarketUploadServer) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGetMarketUploadServer) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -9424,10 +12990,21 @@ func (req PhotosGetMarketUploadServer) params() Params {
 //
 // https://vk.com/dev/photos.getMessagesUploadServer
 type PhotosGetMessagesUploadServer struct {
-	PeerID int64 // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'Chat ID', e.g. '2000000001'. For community: '- Community ID', e.g. '-12345'. "
+	PeerID runtime.PeerID // Destination ID. "For user: 'User ID', e.g. '12345'. For chat: '2000000000' + 'Chat ID', e.g. '2000000001'. For community: '- Community ID', e.g. '-12345'. "
+}
+
+// Method returns the API method name.
+func (req PhotosGetMessagesUploadServer) Method() string {
+	return "photos.getMessagesUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetMessagesUploadServer) AccessTokenType() []string {
+	return []string{"user", "group"}
 }
 
-func (req PhotosGetMessagesUploadServer) params() Params {
+// Params returns the set parameters.
+func (req PhotosGetMessagesUploadServer) Params() Params {
 	params := make(Params)
 	if req.PeerID != 0 {
 		params["peer_id"] = req.PeerID
@@ -9445,7 +13022,18 @@ type PhotosGetNewTags struct {
 	Count  int64 // Number of photos to return.
 }
 
-func (req PhotosGetNewTags) params() Params {
+// Method returns the API method name.
+func (req PhotosGetNewTags) Method() string {
+	return "photos.getNewTags"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetNewTags) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGetNewTags) Params() Params {
 	params := make(Params)
 	if req.Offset != 0 {
 		params["offset"] = req.Offset
@@ -9469,7 +13057,18 @@ type PhotosGetOwnerCoverPhotoUploadServer struct {
 	CropY2  int64 // Y coordinate of the right-bottom corner
 }
 
-func (req PhotosGetOwnerCoverPhotoUploadServer) params() Params {
+// Method returns the API method name.
+func (req PhotosGetOwnerCoverPhotoUploadServer) Method() string {
+	return "photos.getOwnerCoverPhotoUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetOwnerCoverPhotoUploadServer) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGetOwnerCoverPhotoUploadServer) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -9495,10 +13094,21 @@ func (req PhotosGetOwnerCoverPhotoUploadServer) params() Params {
 //
 // https://vk.com/dev/photos.getOwnerPhotoUploadServer
 type PhotosGetOwnerPhotoUploadServer struct {
-	OwnerID int64 // identifier of a community or current user. "Note that community id must be negative. 'owner_id=1' – user, 'owner_id=-1' – community, "
+	OwnerID runtime.OwnerID // identifier of a community or current user. "Note that community id must be negative. 'owner_id=1' – user, 'owner_id=-1' – community, "
+}
+
+// Method returns the API method name.
+func (req PhotosGetOwnerPhotoUploadServer) Method() string {
+	return "photos.getOwnerPhotoUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetOwnerPhotoUploadServer) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosGetOwnerPhotoUploadServer) params() Params {
+// Params returns the set parameters.
+func (req PhotosGetOwnerPhotoUploadServer) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9512,12 +13122,23 @@ func (req PhotosGetOwnerPhotoUploadServer) params() Params {
 //
 // https://vk.com/dev/photos.getTags
 type PhotosGetTags struct {
-	OwnerID   int64 // ID of the user or community that owns the photo.
-	PhotoID   int64 // Photo ID.
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the photo.
+	PhotoID   int64           // Photo ID.
 	AccessKey string
 }
 
-func (req PhotosGetTags) params() Params {
+// Method returns the API method name.
+func (req PhotosGetTags) Method() string {
+	return "photos.getTags"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetTags) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGetTags) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9541,7 +13162,18 @@ type PhotosGetUploadServer struct {
 	AlbumID int64
 }
 
-func (req PhotosGetUploadServer) params() Params {
+// Method returns the API method name.
+func (req PhotosGetUploadServer) Method() string {
+	return "photos.getUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetUploadServer) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGetUploadServer) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -9565,7 +13197,18 @@ type PhotosGetUserPhotos struct {
 	Sort     string // Sort order: '1' — by date the tag was added in ascending order, '0' — by date the tag was added in descending order
 }
 
-func (req PhotosGetUserPhotos) params() Params {
+// Method returns the API method name.
+func (req PhotosGetUserPhotos) Method() string {
+	return "photos.getUserPhotos"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetUserPhotos) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGetUserPhotos) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -9594,7 +13237,18 @@ type PhotosGetWallUploadServer struct {
 	GroupID int64 // ID of community to whose wall the photo will be uploaded.
 }
 
-func (req PhotosGetWallUploadServer) params() Params {
+// Method returns the API method name.
+func (req PhotosGetWallUploadServer) Method() string {
+	return "photos.getWallUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosGetWallUploadServer) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosGetWallUploadServer) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -9608,12 +13262,23 @@ func (req PhotosGetWallUploadServer) params() Params {
 //
 // https://vk.com/dev/photos.makeCover
 type PhotosMakeCover struct {
-	OwnerID int64 // ID of the user or community that owns the photo.
-	PhotoID int64 // Photo ID.
-	AlbumID int64 // Album ID.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the photo.
+	PhotoID int64           // Photo ID.
+	AlbumID int64           // Album ID.
 }
 
-func (req PhotosMakeCover) params() Params {
+// Method returns the API method name.
+func (req PhotosMakeCover) Method() string {
+	return "photos.makeCover"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosMakeCover) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosMakeCover) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9633,12 +13298,23 @@ func (req PhotosMakeCover) params() Params {
 //
 // https://vk.com/dev/photos.move
 type PhotosMove struct {
-	OwnerID       int64 // ID of the user or community that owns the photo.
-	TargetAlbumID int64 // ID of the album to which the photo will be moved.
-	PhotoID       int64 // Photo ID.
+	OwnerID       runtime.OwnerID // ID of the user or community that owns the photo.
+	TargetAlbumID int64           // ID of the album to which the photo will be moved.
+	PhotoID       int64           // Photo ID.
+}
+
+// Method returns the API method name.
+func (req PhotosMove) Method() string {
+	return "photos.move"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosMove) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosMove) params() Params {
+// Params returns the set parameters.
+func (req PhotosMove) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9658,16 +13334,27 @@ func (req PhotosMove) params() Params {
 //
 // https://vk.com/dev/photos.putTag
 type PhotosPutTag struct {
-	OwnerID int64   // ID of the user or community that owns the photo.
-	PhotoID int64   // Photo ID.
-	UserID  int64   // ID of the user to be tagged.
-	X       float64 // Upper left-corner coordinate of the tagged area (as a percentage of the photo's width).
-	Y       float64 // Upper left-corner coordinate of the tagged area (as a percentage of the photo's height).
-	X2      float64 // Lower right-corner coordinate of the tagged area (as a percentage of the photo's width).
-	Y2      float64 // Lower right-corner coordinate of the tagged area (as a percentage of the photo's height).
+	OwnerID runtime.OwnerID // ID of the user or community that owns the photo.
+	PhotoID int64           // Photo ID.
+	UserID  int64           // ID of the user to be tagged.
+	X       float64         // Upper left-corner coordinate of the tagged area (as a percentage of the photo's width).
+	Y       float64         // Upper left-corner coordinate of the tagged area (as a percentage of the photo's height).
+	X2      float64         // Lower right-corner coordinate of the tagged area (as a percentage of the photo's width).
+	Y2      float64         // Lower right-corner coordinate of the tagged area (as a percentage of the photo's height).
 }
 
-func (req PhotosPutTag) params() Params {
+// Method returns the API method name.
+func (req PhotosPutTag) Method() string {
+	return "photos.putTag"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosPutTag) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosPutTag) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9699,12 +13386,23 @@ func (req PhotosPutTag) params() Params {
 //
 // https://vk.com/dev/photos.removeTag
 type PhotosRemoveTag struct {
-	OwnerID int64 // ID of the user or community that owns the photo.
-	PhotoID int64 // Photo ID.
-	TagID   int64 // Tag ID.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the photo.
+	PhotoID int64           // Photo ID.
+	TagID   int64           // Tag ID.
+}
+
+// Method returns the API method name.
+func (req PhotosRemoveTag) Method() string {
+	return "photos.removeTag"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosRemoveTag) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosRemoveTag) params() Params {
+// Params returns the set parameters.
+func (req PhotosRemoveTag) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9724,13 +13422,24 @@ func (req PhotosRemoveTag) params() Params {
 //
 // https://vk.com/dev/photos.reorderAlbums
 type PhotosReorderAlbums struct {
-	OwnerID int64 // ID of the user or community that owns the album.
-	AlbumID int64 // Album ID.
-	Before  int64 // ID of the album before which the album in question shall be placed.
-	After   int64 // ID of the album after which the album in question shall be placed.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the album.
+	AlbumID int64           // Album ID.
+	Before  int64           // ID of the album before which the album in question shall be placed.
+	After   int64           // ID of the album after which the album in question shall be placed.
+}
+
+// Method returns the API method name.
+func (req PhotosReorderAlbums) Method() string {
+	return "photos.reorderAlbums"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosReorderAlbums) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosReorderAlbums) params() Params {
+// Params returns the set parameters.
+func (req PhotosReorderAlbums) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9753,13 +13462,24 @@ func (req PhotosReorderAlbums) params() Params {
 //
 // https://vk.com/dev/photos.reorderPhotos
 type PhotosReorderPhotos struct {
-	OwnerID int64 // ID of the user or community that owns the photo.
-	PhotoID int64 // Photo ID.
-	Before  int64 // ID of the photo before which the photo in question shall be placed.
-	After   int64 // ID of the photo after which the photo in question shall be placed.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the photo.
+	PhotoID int64           // Photo ID.
+	Before  int64           // ID of the photo before which the photo in question shall be placed.
+	After   int64           // ID of the photo after which the photo in question shall be placed.
+}
+
+// Method returns the API method name.
+func (req PhotosReorderPhotos) Method() string {
+	return "photos.reorderPhotos"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosReorderPhotos) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosReorderPhotos) params() Params {
+// Params returns the set parameters.
+func (req PhotosReorderPhotos) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9782,12 +13502,23 @@ func (req PhotosReorderPhotos) params() Params {
 //
 // https://vk.com/dev/photos.report
 type PhotosReport struct {
-	OwnerID int64 // ID of the user or community that owns the photo.
-	PhotoID int64 // Photo ID.
-	Reason  int64 // Reason for the complaint: '0' – spam, '1' – child pornography, '2' – extremism, '3' – violence, '4' – drug propaganda, '5' – adult material, '6' – insult, abuse
+	OwnerID runtime.OwnerID // ID of the user or community that owns the photo.
+	PhotoID int64           // Photo ID.
+	Reason  int64           // Reason for the complaint: '0' – spam, '1' – child pornography, '2' – extremism, '3' – violence, '4' – drug propaganda, '5' – adult material, '6' – insult, abuse
+}
+
+// Method returns the API method name.
+func (req PhotosReport) Method() string {
+	return "photos.report"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosReport) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosReport) params() Params {
+// Params returns the set parameters.
+func (req PhotosReport) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9807,12 +13538,23 @@ func (req PhotosReport) params() Params {
 //
 // https://vk.com/dev/photos.reportComment
 type PhotosReportComment struct {
-	OwnerID   int64 // ID of the user or community that owns the photo.
-	CommentID int64 // ID of the comment being reported.
-	Reason    int64 // Reason for the complaint: '0' – spam, '1' – child pornography, '2' – extremism, '3' – violence, '4' – drug propaganda, '5' – adult material, '6' – insult, abuse
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the photo.
+	CommentID int64           // ID of the comment being reported.
+	Reason    int64           // Reason for the complaint: '0' – spam, '1' – child pornography, '2' – extremism, '3' – violence, '4' – drug propaganda, '5' – adult material, '6' – insult, abuse
+}
+
+// Method returns the API method name.
+func (req PhotosReportComment) Method() string {
+	return "photos.reportComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosReportComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosReportComment) params() Params {
+// Params returns the set parameters.
+func (req PhotosReportComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9832,11 +13574,22 @@ func (req PhotosReportComment) params() Params {
 //
 // https://vk.com/dev/photos.restore
 type PhotosRestore struct {
-	OwnerID int64 // ID of the user or community that owns the photo.
-	PhotoID int64 // Photo ID.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the photo.
+	PhotoID int64           // Photo ID.
+}
+
+// Method returns the API method name.
+func (req PhotosRestore) Method() string {
+	return "photos.restore"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosRestore) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosRestore) params() Params {
+// Params returns the set parameters.
+func (req PhotosRestore) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9853,11 +13606,22 @@ func (req PhotosRestore) params() Params {
 //
 // https://vk.com/dev/photos.restoreComment
 type PhotosRestoreComment struct {
-	OwnerID   int64 // ID of the user or community that owns the photo.
-	CommentID int64 // ID of the deleted comment.
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the photo.
+	CommentID int64           // ID of the deleted comment.
+}
+
+// Method returns the API method name.
+func (req PhotosRestoreComment) Method() string {
+	return "photos.restoreComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosRestoreComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req PhotosRestoreComment) params() Params {
+// Params returns the set parameters.
+func (req PhotosRestoreComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -9884,7 +13648,18 @@ type PhotosSave struct {
 	Caption    string  // Text describing the photo. 2048 digits max.
 }
 
-func (req PhotosSave) params() Params {
+// Method returns the API method name.
+func (req PhotosSave) Method() string {
+	return "photos.save"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosSave) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosSave) Params() Params {
 	params := make(Params)
 	if req.AlbumID != 0 {
 		params["album_id"] = req.AlbumID
@@ -9925,7 +13700,18 @@ type PhotosSaveMarketAlbumPhoto struct {
 	Hash    string // Parameter returned when photos are [vk.com/dev/upload_files|uploaded to server].
 }
 
-func (req PhotosSaveMarketAlbumPhoto) params() Params {
+// Method returns the API method name.
+func (req PhotosSaveMarketAlbumPhoto) Method() string {
+	return "photos.saveMarketAlbumPhoto"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosSaveMarketAlbumPhoto) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosSaveMarketAlbumPhoto) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -9956,7 +13742,18 @@ type PhotosSaveMarketPhoto struct {
 	CropHash string // Parameter returned when photos are [vk.com/dev/upload_files|uploaded to server].
 }
 
-func (req PhotosSaveMarketPhoto) params() Params {
+// Method returns the API method name.
+func (req PhotosSaveMarketPhoto) Method() string {
+	return "photos.saveMarketPhoto"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosSaveMarketPhoto) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosSaveMarketPhoto) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -9990,7 +13787,18 @@ type PhotosSaveMessagesPhoto struct {
 	Hash   string
 }
 
-func (req PhotosSaveMessagesPhoto) params() Params {
+// Method returns the API method name.
+func (req PhotosSaveMessagesPhoto) Method() string {
+	return "photos.saveMessagesPhoto"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosSaveMessagesPhoto) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req PhotosSaveMessagesPhoto) Params() Params {
 	params := make(Params)
 	if req.Photo != "" {
 		params["photo"] = req.Photo
@@ -10014,7 +13822,18 @@ type PhotosSaveOwnerCoverPhoto struct {
 	Photo string // Parameter returned when photos are [vk.com/dev/upload_files|uploaded to server].
 }
 
-func (req PhotosSaveOwnerCoverPhoto) params() Params {
+// Method returns the API method name.
+func (req PhotosSaveOwnerCoverPhoto) Method() string {
+	return "photos.saveOwnerCoverPhoto"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosSaveOwnerCoverPhoto) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req PhotosSaveOwnerCoverPhoto) Params() Params {
 	params := make(Params)
 	if req.Hash != "" {
 		params["hash"] = req.Hash
@@ -10036,7 +13855,18 @@ type PhotosSaveOwnerPhoto struct {
 	Photo  string // parameter returned after [vk.com/dev/upload_files|photo upload].
 }
 
-func (req PhotosSaveOwnerPhoto) params() Params {
+// Method returns the API method name.
+func (req PhotosSaveOwnerPhoto) Method() string {
+	return "photos.saveOwnerPhoto"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosSaveOwnerPhoto) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosSaveOwnerPhoto) Params() Params {
 	params := make(Params)
 	if req.Server != "" {
 		params["server"] = req.Server
@@ -10066,7 +13896,18 @@ type PhotosSaveWallPhoto struct {
 	Caption   string  // Text describing the photo. 2048 digits max.
 }
 
-func (req PhotosSaveWallPhoto) params() Params {
+// Method returns the API method name.
+func (req PhotosSaveWallPhoto) Method() string {
+	return "photos.saveWallPhoto"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosSaveWallPhoto) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PhotosSaveWallPhoto) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -10112,7 +13953,18 @@ type PhotosSearch struct {
 	Radius    int64 // Radius of search in meters (works very approximately). Available values: '10', '100', '800', '6000', '50000'.
 }
 
-func (req PhotosSearch) params() Params {
+// Method returns the API method name.
+func (req PhotosSearch) Method() string {
+	return "photos.search"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PhotosSearch) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req PhotosSearch) Params() Params {
 	params := make(Params)
 	if req.Q != "" {
 		params["q"] = req.Q
@@ -10150,13 +14002,24 @@ func (req PhotosSearch) params() Params {
 //
 // https://vk.com/dev/polls.addVote
 type PollsAddVote struct {
-	OwnerID   int64 // ID of the user or community that owns the poll. Use a negative value to designate a community ID.
-	PollID    int64 // Poll ID.
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the poll. Use a negative value to designate a community ID.
+	PollID    int64           // Poll ID.
 	AnswerIDs []int64
 	IsBoard   bool
 }
 
-func (req PollsAddVote) params() Params {
+// Method returns the API method name.
+func (req PollsAddVote) Method() string {
+	return "polls.addVote"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PollsAddVote) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PollsAddVote) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -10183,14 +14046,25 @@ type PollsCreate struct {
 	IsAnonymous   bool   // '1' – anonymous poll, participants list is hidden,, '0' – public poll, participants list is available,, Default value is '0'.
 	IsMultiple    bool
 	EndDate       int64
-	OwnerID       int64  // If a poll will be added to a communty it is required to send a negative group identifier. Current user by default.
-	AddAnswers    string // available answers list, for example: " ["yes","no","maybe"]", There can be from 1 to 10 answers.
+	OwnerID       runtime.OwnerID // If a poll will be added to a communty it is required to send a negative group identifier. Current user by default.
+	AddAnswers    string          // available answers list, for example: " ["yes","no","maybe"]", There can be from 1 to 10 answers.
 	PhotoID       int64
 	BackgroundID  string
 	DisableUnvote bool
 }
 
-func (req PollsCreate) params() Params {
+// Method returns the API method name.
+func (req PollsCreate) Method() string {
+	return "polls.create"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PollsCreate) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PollsCreate) Params() Params {
 	params := make(Params)
 	if req.Question != "" {
 		params["question"] = req.Question
@@ -10228,13 +14102,24 @@ func (req PollsCreate) params() Params {
 //
 // https://vk.com/dev/polls.deleteVote
 type PollsDeleteVote struct {
-	OwnerID  int64 // ID of the user or community that owns the poll. Use a negative value to designate a community ID.
-	PollID   int64 // Poll ID.
-	AnswerID int64 // Answer ID.
+	OwnerID  runtime.OwnerID // ID of the user or community that owns the poll. Use a negative value to designate a community ID.
+	PollID   int64           // Poll ID.
+	AnswerID int64           // Answer ID.
 	IsBoard  bool
 }
 
-func (req PollsDeleteVote) params() Params {
+// Method returns the API method name.
+func (req PollsDeleteVote) Method() string {
+	return "polls.deleteVote"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PollsDeleteVote) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PollsDeleteVote) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -10257,18 +14142,29 @@ func (req PollsDeleteVote) params() Params {
 //
 // https://vk.com/dev/polls.edit
 type PollsEdit struct {
-	OwnerID       int64  // poll owner id
-	PollID        int64  // edited poll's id
-	Question      string // new question text
-	AddAnswers    string // answers list, for example: , "["yes","no","maybe"]"
-	EditAnswers   string // object containing answers that need to be edited,, key – answer id, value – new answer text. Example: {"382967099":"option1", "382967103":"option2"}"
-	DeleteAnswers string // list of answer ids to be deleted. For example: "[382967099, 382967103]"
+	OwnerID       runtime.OwnerID // poll owner id
+	PollID        int64           // edited poll's id
+	Question      string          // new question text
+	AddAnswers    string          // answers list, for example: , "["yes","no","maybe"]"
+	EditAnswers   string          // object containing answers that need to be edited,, key – answer id, value – new answer text. Example: {"382967099":"option1", "382967103":"option2"}"
+	DeleteAnswers string          // list of answer ids to be deleted. For example: "[382967099, 382967103]"
 	EndDate       int64
 	PhotoID       int64
 	BackgroundID  string
 }
 
-func (req PollsEdit) params() Params {
+// Method returns the API method name.
+func (req PollsEdit) Method() string {
+	return "polls.edit"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PollsEdit) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PollsEdit) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -10306,16 +14202,27 @@ func (req PollsEdit) params() Params {
 //
 // https://vk.com/dev/polls.getById
 type PollsGetByID struct {
-	OwnerID      int64 // ID of the user or community that owns the poll. Use a negative value to designate a community ID.
-	IsBoard      bool  // '1' – poll is in a board, '0' – poll is on a wall. '0' by default.
-	PollID       int64 // Poll ID.
+	OwnerID      runtime.OwnerID // ID of the user or community that owns the poll. Use a negative value to designate a community ID.
+	IsBoard      bool            // '1' – poll is in a board, '0' – poll is on a wall. '0' by default.
+	PollID       int64           // Poll ID.
 	Extended     bool
 	FriendsCount int64
 	Fields       []string
 	NameCase     string
 }
 
-func (req PollsGetByID) params() Params {
+// Method returns the API method name.
+func (req PollsGetByID) Method() string {
+	return "polls.getById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PollsGetByID) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PollsGetByID) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -10347,9 +14254,9 @@ func (req PollsGetByID) params() Params {
 //
 // https://vk.com/dev/polls.getVoters
 type PollsGetVoters struct {
-	OwnerID     int64   // ID of the user or community that owns the poll. Use a negative value to designate a community ID.
-	PollID      int64   // Poll ID.
-	AnswerIDs   []int64 // Answer IDs.
+	OwnerID     runtime.OwnerID // ID of the user or community that owns the poll. Use a negative value to designate a community ID.
+	PollID      int64           // Poll ID.
+	AnswerIDs   []int64         // Answer IDs.
 	IsBoard     bool
 	FriendsOnly bool          // '1' — to return only current user's friends, '0' — to return all users (default),
 	Offset      int64         // Offset needed to return a specific subset of voters. '0' — (default)
@@ -10358,7 +14265,18 @@ type PollsGetVoters struct {
 	NameCase    string        // Case for declension of user name and surname: , 'nom' — nominative (default) , 'gen' — genitive , 'dat' — dative , 'acc' — accusative , 'ins' — instrumental , 'abl' — prepositional
 }
 
-func (req PollsGetVoters) params() Params {
+// Method returns the API method name.
+func (req PollsGetVoters) Method() string {
+	return "polls.getVoters"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PollsGetVoters) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PollsGetVoters) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -10394,7 +14312,7 @@ func (req PollsGetVoters) params() Params {
 //
 // https://vk.com/dev/prettyCards.create
 type PrettyCardsCreate struct {
-	OwnerID  int64
+	OwnerID  runtime.OwnerID
 	Photo    string
 	Title    string
 	Link     string
@@ -10403,7 +14321,18 @@ type PrettyCardsCreate struct {
 	Button   string
 }
 
-func (req PrettyCardsCreate) params() Params {
+// Method returns the API method name.
+func (req PrettyCardsCreate) Method() string {
+	return "prettyCards.create"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PrettyCardsCreate) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PrettyCardsCreate) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -10433,11 +14362,22 @@ func (req PrettyCardsCreate) params() Params {
 //
 // https://vk.com/dev/prettyCards.delete
 type PrettyCardsDelete struct {
-	OwnerID int64
+	OwnerID runtime.OwnerID
 	CardID  int64
 }
 
-func (req PrettyCardsDelete) params() Params {
+// Method returns the API method name.
+func (req PrettyCardsDelete) Method() string {
+	return "prettyCards.delete"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PrettyCardsDelete) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PrettyCardsDelete) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -10452,7 +14392,7 @@ func (req PrettyCardsDelete) params() Params {
 //
 // https://vk.com/dev/prettyCards.edit
 type PrettyCardsEdit struct {
-	OwnerID  int64
+	OwnerID  runtime.OwnerID
 	CardID   int64
 	Photo    string
 	Title    string
@@ -10462,7 +14402,18 @@ type PrettyCardsEdit struct {
 	Button   string
 }
 
-func (req PrettyCardsEdit) params() Params {
+// Method returns the API method name.
+func (req PrettyCardsEdit) Method() string {
+	return "prettyCards.edit"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PrettyCardsEdit) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PrettyCardsEdit) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -10495,12 +14446,23 @@ func (req PrettyCardsEdit) params() Params {
 //
 // https://vk.com/dev/prettyCards.get
 type PrettyCardsGet struct {
-	OwnerID int64
+	OwnerID runtime.OwnerID
 	Offset  int64
 	Count   int64
 }
 
-func (req PrettyCardsGet) params() Params {
+// Method returns the API method name.
+func (req PrettyCardsGet) Method() string {
+	return "prettyCards.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PrettyCardsGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PrettyCardsGet) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -10518,11 +14480,22 @@ func (req PrettyCardsGet) params() Params {
 //
 // https://vk.com/dev/prettyCards.getById
 type PrettyCardsGetByID struct {
-	OwnerID int64
+	OwnerID runtime.OwnerID
 	CardIDs []int64
 }
 
-func (req PrettyCardsGetByID) params() Params {
+// Method returns the API method name.
+func (req PrettyCardsGetByID) Method() string {
+	return "prettyCards.getById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PrettyCardsGetByID) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PrettyCardsGetByID) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -10539,7 +14512,18 @@ func (req PrettyCardsGetByID) params() Params {
 type PrettyCardsGetUploadURL struct {
 }
 
-func (req PrettyCardsGetUploadURL) params() Params {
+// Method returns the API method name.
+func (req PrettyCardsGetUploadURL) Method() string {
+	return "prettyCards.getUploadURL"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req PrettyCardsGetUploadURL) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req PrettyCardsGetUploadURL) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -10558,7 +14542,18 @@ type SearchGetHints struct {
 	SearchGlobal bool
 }
 
-func (req SearchGetHints) params() Params {
+// Method returns the API method name.
+func (req SearchGetHints) Method() string {
+	return "search.getHints"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req SearchGetHints) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req SearchGetHints) Params() Params {
 	params := make(Params)
 	if req.Q != "" {
 		params["q"] = req.Q
@@ -10592,7 +14587,18 @@ type SecureAddAppEvent struct {
 	Value      int64 // depends on activity_id: * 1 – number, current level number,, * 2 – number, current user's points amount, , Any other value is ignored
 }
 
-func (req SecureAddAppEvent) params() Params {
+// Method returns the API method name.
+func (req SecureAddAppEvent) Method() string {
+	return "secure.addAppEvent"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req SecureAddAppEvent) AccessTokenType() []string {
+	return []string{"service"}
+}
+
+// Params returns the set parameters.
+func (req SecureAddAppEvent) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -10616,7 +14622,18 @@ type SecureCheckToken struct {
 	Ip    string // user 'ip address'. Note that user may access using the 'ipv6' address, in this case it is required to transmit the 'ipv6' address. If not transmitted, the address will not be checked.
 }
 
-func (req SecureCheckToken) params() Params {
+// Method returns the API method name.
+func (req SecureCheckToken) Method() string {
+	return "secure.checkToken"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req SecureCheckToken) AccessTokenType() []string {
+	return []string{"service"}
+}
+
+// Params returns the set parameters.
+func (req SecureCheckToken) Params() Params {
 	params := make(Params)
 	if req.Token != "" {
 		params["token"] = req.Token
@@ -10635,7 +14652,18 @@ func (req SecureCheckToken) params() Params {
 type SecureGetAppBalance struct {
 }
 
-func (req SecureGetAppBalance) params() Params {
+// Method returns the API method name.
+func (req SecureGetAppBalance) Method() string {
+	return "secure.getAppBalance"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req SecureGetAppBalance) AccessTokenType() []string {
+	return []string{"service"}
+}
+
+// Params returns the set parameters.
+func (req SecureGetAppBalance) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -10652,7 +14680,18 @@ type SecureGetSMSHistory struct {
 	Limit    int64 // number of returned posts. By default — 1000.
 }
 
-func (req SecureGetSMSHistory) params() Params {
+// Method returns the API method name.
+func (req SecureGetSMSHistory) Method() string {
+	return "secure.getSMSHistory"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req SecureGetSMSHistory) AccessTokenType() []string {
+	return []string{"service"}
+}
+
+// Params returns the set parameters.
+func (req SecureGetSMSHistory) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -10683,7 +14722,18 @@ type SecureGetTransactionsHistory struct {
 	Limit    int64
 }
 
-func (req SecureGetTransactionsHistory) params() Params {
+// Method returns the API method name.
+func (req SecureGetTransactionsHistory) Method() string {
+	return "secure.getTransactionsHistory"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req SecureGetTransactionsHistory) AccessTokenType() []string {
+	return []string{"service"}
+}
+
+// Params returns the set parameters.
+func (req SecureGetTransactionsHistory) Params() Params {
 	params := make(Params)
 	if req.Type != 0 {
 		params["type"] = req.Type
@@ -10715,7 +14765,18 @@ type SecureGetUserLevel struct {
 	UserIDs []int64
 }
 
-func (req SecureGetUserLevel) params() Params {
+// Method returns the API method name.
+func (req SecureGetUserLevel) Method() string {
+	return "secure.getUserLevel"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req SecureGetUserLevel) AccessTokenType() []string {
+	return []string{"service"}
+}
+
+// Params returns the set parameters.
+func (req SecureGetUserLevel) Params() Params {
 	params := make(Params)
 	if len(req.UserIDs) > 0 {
 		params["user_ids"] = req.UserIDs
@@ -10733,7 +14794,18 @@ type SecureGiveEventSticker struct {
 	AchievementID int64
 }
 
-func (req SecureGiveEventSticker) params() Params {
+// Method returns the API method name.
+func (req SecureGiveEventSticker) Method() string {
+	return "secure.giveEventSticker"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req SecureGiveEventSticker) AccessTokenType() []string {
+	return []string{"service"}
+}
+
+// Params returns the set parameters.
+func (req SecureGiveEventSticker) Params() Params {
 	params := make(Params)
 	if len(req.UserIDs) > 0 {
 		params["user_ids"] = req.UserIDs
@@ -10755,7 +14827,18 @@ type SecureSendNotification struct {
 	Message string // notification text which should be sent in 'UTF-8' encoding ('254' characters maximum).
 }
 
-func (req SecureSendNotification) params() Params {
+// Method returns the API method name.
+func (req SecureSendNotification) Method() string {
+	return "secure.sendNotification"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req SecureSendNotification) AccessTokenType() []string {
+	return []string{"service"}
+}
+
+// Params returns the set parameters.
+func (req SecureSendNotification) Params() Params {
 	params := make(Params)
 	if len(req.UserIDs) > 0 {
 		params["user_ids"] = req.UserIDs
@@ -10779,7 +14862,18 @@ type SecureSendSMSNotification struct {
 	Message string // 'SMS' text to be sent in 'UTF-8' encoding. Only Latin letters and numbers are allowed. Maximum size is '160' characters.
 }
 
-func (req SecureSendSMSNotification) params() Params {
+// Method returns the API method name.
+func (req SecureSendSMSNotification) Method() string {
+	return "secure.sendSMSNotification"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req SecureSendSMSNotification) AccessTokenType() []string {
+	return []string{"service"}
+}
+
+// Params returns the set parameters.
+func (req SecureSendSMSNotification) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -10802,7 +14896,18 @@ type SecureSetCounter struct {
 	Increment bool
 }
 
-func (req SecureSetCounter) params() Params {
+// Method returns the API method name.
+func (req SecureSetCounter) Method() string {
+	return "secure.setCounter"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req SecureSetCounter) AccessTokenType() []string {
+	return []string{"service"}
+}
+
+// Params returns the set parameters.
+func (req SecureSetCounter) Params() Params {
 	params := make(Params)
 	if len(req.Counters) > 0 {
 		params["counters"] = req.Counters
@@ -10836,7 +14941,18 @@ type StatsGet struct {
 	Extended       bool
 }
 
-func (req StatsGet) params() Params {
+// Method returns the API method name.
+func (req StatsGet) Method() string {
+	return "stats.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StatsGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req StatsGet) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -10878,7 +14994,18 @@ type StatsGetPostReach struct {
 	PostIDs []int64 // wall posts id
 }
 
-func (req StatsGetPostReach) params() Params {
+// Method returns the API method name.
+func (req StatsGetPostReach) Method() string {
+	return "stats.getPostReach"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StatsGetPostReach) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req StatsGetPostReach) Params() Params {
 	params := make(Params)
 	if req.OwnerID != "" {
 		params["owner_id"] = req.OwnerID
@@ -10896,7 +15023,18 @@ type StatsTrackVisitor struct {
 	ID string
 }
 
-func (req StatsTrackVisitor) params() Params {
+// Method returns the API method name.
+func (req StatsTrackVisitor) Method() string {
+	return "stats.trackVisitor"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StatsTrackVisitor) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req StatsTrackVisitor) Params() Params {
 	params := make(Params)
 	if req.ID != "" {
 		params["id"] = req.ID
@@ -10914,7 +15052,18 @@ type StatusGet struct {
 	GroupID int64
 }
 
-func (req StatusGet) params() Params {
+// Method returns the API method name.
+func (req StatusGet) Method() string {
+	return "status.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StatusGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req StatusGet) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -10935,7 +15084,18 @@ type StatusSet struct {
 	GroupID int64  // Identifier of a community to set a status in. If left blank the status is set to current user.
 }
 
-func (req StatusSet) params() Params {
+// Method returns the API method name.
+func (req StatusSet) Method() string {
+	return "status.set"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StatusSet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req StatusSet) Params() Params {
 	params := make(Params)
 	if req.Text != "" {
 		params["text"] = req.Text
@@ -10957,7 +15117,18 @@ type StorageGet struct {
 	UserID int64
 }
 
-func (req StorageGet) params() Params {
+// Method returns the API method name.
+func (req StorageGet) Method() string {
+	return "storage.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StorageGet) AccessTokenType() []string {
+	return []string{"user", "group", "service"}
+}
+
+// Params returns the set parameters.
+func (req StorageGet) Params() Params {
 	params := make(Params)
 	if req.Key != "" {
 		params["key"] = req.Key
@@ -10982,7 +15153,18 @@ type StorageGetKeys struct {
 	Count  int64 // amount of variable names the info needs to be collected from.
 }
 
-func (req StorageGetKeys) params() Params {
+// Method returns the API method name.
+func (req StorageGetKeys) Method() string {
+	return "storage.getKeys"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StorageGetKeys) AccessTokenType() []string {
+	return []string{"user", "group", "service"}
+}
+
+// Params returns the set parameters.
+func (req StorageGetKeys) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -11007,7 +15189,18 @@ type StorageSet struct {
 	UserID int64
 }
 
-func (req StorageSet) params() Params {
+// Method returns the API method name.
+func (req StorageSet) Method() string {
+	return "storage.set"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StorageSet) AccessTokenType() []string {
+	return []string{"user", "group", "service"}
+}
+
+// Params returns the set parameters.
+func (req StorageSet) Params() Params {
 	params := make(Params)
 	if req.Key != "" {
 		params["key"] = req.Key
@@ -11030,7 +15223,18 @@ type StoriesBanOwner struct {
 	OwnersIDs []int64 // List of sources IDs
 }
 
-func (req StoriesBanOwner) params() Params {
+// Method returns the API method name.
+func (req StoriesBanOwner) Method() string {
+	return "stories.banOwner"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesBanOwner) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req StoriesBanOwner) Params() Params {
 	params := make(Params)
 	if len(req.OwnersIDs) > 0 {
 		params["owners_ids"] = req.OwnersIDs
@@ -11044,11 +15248,22 @@ func (req StoriesBanOwner) params() Params {
 //
 // https://vk.com/dev/stories.delete
 type StoriesDelete struct {
-	OwnerID int64 // Story owner's ID. Current user id is used by default.
-	StoryID int64 // Story ID.
+	OwnerID runtime.OwnerID // Story owner's ID. Current user id is used by default.
+	StoryID int64           // Story ID.
+}
+
+// Method returns the API method name.
+func (req StoriesDelete) Method() string {
+	return "stories.delete"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesDelete) AccessTokenType() []string {
+	return []string{"user", "group"}
 }
 
-func (req StoriesDelete) params() Params {
+// Params returns the set parameters.
+func (req StoriesDelete) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -11065,12 +15280,23 @@ func (req StoriesDelete) params() Params {
 //
 // https://vk.com/dev/stories.get
 type StoriesGet struct {
-	OwnerID  int64 // Owner ID.
-	Extended bool  // '1' — to return additional fields for users and communities. Default value is 0.
+	OwnerID  runtime.OwnerID // Owner ID.
+	Extended bool            // '1' — to return additional fields for users and communities. Default value is 0.
 	Fields   []BaseUserGroupFields
 }
 
-func (req StoriesGet) params() Params {
+// Method returns the API method name.
+func (req StoriesGet) Method() string {
+	return "stories.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesGet) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req StoriesGet) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -11094,7 +15320,18 @@ type StoriesGetBanned struct {
 	Fields   []BaseUserGroupFields // Additional fields to return
 }
 
-func (req StoriesGetBanned) params() Params {
+// Method returns the API method name.
+func (req StoriesGetBanned) Method() string {
+	return "stories.getBanned"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesGetBanned) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req StoriesGetBanned) Params() Params {
 	params := make(Params)
 	if req.Extended {
 		params["extended"] = req.Extended
@@ -11116,7 +15353,18 @@ type StoriesGetByID struct {
 	Fields   []BaseUserGroupFields // Additional fields to return
 }
 
-func (req StoriesGetByID) params() Params {
+// Method returns the API method name.
+func (req StoriesGetByID) Method() string {
+	return "stories.getById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesGetByID) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req StoriesGetByID) Params() Params {
 	params := make(Params)
 	if len(req.Stories) > 0 {
 		params["stories"] = req.Stories
@@ -11145,7 +15393,18 @@ type StoriesGetPhotoUploadServer struct {
 	ClickableStickers string
 }
 
-func (req StoriesGetPhotoUploadServer) params() Params {
+// Method returns the API method name.
+func (req StoriesGetPhotoUploadServer) Method() string {
+	return "stories.getPhotoUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesGetPhotoUploadServer) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req StoriesGetPhotoUploadServer) Params() Params {
 	params := make(Params)
 	if req.AddToNews {
 		params["add_to_news"] = req.AddToNews
@@ -11177,14 +15436,25 @@ func (req StoriesGetPhotoUploadServer) params() Params {
 //
 // https://vk.com/dev/stories.getReplies
 type StoriesGetReplies struct {
-	OwnerID   int64                 // Story owner ID.
+	OwnerID   runtime.OwnerID       // Story owner ID.
 	StoryID   int64                 // Story ID.
 	AccessKey string                // Access key for the private object.
 	Extended  bool                  // '1' — to return additional fields for users and communities. Default value is 0.
 	Fields    []BaseUserGroupFields // Additional fields to return
 }
 
-func (req StoriesGetReplies) params() Params {
+// Method returns the API method name.
+func (req StoriesGetReplies) Method() string {
+	return "stories.getReplies"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesGetReplies) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req StoriesGetReplies) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -11210,11 +15480,22 @@ func (req StoriesGetReplies) params() Params {
 //
 // https://vk.com/dev/stories.getStats
 type StoriesGetStats struct {
-	OwnerID int64 // Story owner ID.
-	StoryID int64 // Story ID.
+	OwnerID runtime.OwnerID // Story owner ID.
+	StoryID int64           // Story ID.
+}
+
+// Method returns the API method name.
+func (req StoriesGetStats) Method() string {
+	return "stories.getStats"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesGetStats) AccessTokenType() []string {
+	return []string{"user", "group"}
 }
 
-func (req StoriesGetStats) params() Params {
+// Params returns the set parameters.
+func (req StoriesGetStats) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -11240,7 +15521,18 @@ type StoriesGetVideoUploadServer struct {
 	ClickableStickers string
 }
 
-func (req StoriesGetVideoUploadServer) params() Params {
+// Method returns the API method name.
+func (req StoriesGetVideoUploadServer) Method() string {
+	return "stories.getVideoUploadServer"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesGetVideoUploadServer) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req StoriesGetVideoUploadServer) Params() Params {
 	params := make(Params)
 	if req.AddToNews {
 		params["add_to_news"] = req.AddToNews
@@ -11272,14 +15564,25 @@ func (req StoriesGetVideoUploadServer) params() Params {
 //
 // https://vk.com/dev/stories.getViewers
 type StoriesGetViewers struct {
-	OwnerID  int64 // Story owner ID.
-	StoryID  int64 // Story ID.
-	Count    int64 // Maximum number of results.
-	Offset   int64 // Offset needed to return a specific subset of results.
-	Extended bool  // '1' — to return detailed information about photos
+	OwnerID  runtime.OwnerID // Story owner ID.
+	StoryID  int64           // Story ID.
+	Count    int64           // Maximum number of results.
+	Offset   int64           // Offset needed to return a specific subset of results.
+	Extended bool            // '1' — to return detailed information about photos
+}
+
+// Method returns the API method name.
+func (req StoriesGetViewers) Method() string {
+	return "stories.getViewers"
 }
 
-func (req StoriesGetViewers) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesGetViewers) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req StoriesGetViewers) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -11305,11 +15608,22 @@ func (req StoriesGetViewers) params() Params {
 //
 // https://vk.com/dev/stories.hideAllReplies
 type StoriesHideAllReplies struct {
-	OwnerID int64 // ID of the user whose replies should be hidden.
+	OwnerID runtime.OwnerID // ID of the user whose replies should be hidden.
 	GroupID int64
 }
 
-func (req StoriesHideAllReplies) params() Params {
+// Method returns the API method name.
+func (req StoriesHideAllReplies) Method() string {
+	return "stories.hideAllReplies"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesHideAllReplies) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req StoriesHideAllReplies) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -11326,11 +15640,22 @@ func (req StoriesHideAllReplies) params() Params {
 //
 // https://vk.com/dev/stories.hideReply
 type StoriesHideReply struct {
-	OwnerID int64 // ID of the user whose replies should be hidden.
-	StoryID int64 // Story ID.
+	OwnerID runtime.OwnerID // ID of the user whose replies should be hidden.
+	StoryID int64           // Story ID.
+}
+
+// Method returns the API method name.
+func (req StoriesHideReply) Method() string {
+	return "stories.hideReply"
 }
 
-func (req StoriesHideReply) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesHideReply) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req StoriesHideReply) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -11356,7 +15681,18 @@ type StoriesSearch struct {
 	Fields      []string
 }
 
-func (req StoriesSearch) params() Params {
+// Method returns the API method name.
+func (req StoriesSearch) Method() string {
+	return "stories.search"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesSearch) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req StoriesSearch) Params() Params {
 	params := make(Params)
 	if req.Q != "" {
 		params["q"] = req.Q
@@ -11397,7 +15733,18 @@ type StoriesUnbanOwner struct {
 	OwnersIDs []int64 // List of hidden sources to show stories from.
 }
 
-func (req StoriesUnbanOwner) params() Params {
+// Method returns the API method name.
+func (req StoriesUnbanOwner) Method() string {
+	return "stories.unbanOwner"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StoriesUnbanOwner) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req StoriesUnbanOwner) Params() Params {
 	params := make(Params)
 	if len(req.OwnersIDs) > 0 {
 		params["owners_ids"] = req.OwnersIDs
@@ -11413,7 +15760,18 @@ func (req StoriesUnbanOwner) params() Params {
 type StreamingGetServerURL struct {
 }
 
-func (req StreamingGetServerURL) params() Params {
+// Method returns the API method name.
+func (req StreamingGetServerURL) Method() string {
+	return "streaming.getServerUrl"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StreamingGetServerURL) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req StreamingGetServerURL) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -11425,7 +15783,18 @@ type StreamingSetSettings struct {
 	MonthlyTier string
 }
 
-func (req StreamingSetSettings) params() Params {
+// Method returns the API method name.
+func (req StreamingSetSettings) Method() string {
+	return "streaming.setSettings"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req StreamingSetSettings) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req StreamingSetSettings) Params() Params {
 	params := make(Params)
 	if req.MonthlyTier != "" {
 		params["monthly_tier"] = req.MonthlyTier
@@ -11444,7 +15813,18 @@ type UsersGet struct {
 	NameCase string        // Case for declension of user name and surname: 'nom' — nominative (default), 'gen' — genitive , 'dat' — dative, 'acc' — accusative , 'ins' — instrumental , 'abl' — prepositional
 }
 
-func (req UsersGet) params() Params {
+// Method returns the API method name.
+func (req UsersGet) Method() string {
+	return "users.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req UsersGet) AccessTokenType() []string {
+	return []string{"user", "group", "service"}
+}
+
+// Params returns the set parameters.
+func (req UsersGet) Params() Params {
 	params := make(Params)
 	if len(req.UserIDs) > 0 {
 		params["user_ids"] = req.UserIDs
@@ -11471,7 +15851,18 @@ type UsersGetFollowers struct {
 	NameCase string        // Case for declension of user name and surname: 'nom' — nominative (default), 'gen' — genitive , 'dat' — dative, 'acc' — accusative , 'ins' — instrumental , 'abl' — prepositional
 }
 
-func (req UsersGetFollowers) params() Params {
+// Method returns the API method name.
+func (req UsersGetFollowers) Method() string {
+	return "users.getFollowers"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req UsersGetFollowers) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req UsersGetFollowers) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -11504,7 +15895,18 @@ type UsersGetSubscriptions struct {
 	Fields   []UsersFields
 }
 
-func (req UsersGetSubscriptions) params() Params {
+// Method returns the API method name.
+func (req UsersGetSubscriptions) Method() string {
+	return "users.getSubscriptions"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req UsersGetSubscriptions) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req UsersGetSubscriptions) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -11535,7 +15937,18 @@ type UsersReport struct {
 	Comment string // Comment describing the complaint.
 }
 
-func (req UsersReport) params() Params {
+// Method returns the API method name.
+func (req UsersReport) Method() string {
+	return "users.report"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req UsersReport) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req UsersReport) Params() Params {
 	params := make(Params)
 	if req.UserID != 0 {
 		params["user_id"] = req.UserID
@@ -11589,7 +16002,18 @@ type UsersSearch struct {
 	FromList          []string
 }
 
-func (req UsersSearch) params() Params {
+// Method returns the API method name.
+func (req UsersSearch) Method() string {
+	return "users.search"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req UsersSearch) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req UsersSearch) Params() Params {
 	params := make(Params)
 	if req.Q != "" {
 		params["q"] = req.Q
@@ -11699,7 +16123,18 @@ type UtilsCheckLink struct {
 	URL string // Link to check (e.g., 'http://google.com').
 }
 
-func (req UtilsCheckLink) params() Params {
+// Method returns the API method name.
+func (req UtilsCheckLink) Method() string {
+	return "utils.checkLink"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req UtilsCheckLink) AccessTokenType() []string {
+	return []string{"user", "group", "service"}
+}
+
+// Params returns the set parameters.
+func (req UtilsCheckLink) Params() Params {
 	params := make(Params)
 	if req.URL != "" {
 		params["url"] = req.URL
@@ -11716,7 +16151,18 @@ type UtilsDeleteFromLastShortened struct {
 	Key string // Link key (characters after vk.cc/).
 }
 
-func (req UtilsDeleteFromLastShortened) params() Params {
+// Method returns the API method name.
+func (req UtilsDeleteFromLastShortened) Method() string {
+	return "utils.deleteFromLastShortened"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req UtilsDeleteFromLastShortened) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req UtilsDeleteFromLastShortened) Params() Params {
 	params := make(Params)
 	if req.Key != "" {
 		params["key"] = req.Key
@@ -11734,7 +16180,18 @@ type UtilsGetLastShortenedLinks struct {
 	Offset int64 // Offset needed to return a specific subset of links.
 }
 
-func (req UtilsGetLastShortenedLinks) params() Params {
+// Method returns the API method name.
+func (req UtilsGetLastShortenedLinks) Method() string {
+	return "utils.getLastShortenedLinks"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req UtilsGetLastShortenedLinks) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req UtilsGetLastShortenedLinks) Params() Params {
 	params := make(Params)
 	if req.Count != 0 {
 		params["count"] = req.Count
@@ -11759,7 +16216,18 @@ type UtilsGetLinkStats struct {
 	Extended       bool   // 1 — to return extended stats data (sex, age, geo). 0 — to return views number only.
 }
 
-func (req UtilsGetLinkStats) params() Params {
+// Method returns the API method name.
+func (req UtilsGetLinkStats) Method() string {
+	return "utils.getLinkStats"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req UtilsGetLinkStats) AccessTokenType() []string {
+	return []string{"user", "group", "service"}
+}
+
+// Params returns the set parameters.
+func (req UtilsGetLinkStats) Params() Params {
 	params := make(Params)
 	if req.Key != "" {
 		params["key"] = req.Key
@@ -11790,7 +16258,18 @@ func (req UtilsGetLinkStats) params() Params {
 type UtilsGetServerTime struct {
 }
 
-func (req UtilsGetServerTime) params() Params {
+// Method returns the API method name.
+func (req UtilsGetServerTime) Method() string {
+	return "utils.getServerTime"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req UtilsGetServerTime) AccessTokenType() []string {
+	return []string{"user", "group", "service"}
+}
+
+// Params returns the set parameters.
+func (req UtilsGetServerTime) Params() Params {
 	params := make(Params)
 	return params
 }
@@ -11805,7 +16284,18 @@ type UtilsGetShortLink struct {
 	Private bool   // 1 — private stats, 0 — public stats.
 }
 
-func (req UtilsGetShortLink) params() Params {
+// Method returns the API method name.
+func (req UtilsGetShortLink) Method() string {
+	return "utils.getShortLink"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req UtilsGetShortLink) AccessTokenType() []string {
+	return []string{"user", "group", "service"}
+}
+
+// Params returns the set parameters.
+func (req UtilsGetShortLink) Params() Params {
 	params := make(Params)
 	if req.URL != "" {
 		params["url"] = req.URL
@@ -11825,7 +16315,18 @@ type UtilsResolveScreenName struct {
 	ScreenName string // Screen name of the user, community (e.g., 'apiclub,' 'andrew', or 'rules_of_war'), or application.
 }
 
-func (req UtilsResolveScreenName) params() Params {
+// Method returns the API method name.
+func (req UtilsResolveScreenName) Method() string {
+	return "utils.resolveScreenName"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req UtilsResolveScreenName) AccessTokenType() []string {
+	return []string{"user", "group", "service"}
+}
+
+// Params returns the set parameters.
+func (req UtilsResolveScreenName) Params() Params {
 	params := make(Params)
 	if req.ScreenName != "" {
 		params["screen_name"] = req.ScreenName
@@ -11839,12 +16340,23 @@ func (req UtilsResolveScreenName) params() Params {
 //
 // https://vk.com/dev/video.add
 type VideoAdd struct {
-	TargetID int64 // identifier of a user or community to add a video to. Use a negative value to designate a community ID.
-	VideoID  int64 // Video ID.
-	OwnerID  int64 // ID of the user or community that owns the video. Use a negative value to designate a community ID.
+	TargetID int64           // identifier of a user or community to add a video to. Use a negative value to designate a community ID.
+	VideoID  int64           // Video ID.
+	OwnerID  runtime.OwnerID // ID of the user or community that owns the video. Use a negative value to designate a community ID.
+}
+
+// Method returns the API method name.
+func (req VideoAdd) Method() string {
+	return "video.add"
 }
 
-func (req VideoAdd) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req VideoAdd) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoAdd) Params() Params {
 	params := make(Params)
 	if req.TargetID != 0 {
 		params["target_id"] = req.TargetID
@@ -11869,7 +16381,18 @@ type VideoAddAlbum struct {
 	Privacy []string // new access permissions for the album. Possible values: , *'0' – all users,, *'1' – friends only,, *'2' – friends and friends of friends,, *'3' – "only me".
 }
 
-func (req VideoAddAlbum) params() Params {
+// Method returns the API method name.
+func (req VideoAddAlbum) Method() string {
+	return "video.addAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoAddAlbum) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoAddAlbum) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -11890,11 +16413,22 @@ type VideoAddToAlbum struct {
 	TargetID int64
 	AlbumID  int64
 	AlbumIDs []int64
-	OwnerID  int64
+	OwnerID  runtime.OwnerID
 	VideoID  int64
 }
 
-func (req VideoAddToAlbum) params() Params {
+// Method returns the API method name.
+func (req VideoAddToAlbum) Method() string {
+	return "video.addToAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoAddToAlbum) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoAddToAlbum) Params() Params {
 	params := make(Params)
 	if req.TargetID != 0 {
 		params["target_id"] = req.TargetID
@@ -11920,17 +16454,28 @@ func (req VideoAddToAlbum) params() Params {
 //
 // https://vk.com/dev/video.createComment
 type VideoCreateComment struct {
-	OwnerID        int64    // ID of the user or community that owns the video.
-	VideoID        int64    // Video ID.
-	Message        string   // New comment text.
-	Attachments    []string // List of objects attached to the comment, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — ID of the media attachment owner. '<media_id>' — Media attachment ID. Example: "photo100172_166443618,photo66748_265827614"
-	FromGroup      bool     // '1' — to post the comment from a community name (only if 'owner_id'<0)
+	OwnerID        runtime.OwnerID // ID of the user or community that owns the video.
+	VideoID        int64           // Video ID.
+	Message        string          // New comment text.
+	Attachments    []string        // List of objects attached to the comment, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — ID of the media attachment owner. '<media_id>' — Media attachment ID. Example: "photo100172_166443618,photo66748_265827614"
+	FromGroup      bool            // '1' — to post the comment from a community name (only if 'owner_id'<0)
 	ReplyToComment int64
 	StickerID      int64
 	Guid           string
 }
 
-func (req VideoCreateComment) params() Params {
+// Method returns the API method name.
+func (req VideoCreateComment) Method() string {
+	return "video.createComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoCreateComment) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoCreateComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -11965,12 +16510,23 @@ func (req VideoCreateComment) params() Params {
 //
 // https://vk.com/dev/video.delete
 type VideoDelete struct {
-	VideoID  int64 // Video ID.
-	OwnerID  int64 // ID of the user or community that owns the video.
+	VideoID  int64           // Video ID.
+	OwnerID  runtime.OwnerID // ID of the user or community that owns the video.
 	TargetID int64
 }
 
-func (req VideoDelete) params() Params {
+// Method returns the API method name.
+func (req VideoDelete) Method() string {
+	return "video.delete"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoDelete) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoDelete) Params() Params {
 	params := make(Params)
 	if req.VideoID != 0 {
 		params["video_id"] = req.VideoID
@@ -11994,7 +16550,18 @@ type VideoDeleteAlbum struct {
 	AlbumID int64 // Album ID.
 }
 
-func (req VideoDeleteAlbum) params() Params {
+// Method returns the API method name.
+func (req VideoDeleteAlbum) Method() string {
+	return "video.deleteAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoDeleteAlbum) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoDeleteAlbum) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -12011,11 +16578,22 @@ func (req VideoDeleteAlbum) params() Params {
 //
 // https://vk.com/dev/video.deleteComment
 type VideoDeleteComment struct {
-	OwnerID   int64 // ID of the user or community that owns the video.
-	CommentID int64 // ID of the comment to be deleted.
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the video.
+	CommentID int64           // ID of the comment to be deleted.
+}
+
+// Method returns the API method name.
+func (req VideoDeleteComment) Method() string {
+	return "video.deleteComment"
 }
 
-func (req VideoDeleteComment) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req VideoDeleteComment) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoDeleteComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12032,17 +16610,28 @@ func (req VideoDeleteComment) params() Params {
 //
 // https://vk.com/dev/video.edit
 type VideoEdit struct {
-	OwnerID        int64    // ID of the user or community that owns the video.
-	VideoID        int64    // Video ID.
-	Name           string   // New video title.
-	Desc           string   // New video description.
-	PrivacyView    []string // Privacy settings in a [vk.com/dev/privacy_setting|special format]. Privacy setting is available for videos uploaded to own profile by user.
-	PrivacyComment []string // Privacy settings for comments in a [vk.com/dev/privacy_setting|special format].
-	NoComments     bool     // Disable comments for the group video.
-	Repeat         bool     // '1' — to repeat the playback of the video, '0' — to play the video once,
+	OwnerID        runtime.OwnerID // ID of the user or community that owns the video.
+	VideoID        int64           // Video ID.
+	Name           string          // New video title.
+	Desc           string          // New video description.
+	PrivacyView    []string        // Privacy settings in a [vk.com/dev/privacy_setting|special format]. Privacy setting is available for videos uploaded to own profile by user.
+	PrivacyComment []string        // Privacy settings for comments in a [vk.com/dev/privacy_setting|special format].
+	NoComments     bool            // Disable comments for the group video.
+	Repeat         bool            // '1' — to repeat the playback of the video, '0' — to play the video once,
+}
+
+// Method returns the API method name.
+func (req VideoEdit) Method() string {
+	return "video.edit"
 }
 
-func (req VideoEdit) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req VideoEdit) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoEdit) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12083,7 +16672,18 @@ type VideoEditAlbum struct {
 	Privacy []string // new access permissions for the album. Possible values: , *'0' – all users,, *'1' – friends only,, *'2' – friends and friends of friends,, *'3' – "only me".
 }
 
-func (req VideoEditAlbum) params() Params {
+// Method returns the API method name.
+func (req VideoEditAlbum) Method() string {
+	return "video.editAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoEditAlbum) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoEditAlbum) Params() Params {
 	params := make(Params)
 	if req.GroupID != 0 {
 		params["group_id"] = req.GroupID
@@ -12106,13 +16706,24 @@ func (req VideoEditAlbum) params() Params {
 //
 // https://vk.com/dev/video.editComment
 type VideoEditComment struct {
-	OwnerID     int64    // ID of the user or community that owns the video.
-	CommentID   int64    // Comment ID.
-	Message     string   // New comment text.
-	Attachments []string // List of objects attached to the comment, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — ID of the media attachment owner. '<media_id>' — Media attachment ID. Example: "photo100172_166443618,photo66748_265827614"
+	OwnerID     runtime.OwnerID // ID of the user or community that owns the video.
+	CommentID   int64           // Comment ID.
+	Message     string          // New comment text.
+	Attachments []string        // List of objects attached to the comment, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — ID of the media attachment owner. '<media_id>' — Media attachment ID. Example: "photo100172_166443618,photo66748_265827614"
+}
+
+// Method returns the API method name.
+func (req VideoEditComment) Method() string {
+	return "video.editComment"
 }
 
-func (req VideoEditComment) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req VideoEditComment) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoEditComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12135,15 +16746,26 @@ func (req VideoEditComment) params() Params {
 //
 // https://vk.com/dev/video.get
 type VideoGet struct {
-	OwnerID  int64    // ID of the user or community that owns the video(s).
-	Videos   []string // Video IDs, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", Use a negative value to designate a community ID. Example: "-4363_136089719,13245770_137352259"
-	AlbumID  int64    // ID of the album containing the video(s).
-	Count    int64    // Number of videos to return.
-	Offset   int64    // Offset needed to return a specific subset of videos.
-	Extended bool     // '1' — to return an extended response with additional fields
+	OwnerID  runtime.OwnerID // ID of the user or community that owns the video(s).
+	Videos   []string        // Video IDs, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", Use a negative value to designate a community ID. Example: "-4363_136089719,13245770_137352259"
+	AlbumID  int64           // ID of the album containing the video(s).
+	Count    int64           // Number of videos to return.
+	Offset   int64           // Offset needed to return a specific subset of videos.
+	Extended bool            // '1' — to return an extended response with additional fields
+}
+
+// Method returns the API method name.
+func (req VideoGet) Method() string {
+	return "video.get"
 }
 
-func (req VideoGet) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req VideoGet) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoGet) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12172,11 +16794,22 @@ func (req VideoGet) params() Params {
 //
 // https://vk.com/dev/video.getAlbumById
 type VideoGetAlbumByID struct {
-	OwnerID int64 // identifier of a user or community to add a video to. Use a negative value to designate a community ID.
-	AlbumID int64 // Album ID.
+	OwnerID runtime.OwnerID // identifier of a user or community to add a video to. Use a negative value to designate a community ID.
+	AlbumID int64           // Album ID.
+}
+
+// Method returns the API method name.
+func (req VideoGetAlbumByID) Method() string {
+	return "video.getAlbumById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoGetAlbumByID) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req VideoGetAlbumByID) params() Params {
+// Params returns the set parameters.
+func (req VideoGetAlbumByID) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12193,14 +16826,25 @@ func (req VideoGetAlbumByID) params() Params {
 //
 // https://vk.com/dev/video.getAlbums
 type VideoGetAlbums struct {
-	OwnerID    int64 // ID of the user or community that owns the video album(s).
-	Offset     int64 // Offset needed to return a specific subset of video albums.
-	Count      int64 // Number of video albums to return.
-	Extended   bool  // '1' — to return additional information about album privacy settings for the current user
+	OwnerID    runtime.OwnerID // ID of the user or community that owns the video album(s).
+	Offset     int64           // Offset needed to return a specific subset of video albums.
+	Count      int64           // Number of video albums to return.
+	Extended   bool            // '1' — to return additional information about album privacy settings for the current user
 	NeedSystem bool
 }
 
-func (req VideoGetAlbums) params() Params {
+// Method returns the API method name.
+func (req VideoGetAlbums) Method() string {
+	return "video.getAlbums"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoGetAlbums) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoGetAlbums) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12225,12 +16869,23 @@ func (req VideoGetAlbums) params() Params {
 // https://vk.com/dev/video.getAlbumsByVideo
 type VideoGetAlbumsByVideo struct {
 	TargetID int64
-	OwnerID  int64
+	OwnerID  runtime.OwnerID
 	VideoID  int64
 	Extended bool
 }
 
-func (req VideoGetAlbumsByVideo) params() Params {
+// Method returns the API method name.
+func (req VideoGetAlbumsByVideo) Method() string {
+	return "video.getAlbumsByVideo"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoGetAlbumsByVideo) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoGetAlbumsByVideo) Params() Params {
 	params := make(Params)
 	if req.TargetID != 0 {
 		params["target_id"] = req.TargetID
@@ -12253,9 +16908,9 @@ func (req VideoGetAlbumsByVideo) params() Params {
 //
 // https://vk.com/dev/video.getComments
 type VideoGetComments struct {
-	OwnerID        int64 // ID of the user or community that owns the video.
-	VideoID        int64 // Video ID.
-	NeedLikes      bool  // '1' — to return an additional 'likes' field
+	OwnerID        runtime.OwnerID // ID of the user or community that owns the video.
+	VideoID        int64           // Video ID.
+	NeedLikes      bool            // '1' — to return an additional 'likes' field
 	StartCommentID int64
 	Offset         int64  // Offset needed to return a specific subset of comments.
 	Count          int64  // Number of comments to return.
@@ -12264,7 +16919,18 @@ type VideoGetComments struct {
 	Fields         []string
 }
 
-func (req VideoGetComments) params() Params {
+// Method returns the API method name.
+func (req VideoGetComments) Method() string {
+	return "video.getComments"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoGetComments) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoGetComments) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12303,11 +16969,22 @@ type VideoRemoveFromAlbum struct {
 	TargetID int64
 	AlbumID  int64
 	AlbumIDs []int64
-	OwnerID  int64
+	OwnerID  runtime.OwnerID
 	VideoID  int64
 }
 
-func (req VideoRemoveFromAlbum) params() Params {
+// Method returns the API method name.
+func (req VideoRemoveFromAlbum) Method() string {
+	return "video.removeFromAlbum"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoRemoveFromAlbum) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoRemoveFromAlbum) Params() Params {
 	params := make(Params)
 	if req.TargetID != 0 {
 		params["target_id"] = req.TargetID
@@ -12333,13 +17010,24 @@ func (req VideoRemoveFromAlbum) params() Params {
 //
 // https://vk.com/dev/video.reorderAlbums
 type VideoReorderAlbums struct {
-	OwnerID int64 // ID of the user or community that owns the albums..
-	AlbumID int64 // Album ID.
-	Before  int64 // ID of the album before which the album in question shall be placed.
-	After   int64 // ID of the album after which the album in question shall be placed.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the albums..
+	AlbumID int64           // Album ID.
+	Before  int64           // ID of the album before which the album in question shall be placed.
+	After   int64           // ID of the album after which the album in question shall be placed.
 }
 
-func (req VideoReorderAlbums) params() Params {
+// Method returns the API method name.
+func (req VideoReorderAlbums) Method() string {
+	return "video.reorderAlbums"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoReorderAlbums) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoReorderAlbums) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12362,17 +17050,28 @@ func (req VideoReorderAlbums) params() Params {
 //
 // https://vk.com/dev/video.reorderVideos
 type VideoReorderVideos struct {
-	TargetID      int64 // ID of the user or community that owns the album with videos.
-	AlbumID       int64 // ID of the video album.
-	OwnerID       int64 // ID of the user or community that owns the video.
-	VideoID       int64 // ID of the video.
-	BeforeOwnerID int64 // ID of the user or community that owns the video before which the video in question shall be placed.
-	BeforeVideoID int64 // ID of the video before which the video in question shall be placed.
-	AfterOwnerID  int64 // ID of the user or community that owns the video after which the photo in question shall be placed.
-	AfterVideoID  int64 // ID of the video after which the photo in question shall be placed.
+	TargetID      int64           // ID of the user or community that owns the album with videos.
+	AlbumID       int64           // ID of the video album.
+	OwnerID       runtime.OwnerID // ID of the user or community that owns the video.
+	VideoID       int64           // ID of the video.
+	BeforeOwnerID int64           // ID of the user or community that owns the video before which the video in question shall be placed.
+	BeforeVideoID int64           // ID of the video before which the video in question shall be placed.
+	AfterOwnerID  int64           // ID of the user or community that owns the video after which the photo in question shall be placed.
+	AfterVideoID  int64           // ID of the video after which the photo in question shall be placed.
+}
+
+// Method returns the API method name.
+func (req VideoReorderVideos) Method() string {
+	return "video.reorderVideos"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoReorderVideos) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req VideoReorderVideos) params() Params {
+// Params returns the set parameters.
+func (req VideoReorderVideos) Params() Params {
 	params := make(Params)
 	if req.TargetID != 0 {
 		params["target_id"] = req.TargetID
@@ -12407,14 +17106,25 @@ func (req VideoReorderVideos) params() Params {
 //
 // https://vk.com/dev/video.report
 type VideoReport struct {
-	OwnerID     int64  // ID of the user or community that owns the video.
-	VideoID     int64  // Video ID.
-	Reason      int64  // Reason for the complaint: '0' – spam, '1' – child pornography, '2' – extremism, '3' – violence, '4' – drug propaganda, '5' – adult material, '6' – insult, abuse
-	Comment     string // Comment describing the complaint.
-	SearchQuery string // (If the video was found in search results.) Search query string.
+	OwnerID     runtime.OwnerID // ID of the user or community that owns the video.
+	VideoID     int64           // Video ID.
+	Reason      int64           // Reason for the complaint: '0' – spam, '1' – child pornography, '2' – extremism, '3' – violence, '4' – drug propaganda, '5' – adult material, '6' – insult, abuse
+	Comment     string          // Comment describing the complaint.
+	SearchQuery string          // (If the video was found in search results.) Search query string.
 }
 
-func (req VideoReport) params() Params {
+// Method returns the API method name.
+func (req VideoReport) Method() string {
+	return "video.report"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoReport) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoReport) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12440,12 +17150,23 @@ func (req VideoReport) params() Params {
 //
 // https://vk.com/dev/video.reportComment
 type VideoReportComment struct {
-	OwnerID   int64 // ID of the user or community that owns the video.
-	CommentID int64 // ID of the comment being reported.
-	Reason    int64 // Reason for the complaint: , 0 – spam , 1 – child pornography , 2 – extremism , 3 – violence , 4 – drug propaganda , 5 – adult material , 6 – insult, abuse
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the video.
+	CommentID int64           // ID of the comment being reported.
+	Reason    int64           // Reason for the complaint: , 0 – spam , 1 – child pornography , 2 – extremism , 3 – violence , 4 – drug propaganda , 5 – adult material , 6 – insult, abuse
+}
+
+// Method returns the API method name.
+func (req VideoReportComment) Method() string {
+	return "video.reportComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoReportComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req VideoReportComment) params() Params {
+// Params returns the set parameters.
+func (req VideoReportComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12465,11 +17186,22 @@ func (req VideoReportComment) params() Params {
 //
 // https://vk.com/dev/video.restore
 type VideoRestore struct {
-	VideoID int64 // Video ID.
-	OwnerID int64 // ID of the user or community that owns the video.
+	VideoID int64           // Video ID.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the video.
 }
 
-func (req VideoRestore) params() Params {
+// Method returns the API method name.
+func (req VideoRestore) Method() string {
+	return "video.restore"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoRestore) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoRestore) Params() Params {
 	params := make(Params)
 	if req.VideoID != 0 {
 		params["video_id"] = req.VideoID
@@ -12486,11 +17218,22 @@ func (req VideoRestore) params() Params {
 //
 // https://vk.com/dev/video.restoreComment
 type VideoRestoreComment struct {
-	OwnerID   int64 // ID of the user or community that owns the video.
-	CommentID int64 // ID of the deleted comment.
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the video.
+	CommentID int64           // ID of the deleted comment.
+}
+
+// Method returns the API method name.
+func (req VideoRestoreComment) Method() string {
+	return "video.restoreComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoRestoreComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req VideoRestoreComment) params() Params {
+// Params returns the set parameters.
+func (req VideoRestoreComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12521,7 +17264,18 @@ type VideoSave struct {
 	Compression    bool
 }
 
-func (req VideoSave) params() Params {
+// Method returns the API method name.
+func (req VideoSave) Method() string {
+	return "video.save"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoSave) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoSave) Params() Params {
 	params := make(Params)
 	if req.Name != "" {
 		params["name"] = req.Name
@@ -12581,7 +17335,18 @@ type VideoSearch struct {
 	Extended  bool
 }
 
-func (req VideoSearch) params() Params {
+// Method returns the API method name.
+func (req VideoSearch) Method() string {
+	return "video.search"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req VideoSearch) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req VideoSearch) Params() Params {
 	params := make(Params)
 	if req.Q != "" {
 		params["q"] = req.Q
@@ -12623,11 +17388,22 @@ func (req VideoSearch) params() Params {
 //
 // https://vk.com/dev/wall.closeComments
 type WallCloseComments struct {
-	OwnerID int64
+	OwnerID runtime.OwnerID
 	PostID  int64
 }
 
-func (req WallCloseComments) params() Params {
+// Method returns the API method name.
+func (req WallCloseComments) Method() string {
+	return "wall.closeComments"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallCloseComments) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req WallCloseComments) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12644,17 +17420,28 @@ func (req WallCloseComments) params() Params {
 //
 // https://vk.com/dev/wall.createComment
 type WallCreateComment struct {
-	OwnerID        int64    // User ID or community ID. Use a negative value to designate a community ID.
-	PostID         int64    // Post ID.
-	FromGroup      int64    // Group ID.
-	Message        string   // (Required if 'attachments' is not set.) Text of the comment.
-	ReplyToComment int64    // ID of comment to reply.
-	Attachments    []string // (Required if 'message' is not set.) List of media objects attached to the comment, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media ojbect: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — ID of the media owner. '<media_id>' — Media ID. For example: "photo100172_166443618,photo66748_265827614"
-	StickerID      int64    // Sticker ID.
-	Guid           string   // Unique identifier to avoid repeated comments.
+	OwnerID        runtime.OwnerID // User ID or community ID. Use a negative value to designate a community ID.
+	PostID         int64           // Post ID.
+	FromGroup      int64           // Group ID.
+	Message        string          // (Required if 'attachments' is not set.) Text of the comment.
+	ReplyToComment int64           // ID of comment to reply.
+	Attachments    []string        // (Required if 'message' is not set.) List of media objects attached to the comment, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media ojbect: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — ID of the media owner. '<media_id>' — Media ID. For example: "photo100172_166443618,photo66748_265827614"
+	StickerID      int64           // Sticker ID.
+	Guid           string          // Unique identifier to avoid repeated comments.
+}
+
+// Method returns the API method name.
+func (req WallCreateComment) Method() string {
+	return "wall.createComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallCreateComment) AccessTokenType() []string {
+	return []string{"user", "group"}
 }
 
-func (req WallCreateComment) params() Params {
+// Params returns the set parameters.
+func (req WallCreateComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12689,11 +17476,22 @@ func (req WallCreateComment) params() Params {
 //
 // https://vk.com/dev/wall.delete
 type WallDelete struct {
-	OwnerID int64 // User ID or community ID. Use a negative value to designate a community ID.
-	PostID  int64 // ID of the post to be deleted.
+	OwnerID runtime.OwnerID // User ID or community ID. Use a negative value to designate a community ID.
+	PostID  int64           // ID of the post to be deleted.
 }
 
-func (req WallDelete) params() Params {
+// Method returns the API method name.
+func (req WallDelete) Method() string {
+	return "wall.delete"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallDelete) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req WallDelete) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12710,11 +17508,22 @@ func (req WallDelete) params() Params {
 //
 // https://vk.com/dev/wall.deleteComment
 type WallDeleteComment struct {
-	OwnerID   int64 // User ID or community ID. Use a negative value to designate a community ID.
-	CommentID int64 // Comment ID.
+	OwnerID   runtime.OwnerID // User ID or community ID. Use a negative value to designate a community ID.
+	CommentID int64           // Comment ID.
+}
+
+// Method returns the API method name.
+func (req WallDeleteComment) Method() string {
+	return "wall.deleteComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallDeleteComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req WallDeleteComment) params() Params {
+// Params returns the set parameters.
+func (req WallDeleteComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12731,7 +17540,7 @@ func (req WallDeleteComment) params() Params {
 //
 // https://vk.com/dev/wall.edit
 type WallEdit struct {
-	OwnerID             int64 // User ID or community ID. Use a negative value to designate a community ID.
+	OwnerID             runtime.OwnerID // User ID or community ID. Use a negative value to designate a community ID.
 	PostID              int64
 	FriendsOnly         bool
 	Message             string   // (Required if 'attachments' is not set.) Text of the post.
@@ -12750,7 +17559,18 @@ type WallEdit struct {
 	Copyright           string
 }
 
-func (req WallEdit) params() Params {
+// Method returns the API method name.
+func (req WallEdit) Method() string {
+	return "wall.edit"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallEdit) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req WallEdit) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12812,21 +17632,32 @@ func (req WallEdit) params() Params {
 //
 // https://vk.com/dev/wall.editAdsStealth
 type WallEditAdsStealth struct {
-	OwnerID     int64    // User ID or community ID. Use a negative value to designate a community ID.
-	PostID      int64    // Post ID. Used for publishing of scheduled and suggested posts.
-	Message     string   // (Required if 'attachments' is not set.) Text of the post.
-	Attachments []string // (Required if 'message' is not set.) List of objects attached to the post, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, 'page' — wiki-page, 'note' — note, 'poll' — poll, 'album' — photo album, '<owner_id>' — ID of the media application owner. '<media_id>' — Media application ID. Example: "photo100172_166443618,photo66748_265827614", May contain a link to an external page to include in the post. Example: "photo66748_265827614,http://habrahabr.ru", "NOTE: If more than one link is being attached, an error will be thrown."
-	Signed      bool     // Only for posts in communities with 'from_group' set to '1': '1' — post will be signed with the name of the posting user, '0' — post will not be signed (default)
-	Lat         float64  // Geographical latitude of a check-in, in degrees (from -90 to 90).
-	Long        float64  // Geographical longitude of a check-in, in degrees (from -180 to 180).
-	PlaceID     int64    // ID of the location where the user was tagged.
-	LinkButton  string   // Link button ID
-	LinkTitle   string   // Link title
-	LinkImage   string   // Link image url
-	LinkVideo   string   // Link video ID in format "<owner_id>_<media_id>"
+	OwnerID     runtime.OwnerID // User ID or community ID. Use a negative value to designate a community ID.
+	PostID      int64           // Post ID. Used for publishing of scheduled and suggested posts.
+	Message     string          // (Required if 'attachments' is not set.) Text of the post.
+	Attachments []string        // (Required if 'message' is not set.) List of objects attached to the post, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, 'page' — wiki-page, 'note' — note, 'poll' — poll, 'album' — photo album, '<owner_id>' — ID of the media application owner. '<media_id>' — Media application ID. Example: "photo100172_166443618,photo66748_265827614", May contain a link to an external page to include in the post. Example: "photo66748_265827614,http://habrahabr.ru", "NOTE: If more than one link is being attached, an error will be thrown."
+	Signed      bool            // Only for posts in communities with 'from_group' set to '1': '1' — post will be signed with the name of the posting user, '0' — post will not be signed (default)
+	Lat         float64         // Geographical latitude of a check-in, in degrees (from -90 to 90).
+	Long        float64         // Geographical longitude of a check-in, in degrees (from -180 to 180).
+	PlaceID     int64           // ID of the location where the user was tagged.
+	LinkButton  string          // Link button ID
+	LinkTitle   string          // Link title
+	LinkImage   string          // Link image url
+	LinkVideo   string          // Link video ID in format "<owner_id>_<media_id>"
+}
+
+// Method returns the API method name.
+func (req WallEditAdsStealth) Method() string {
+	return "wall.editAdsStealth"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallEditAdsStealth) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req WallEditAdsStealth) params() Params {
+// Params returns the set parameters.
+func (req WallEditAdsStealth) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12873,13 +17704,24 @@ func (req WallEditAdsStealth) params() Params {
 //
 // https://vk.com/dev/wall.editComment
 type WallEditComment struct {
-	OwnerID     int64    // User ID or community ID. Use a negative value to designate a community ID.
-	CommentID   int64    // Comment ID.
-	Message     string   // New comment text.
-	Attachments []string // List of objects attached to the comment, in the following format: , "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — ID of the media attachment owner. '<media_id>' — Media attachment ID. For example: "photo100172_166443618,photo66748_265827614"
+	OwnerID     runtime.OwnerID // User ID or community ID. Use a negative value to designate a community ID.
+	CommentID   int64           // Comment ID.
+	Message     string          // New comment text.
+	Attachments []string        // List of objects attached to the comment, in the following format: , "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, '<owner_id>' — ID of the media attachment owner. '<media_id>' — Media attachment ID. For example: "photo100172_166443618,photo66748_265827614"
+}
+
+// Method returns the API method name.
+func (req WallEditComment) Method() string {
+	return "wall.editComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallEditComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req WallEditComment) params() Params {
+// Params returns the set parameters.
+func (req WallEditComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12902,16 +17744,27 @@ func (req WallEditComment) params() Params {
 //
 // https://vk.com/dev/wall.get
 type WallGet struct {
-	OwnerID  int64  // ID of the user or community that owns the wall. By default, current user ID. Use a negative value to designate a community ID.
-	Domain   string // User or community short address.
-	Offset   int64  // Offset needed to return a specific subset of posts.
-	Count    int64  // Number of posts to return (maximum 100).
-	Filter   string // Filter to apply: 'owner' — posts by the wall owner, 'others' — posts by someone else, 'all' — posts by the wall owner and others (default), 'postponed' — timed posts (only available for calls with an 'access_token'), 'suggests' — suggested posts on a community wall
-	Extended bool   // '1' — to return 'wall', 'profiles', and 'groups' fields, '0' — to return no additional fields (default)
+	OwnerID  runtime.OwnerID // ID of the user or community that owns the wall. By default, current user ID. Use a negative value to designate a community ID.
+	Domain   string          // User or community short address.
+	Offset   int64           // Offset needed to return a specific subset of posts.
+	Count    int64           // Number of posts to return (maximum 100).
+	Filter   string          // Filter to apply: 'owner' — posts by the wall owner, 'others' — posts by someone else, 'all' — posts by the wall owner and others (default), 'postponed' — timed posts (only available for calls with an 'access_token'), 'suggests' — suggested posts on a community wall
+	Extended bool            // '1' — to return 'wall', 'profiles', and 'groups' fields, '0' — to return no additional fields (default)
 	Fields   []BaseUserGroupFields
 }
 
-func (req WallGet) params() Params {
+// Method returns the API method name.
+func (req WallGet) Method() string {
+	return "wall.get"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallGet) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req WallGet) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -12949,7 +17802,18 @@ type WallGetByID struct {
 	Fields           []BaseUserGroupFields
 }
 
-func (req WallGetByID) params() Params {
+// Method returns the API method name.
+func (req WallGetByID) Method() string {
+	return "wall.getById"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallGetByID) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req WallGetByID) Params() Params {
 	params := make(Params)
 	if len(req.Posts) > 0 {
 		params["posts"] = req.Posts
@@ -12972,13 +17836,24 @@ func (req WallGetByID) params() Params {
 //
 // https://vk.com/dev/wall.getComment
 type WallGetComment struct {
-	OwnerID   int64 // User ID or community ID. Use a negative value to designate a community ID.
-	CommentID int64 // Comment ID.
+	OwnerID   runtime.OwnerID // User ID or community ID. Use a negative value to designate a community ID.
+	CommentID int64           // Comment ID.
 	Extended  bool
 	Fields    []BaseUserGroupFields
 }
 
-func (req WallGetComment) params() Params {
+// Method returns the API method name.
+func (req WallGetComment) Method() string {
+	return "wall.getComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallGetComment) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req WallGetComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13001,9 +17876,9 @@ func (req WallGetComment) params() Params {
 //
 // https://vk.com/dev/wall.getComments
 type WallGetComments struct {
-	OwnerID          int64 // User ID or community ID. Use a negative value to designate a community ID.
-	PostID           int64 // Post ID.
-	NeedLikes        bool  // '1' — to return the 'likes' field, '0' — not to return the 'likes' field (default)
+	OwnerID          runtime.OwnerID // User ID or community ID. Use a negative value to designate a community ID.
+	PostID           int64           // Post ID.
+	NeedLikes        bool            // '1' — to return the 'likes' field, '0' — not to return the 'likes' field (default)
 	StartCommentID   int64
 	Offset           int64  // Offset needed to return a specific subset of comments.
 	Count            int64  // Number of comments to return (maximum 100).
@@ -13015,7 +17890,18 @@ type WallGetComments struct {
 	ThreadItemsCount int64 // Count items in threads.
 }
 
-func (req WallGetComments) params() Params {
+// Method returns the API method name.
+func (req WallGetComments) Method() string {
+	return "wall.getComments"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallGetComments) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req WallGetComments) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13062,13 +17948,24 @@ func (req WallGetComments) params() Params {
 //
 // https://vk.com/dev/wall.getReposts
 type WallGetReposts struct {
-	OwnerID int64 // User ID or community ID. By default, current user ID. Use a negative value to designate a community ID.
-	PostID  int64 // Post ID.
-	Offset  int64 // Offset needed to return a specific subset of reposts.
-	Count   int64 // Number of reposts to return.
+	OwnerID runtime.OwnerID // User ID or community ID. By default, current user ID. Use a negative value to designate a community ID.
+	PostID  int64           // Post ID.
+	Offset  int64           // Offset needed to return a specific subset of reposts.
+	Count   int64           // Number of reposts to return.
+}
+
+// Method returns the API method name.
+func (req WallGetReposts) Method() string {
+	return "wall.getReposts"
 }
 
-func (req WallGetReposts) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req WallGetReposts) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req WallGetReposts) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13089,11 +17986,22 @@ func (req WallGetReposts) params() Params {
 //
 // https://vk.com/dev/wall.openComments
 type WallOpenComments struct {
-	OwnerID int64
+	OwnerID runtime.OwnerID
 	PostID  int64
 }
 
-func (req WallOpenComments) params() Params {
+// Method returns the API method name.
+func (req WallOpenComments) Method() string {
+	return "wall.openComments"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallOpenComments) AccessTokenType() []string {
+	return []string{"user", "group"}
+}
+
+// Params returns the set parameters.
+func (req WallOpenComments) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13110,11 +18018,22 @@ func (req WallOpenComments) params() Params {
 //
 // https://vk.com/dev/wall.pin
 type WallPin struct {
-	OwnerID int64 // ID of the user or community that owns the wall. By default, current user ID. Use a negative value to designate a community ID.
-	PostID  int64 // Post ID.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the wall. By default, current user ID. Use a negative value to designate a community ID.
+	PostID  int64           // Post ID.
+}
+
+// Method returns the API method name.
+func (req WallPin) Method() string {
+	return "wall.pin"
 }
 
-func (req WallPin) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req WallPin) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req WallPin) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13131,18 +18050,18 @@ func (req WallPin) params() Params {
 //
 // https://vk.com/dev/wall.post
 type WallPost struct {
-	OwnerID           int64    // User ID or community ID. Use a negative value to designate a community ID.
-	FriendsOnly       bool     // '1' — post will be available to friends only, '0' — post will be available to all users (default)
-	FromGroup         bool     // For a community: '1' — post will be published by the community, '0' — post will be published by the user (default)
-	Message           string   // (Required if 'attachments' is not set.) Text of the post.
-	Attachments       []string // (Required if 'message' is not set.) List of objects attached to the post, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, 'page' — wiki-page, 'note' — note, 'poll' — poll, 'album' — photo album, '<owner_id>' — ID of the media application owner. '<media_id>' — Media application ID. Example: "photo100172_166443618,photo66748_265827614", May contain a link to an external page to include in the post. Example: "photo66748_265827614,http://habrahabr.ru", "NOTE: If more than one link is being attached, an error will be thrown."
-	Services          string   // List of services or websites the update will be exported to, if the user has so requested. Sample values: 'twitter', 'facebook'.
-	Signed            bool     // Only for posts in communities with 'from_group' set to '1': '1' — post will be signed with the name of the posting user, '0' — post will not be signed (default)
-	PublishDate       int64    // Publication date (in Unix time). If used, posting will be delayed until the set time.
-	Lat               float64  // Geographical latitude of a check-in, in degrees (from -90 to 90).
-	Long              float64  // Geographical longitude of a check-in, in degrees (from -180 to 180).
-	PlaceID           int64    // ID of the location where the user was tagged.
-	PostID            int64    // Post ID. Used for publishing of scheduled and suggested posts.
+	OwnerID           runtime.OwnerID // User ID or community ID. Use a negative value to designate a community ID.
+	FriendsOnly       bool            // '1' — post will be available to friends only, '0' — post will be available to all users (default)
+	FromGroup         bool            // For a community: '1' — post will be published by the community, '0' — post will be published by the user (default)
+	Message           string          // (Required if 'attachments' is not set.) Text of the post.
+	Attachments       []string        // (Required if 'message' is not set.) List of objects attached to the post, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, 'page' — wiki-page, 'note' — note, 'poll' — poll, 'album' — photo album, '<owner_id>' — ID of the media application owner. '<media_id>' — Media application ID. Example: "photo100172_166443618,photo66748_265827614", May contain a link to an external page to include in the post. Example: "photo66748_265827614,http://habrahabr.ru", "NOTE: If more than one link is being attached, an error will be thrown."
+	Services          string          // List of services or websites the update will be exported to, if the user has so requested. Sample values: 'twitter', 'facebook'.
+	Signed            bool            // Only for posts in communities with 'from_group' set to '1': '1' — post will be signed with the name of the posting user, '0' — post will not be signed (default)
+	PublishDate       int64           // Publication date (in Unix time). If used, posting will be delayed until the set time.
+	Lat               float64         // Geographical latitude of a check-in, in degrees (from -90 to 90).
+	Long              float64         // Geographical longitude of a check-in, in degrees (from -180 to 180).
+	PlaceID           int64           // ID of the location where the user was tagged.
+	PostID            int64           // Post ID. Used for publishing of scheduled and suggested posts.
 	Guid              string
 	MarkAsAds         bool
 	CloseComments     bool
@@ -13150,7 +18069,18 @@ type WallPost struct {
 	Copyright         string
 }
 
-func (req WallPost) params() Params {
+// Method returns the API method name.
+func (req WallPost) Method() string {
+	return "wall.post"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallPost) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req WallPost) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13212,21 +18142,32 @@ func (req WallPost) params() Params {
 //
 // https://vk.com/dev/wall.postAdsStealth
 type WallPostAdsStealth struct {
-	OwnerID     int64    // User ID or community ID. Use a negative value to designate a community ID.
-	Message     string   // (Required if 'attachments' is not set.) Text of the post.
-	Attachments []string // (Required if 'message' is not set.) List of objects attached to the post, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, 'page' — wiki-page, 'note' — note, 'poll' — poll, 'album' — photo album, '<owner_id>' — ID of the media application owner. '<media_id>' — Media application ID. Example: "photo100172_166443618,photo66748_265827614", May contain a link to an external page to include in the post. Example: "photo66748_265827614,http://habrahabr.ru", "NOTE: If more than one link is being attached, an error will be thrown."
-	Signed      bool     // Only for posts in communities with 'from_group' set to '1': '1' — post will be signed with the name of the posting user, '0' — post will not be signed (default)
-	Lat         float64  // Geographical latitude of a check-in, in degrees (from -90 to 90).
-	Long        float64  // Geographical longitude of a check-in, in degrees (from -180 to 180).
-	PlaceID     int64    // ID of the location where the user was tagged.
-	Guid        string   // Unique identifier to avoid duplication the same post.
-	LinkButton  string   // Link button ID
-	LinkTitle   string   // Link title
-	LinkImage   string   // Link image url
-	LinkVideo   string   // Link video ID in format "<owner_id>_<media_id>"
+	OwnerID     runtime.OwnerID // User ID or community ID. Use a negative value to designate a community ID.
+	Message     string          // (Required if 'attachments' is not set.) Text of the post.
+	Attachments []string        // (Required if 'message' is not set.) List of objects attached to the post, in the following format: "<owner_id>_<media_id>,<owner_id>_<media_id>", '' — Type of media attachment: 'photo' — photo, 'video' — video, 'audio' — audio, 'doc' — document, 'page' — wiki-page, 'note' — note, 'poll' — poll, 'album' — photo album, '<owner_id>' — ID of the media application owner. '<media_id>' — Media application ID. Example: "photo100172_166443618,photo66748_265827614", May contain a link to an external page to include in the post. Example: "photo66748_265827614,http://habrahabr.ru", "NOTE: If more than one link is being attached, an error will be thrown."
+	Signed      bool            // Only for posts in communities with 'from_group' set to '1': '1' — post will be signed with the name of the posting user, '0' — post will not be signed (default)
+	Lat         float64         // Geographical latitude of a check-in, in degrees (from -90 to 90).
+	Long        float64         // Geographical longitude of a check-in, in degrees (from -180 to 180).
+	PlaceID     int64           // ID of the location where the user was tagged.
+	Guid        string          // Unique identifier to avoid duplication the same post.
+	LinkButton  string          // Link button ID
+	LinkTitle   string          // Link title
+	LinkImage   string          // Link image url
+	LinkVideo   string          // Link video ID in format "<owner_id>_<media_id>"
+}
+
+// Method returns the API method name.
+func (req WallPostAdsStealth) Method() string {
+	return "wall.postAdsStealth"
 }
 
-func (req WallPostAdsStealth) params() Params {
+// AccessTokenType returns the token types the method accepts.
+func (req WallPostAdsStealth) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req WallPostAdsStealth) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13273,12 +18214,23 @@ func (req WallPostAdsStealth) params() Params {
 //
 // https://vk.com/dev/wall.reportComment
 type WallReportComment struct {
-	OwnerID   int64 // ID of the user or community that owns the wall.
-	CommentID int64 // Comment ID.
-	Reason    int64 // Reason for the complaint: '0' – spam, '1' – child pornography, '2' – extremism, '3' – violence, '4' – drug propaganda, '5' – adult material, '6' – insult, abuse
+	OwnerID   runtime.OwnerID // ID of the user or community that owns the wall.
+	CommentID int64           // Comment ID.
+	Reason    int64           // Reason for the complaint: '0' – spam, '1' – child pornography, '2' – extremism, '3' – violence, '4' – drug propaganda, '5' – adult material, '6' – insult, abuse
+}
+
+// Method returns the API method name.
+func (req WallReportComment) Method() string {
+	return "wall.reportComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallReportComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req WallReportComment) params() Params {
+// Params returns the set parameters.
+func (req WallReportComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13298,12 +18250,23 @@ func (req WallReportComment) params() Params {
 //
 // https://vk.com/dev/wall.reportPost
 type WallReportPost struct {
-	OwnerID int64 // ID of the user or community that owns the wall.
-	PostID  int64 // Post ID.
-	Reason  int64 // Reason for the complaint: '0' – spam, '1' – child pornography, '2' – extremism, '3' – violence, '4' – drug propaganda, '5' – adult material, '6' – insult, abuse
+	OwnerID runtime.OwnerID // ID of the user or community that owns the wall.
+	PostID  int64           // Post ID.
+	Reason  int64           // Reason for the complaint: '0' – spam, '1' – child pornography, '2' – extremism, '3' – violence, '4' – drug propaganda, '5' – adult material, '6' – insult, abuse
+}
+
+// Method returns the API method name.
+func (req WallReportPost) Method() string {
+	return "wall.reportPost"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallReportPost) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req WallReportPost) params() Params {
+// Params returns the set parameters.
+func (req WallReportPost) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13330,7 +18293,18 @@ type WallRepost struct {
 	MuteNotifications bool
 }
 
-func (req WallRepost) params() Params {
+// Method returns the API method name.
+func (req WallRepost) Method() string {
+	return "wall.repost"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallRepost) AccessTokenType() []string {
+	return []string{"user"}
+}
+
+// Params returns the set parameters.
+func (req WallRepost) Params() Params {
 	params := make(Params)
 	if req.Object != "" {
 		params["object"] = req.Object
@@ -13356,11 +18330,22 @@ func (req WallRepost) params() Params {
 //
 // https://vk.com/dev/wall.restore
 type WallRestore struct {
-	OwnerID int64 // User ID or community ID from whose wall the post was deleted. Use a negative value to designate a community ID.
-	PostID  int64 // ID of the post to be restored.
+	OwnerID runtime.OwnerID // User ID or community ID from whose wall the post was deleted. Use a negative value to designate a community ID.
+	PostID  int64           // ID of the post to be restored.
+}
+
+// Method returns the API method name.
+func (req WallRestore) Method() string {
+	return "wall.restore"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallRestore) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req WallRestore) params() Params {
+// Params returns the set parameters.
+func (req WallRestore) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13377,11 +18362,22 @@ func (req WallRestore) params() Params {
 //
 // https://vk.com/dev/wall.restoreComment
 type WallRestoreComment struct {
-	OwnerID   int64 // User ID or community ID. Use a negative value to designate a community ID.
-	CommentID int64 // Comment ID.
+	OwnerID   runtime.OwnerID // User ID or community ID. Use a negative value to designate a community ID.
+	CommentID int64           // Comment ID.
+}
+
+// Method returns the API method name.
+func (req WallRestoreComment) Method() string {
+	return "wall.restoreComment"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallRestoreComment) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req WallRestoreComment) params() Params {
+// Params returns the set parameters.
+func (req WallRestoreComment) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13398,17 +18394,28 @@ func (req WallRestoreComment) params() Params {
 //
 // https://vk.com/dev/wall.search
 type WallSearch struct {
-	OwnerID    int64  // user or community id. "Remember that for a community 'owner_id' must be negative."
-	Domain     string // user or community screen name.
-	Query      string // search query string.
-	OwnersOnly bool   // '1' – returns only page owner's posts.
-	Count      int64  // count of posts to return.
-	Offset     int64  // Offset needed to return a specific subset of posts.
-	Extended   bool   // show extended post info.
+	OwnerID    runtime.OwnerID // user or community id. "Remember that for a community 'owner_id' must be negative."
+	Domain     string          // user or community screen name.
+	Query      string          // search query string.
+	OwnersOnly bool            // '1' – returns only page owner's posts.
+	Count      int64           // count of posts to return.
+	Offset     int64           // Offset needed to return a specific subset of posts.
+	Extended   bool            // show extended post info.
 	Fields     []BaseUserGroupFields
 }
 
-func (req WallSearch) params() Params {
+// Method returns the API method name.
+func (req WallSearch) Method() string {
+	return "wall.search"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallSearch) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req WallSearch) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13443,11 +18450,22 @@ func (req WallSearch) params() Params {
 //
 // https://vk.com/dev/wall.unpin
 type WallUnpin struct {
-	OwnerID int64 // ID of the user or community that owns the wall. By default, current user ID. Use a negative value to designate a community ID.
-	PostID  int64 // Post ID.
+	OwnerID runtime.OwnerID // ID of the user or community that owns the wall. By default, current user ID. Use a negative value to designate a community ID.
+	PostID  int64           // Post ID.
+}
+
+// Method returns the API method name.
+func (req WallUnpin) Method() string {
+	return "wall.unpin"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WallUnpin) AccessTokenType() []string {
+	return []string{"user"}
 }
 
-func (req WallUnpin) params() Params {
+// Params returns the set parameters.
+func (req WallUnpin) Params() Params {
 	params := make(Params)
 	if req.OwnerID != 0 {
 		params["owner_id"] = req.OwnerID
@@ -13473,7 +18491,18 @@ type WidgetsGetComments struct {
 	Count       int64
 }
 
-func (req WidgetsGetComments) params() Params {
+// Method returns the API method name.
+func (req WidgetsGetComments) Method() string {
+	return "widgets.getComments"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WidgetsGetComments) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req WidgetsGetComments) Params() Params {
 	params := make(Params)
 	if req.WidgetApiID != 0 {
 		params["widget_api_id"] = req.WidgetApiID
@@ -13512,7 +18541,18 @@ type WidgetsGetPages struct {
 	Count       int64
 }
 
-func (req WidgetsGetPages) params() Params {
+// Method returns the API method name.
+func (req WidgetsGetPages) Method() string {
+	return "widgets.getPages"
+}
+
+// AccessTokenType returns the token types the method accepts.
+func (req WidgetsGetPages) AccessTokenType() []string {
+	return []string{"user", "service"}
+}
+
+// Params returns the set parameters.
+func (req WidgetsGetPages) Params() Params {
 	params := make(Params)
 	if req.WidgetApiID != 0 {
 		params["widget_api_id"] = req.WidgetApiID
@@ -0,0 +1,257 @@
+// Code generated by vkgen; DO NOT EDIT.
+
+package generated
+
+import (
+	"context"
+	"io"
+
+	"github.com/cqln/vkgen/runtime"
+)
+
+// UploadMessagesDoc uploads a doc to the server from docs.getMessagesUploadServer
+// and saves it with docs.save. Parameters shared by both methods
+// are taken from req unless set in save.
+func (vk *VK) UploadMessagesDoc(ctx context.Context, req DocsGetMessagesUploadServer, save DocsSave, file io.Reader) (response DocsSaveResponse, err error) {
+	var server runtime.UploadServer
+	serverParams := req.Params()
+	err = vk.CallUnmarshal(ctx, req, serverParams, &server)
+	if err != nil {
+		return
+	}
+
+	uploaded, err := vk.Upload(ctx, server.UploadURL, "file", file)
+	if err != nil {
+		return
+	}
+
+	params := save.Params()
+	params.Merge(uploaded, "file", "title", "tags", "return_tags")
+	err = vk.CallUnmarshal(ctx, save, params, &response)
+	return
+}
+
+// UploadDoc uploads a doc to the server from docs.getUploadServer
+// and saves it with docs.save. Parameters shared by both methods
+// are taken from req unless set in save.
+func (vk *VK) UploadDoc(ctx context.Context, req DocsGetUploadServer, save DocsSave, file io.Reader) (response DocsSaveResponse, err error) {
+	var server runtime.UploadServer
+	serverParams := req.Params()
+	err = vk.CallUnmarshal(ctx, req, serverParams, &server)
+	if err != nil {
+		return
+	}
+
+	uploaded, err := vk.Upload(ctx, server.UploadURL, "file", file)
+	if err != nil {
+		return
+	}
+
+	params := save.Params()
+	params.Merge(uploaded, "file", "title", "tags", "return_tags")
+	err = vk.CallUnmarshal(ctx, save, params, &response)
+	return
+}
+
+// UploadWallDoc uploads a doc to the server from docs.getWallUploadServer
+// and saves it with docs.save. Parameters shared by both methods
+// are taken from req unless set in save.
+func (vk *VK) UploadWallDoc(ctx context.Context, req DocsGetWallUploadServer, save DocsSave, file io.Reader) (response DocsSaveResponse, err error) {
+	var server runtime.UploadServer
+	serverParams := req.Params()
+	err = vk.CallUnmarshal(ctx, req, serverParams, &server)
+	if err != nil {
+		return
+	}
+
+	uploaded, err := vk.Upload(ctx, server.UploadURL, "file", file)
+	if err != nil {
+		return
+	}
+
+	params := save.Params()
+	params.Merge(uploaded, "file", "title", "tags", "return_tags")
+	err = vk.CallUnmarshal(ctx, save, params, &response)
+	return
+}
+
+// UploadChatPhoto uploads a photo to the server from photos.getChatUploadServer
+// and saves it with messages.setChatPhoto. Parameters shared by both methods
+// are taken from req unless set in save.
+func (vk *VK) UploadChatPhoto(ctx context.Context, req PhotosGetChatUploadServer, save MessagesSetChatPhoto, file io.Reader) (response MessagesSetChatPhotoResponse, err error) {
+	var server runtime.UploadServer
+	serverParams := req.Params()
+	err = vk.CallUnmarshal(ctx, req, serverParams, &server)
+	if err != nil {
+		return
+	}
+
+	uploaded, err := vk.Upload(ctx, server.UploadURL, "file", file)
+	if err != nil {
+		return
+	}
+	uploaded["file"] = uploaded["response"]
+
+	params := save.Params()
+	params.Merge(uploaded, "file")
+	err = vk.CallUnmarshal(ctx, save, params, &response)
+	return
+}
+
+// UploadMarketAlbumPhoto uploads a photo to the server from photos.getMarketAlbumUploadServer
+// and saves it with photos.saveMarketAlbumPhoto. Parameters shared by both methods
+// are taken from req unless set in save.
+func (vk *VK) UploadMarketAlbumPhoto(ctx context.Context, req PhotosGetMarketAlbumUploadServer, save PhotosSaveMarketAlbumPhoto, file io.Reader) (response PhotosSaveMarketAlbumPhotoResponse, err error) {
+	var server runtime.UploadServer
+	serverParams := req.Params()
+	err = vk.CallUnmarshal(ctx, req, serverParams, &server)
+	if err != nil {
+		return
+	}
+
+	uploaded, err := vk.Upload(ctx, server.UploadURL, "file", file)
+	if err != nil {
+		return
+	}
+
+	params := save.Params()
+	params.Defaults(serverParams, "group_id")
+	params.Merge(uploaded, "photo", "server", "hash")
+	err = vk.CallUnmarshal(ctx, save, params, &response)
+	return
+}
+
+// UploadMarketPhoto uploads a photo to the server from photos.getMarketUploadServer
+// and saves it with photos.saveMarketPhoto. Parameters shared by both methods
+// are taken from req unless set in save.
+func (vk *VK) UploadMarketPhoto(ctx context.Context, req PhotosGetMarketUploadServer, save PhotosSaveMarketPhoto, file io.Reader) (response PhotosSaveMarketPhotoResponse, err error) {
+	var server runtime.UploadServer
+	serverParams := req.Params()
+	err = vk.CallUnmarshal(ctx, req, serverParams, &server)
+	if err != nil {
+		return
+	}
+
+	uploaded, err := vk.Upload(ctx, server.UploadURL, "file", file)
+	if err != nil {
+		return
+	}
+
+	params := save.Params()
+	params.Defaults(serverParams, "group_id")
+	params.Merge(uploaded, "photo", "server", "hash", "crop_data", "crop_hash")
+	err = vk.CallUnmarshal(ctx, save, params, &response)
+	return
+}
+
+// UploadMessagesPhoto uploads a photo to the server from photos.getMessagesUploadServer
+// and saves it with photos.saveMessagesPhoto. Parameters shared by both methods
+// are taken from req unless set in save.
+func (vk *VK) UploadMessagesPhoto(ctx context.Context, req PhotosGetMessagesUploadServer, save PhotosSaveMessagesPhoto, file io.Reader) (response PhotosSaveMessagesPhotoResponse, err error) {
+	var server runtime.UploadServer
+	serverParams := req.Params()
+	err = vk.CallUnmarshal(ctx, req, serverParams, &server)
+	if err != nil {
+		return
+	}
+
+	uploaded, err := vk.Upload(ctx, server.UploadURL, "photo", file)
+	if err != nil {
+		return
+	}
+
+	params := save.Params()
+	params.Merge(uploaded, "photo", "server", "hash")
+	err = vk.CallUnmarshal(ctx, save, params, &response)
+	return
+}
+
+// UploadOwnerCoverPhoto uploads a photo to the server from photos.getOwnerCoverPhotoUploadServer
+// and saves it with photos.saveOwnerCoverPhoto. Parameters shared by both methods
+// are taken from req unless set in save.
+func (vk *VK) UploadOwnerCoverPhoto(ctx context.Context, req PhotosGetOwnerCoverPhotoUploadServer, save PhotosSaveOwnerCoverPhoto, file io.Reader) (response PhotosSaveOwnerCoverPhotoResponse, err error) {
+	var server runtime.UploadServer
+	serverParams := req.Params()
+	err = vk.CallUnmarshal(ctx, req, serverParams, &server)
+	if err != nil {
+		return
+	}
+
+	uploaded, err := vk.Upload(ctx, server.UploadURL, "photo", file)
+	if err != nil {
+		return
+	}
+
+	params := save.Params()
+	params.Merge(uploaded, "hash", "photo")
+	err = vk.CallUnmarshal(ctx, save, params, &response)
+	return
+}
+
+// UploadOwnerPhoto uploads a photo to the server from photos.getOwnerPhotoUploadServer
+// and saves it with photos.saveOwnerPhoto. Parameters shared by both methods
+// are taken from req unless set in save.
+func (vk *VK) UploadOwnerPhoto(ctx context.Context, req PhotosGetOwnerPhotoUploadServer, save PhotosSaveOwnerPhoto, file io.Reader) (response PhotosSaveOwnerPhotoResponse, err error) {
+	var server runtime.UploadServer
+	serverParams := req.Params()
+	err = vk.CallUnmarshal(ctx, req, serverParams, &server)
+	if err != nil {
+		return
+	}
+
+	uploaded, err := vk.Upload(ctx, server.UploadURL, "photo", file)
+	if err != nil {
+		return
+	}
+
+	params := save.Params()
+	params.Merge(uploaded, "server", "hash", "photo")
+	err = vk.CallUnmarshal(ctx, save, params, &response)
+	return
+}
+
+// UploadPhoto uploads a photo to the server from photos.getUploadServer
+// and saves it with photos.save. Parameters shared by both methods
+// are taken from req unless set in save.
+func (vk *VK) UploadPhoto(ctx context.Context, req PhotosGetUploadServer, save PhotosSave, file io.Reader) (response PhotosSaveResponse, err error) {
+	var server runtime.UploadServer
+	serverParams := req.Params()
+	err = vk.CallUnmarshal(ctx, req, serverParams, &server)
+	if err != nil {
+		return
+	}
+
+	uploaded, err := vk.Upload(ctx, server.UploadURL, "file1", file)
+	if err != nil {
+		return
+	}
+
+	params := save.Params()
+	params.Defaults(serverParams, "album_id", "group_id")
+	params.Merge(uploaded, "server", "photos_list", "hash", "latitude", "longitude", "caption")
+	err = vk.CallUnmarshal(ctx, save, params, &response)
+	return
+}
+
+// UploadWallPhoto uploads a photo to the server from photos.getWallUploadServer
+// and saves it with photos.saveWallPhoto. Parameters shared by both methods
+// are taken from req unless set in save.
+func (vk *VK) UploadWallPhoto(ctx context.Context, req PhotosGetWallUploadServer, save PhotosSaveWallPhoto, file io.Reader) (response PhotosSaveWallPhotoResponse, err error) {
+	var server runtime.UploadServer
+	serverParams := req.Params()
+	err = vk.CallUnmarshal(ctx, req, serverParams, &server)
+	if err != nil {
+		return
+	}
+
+	uploaded, err := vk.Upload(ctx, server.UploadURL, "photo", file)
+	if err != nil {
+		return
+	}
+
+	params := save.Params()
+	params.Defaults(serverParams, "group_id")
+	params.Merge(uploaded, "user_id", "photo", "server", "hash", "latitude", "longitude", "caption")
+	err = vk.CallUnmarshal(ctx, save, params, &response)
+	return
+}
@@ -0,0 +1,18 @@
+// Code generated by vkgen; DO NOT EDIT.
+
+package generated
+
+import "github.com/cqln/vkgen/runtime"
+
+// VK is the VK API client the generated methods are attached to.
+type VK struct {
+	*runtime.VK
+}
+
+// NewVK returns a client authorized with the access token.
+func NewVK(token string) *VK {
+	return &VK{runtime.NewVK(token)}
+}
+
+// Params are raw method parameters.
+type Params = runtime.Params
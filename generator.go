@@ -5,28 +5,72 @@ import (
 	"fmt"
 	"go/format"
 	"io/ioutil"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/cqln/vkgen/schema"
 )
 
 const (
-	genPrefix = "// Code generated by vkgen; DO NOT EDIT."
-	pkgName   = "generated"
+	genPrefix      = "// Code generated by vkgen; DO NOT EDIT."
+	defaultPkgName = "generated"
+	runtimePkg     = "github.com/cqln/vkgen/runtime"
 )
 
+// Options control the generator behaviour and the set of emitted files.
+type Options struct {
+	NoFmt    bool   // disable code formatting
+	NoGoify  bool   // disable names gopherization
+	Debug    bool   // print timings, definitions and fallbacks
+	Tests    bool   // emit round-trip and fuzz tests for objects and responses
+	Strict   bool   // emit required fields registry for strict decoding
+	FastJSON bool   // emit reflection-free MarshalJSON/UnmarshalJSON
+	Lenient  bool   // emit tolerant decoding of known-flaky fields
+	Events   string // events schema file relative to SchemaDir, none when empty
+	// NoAttachments, NoUploads, NoLongPoll and NoCache turn off the
+	// emitters of the helpers built on top of the client.
+	NoAttachments bool
+	NoUploads     bool
+	NoLongPoll    bool
+	NoCache       bool
+	Config        Config
+	// SchemaDir holds the methods, objects and responses schemas.
+	SchemaDir string
+	// OutDir receives the generated files, "generated" when empty.
+	OutDir string
+	// Package names the generated package, "generated" when empty.
+	Package string
+	// Sink receives the generated files instead of the file system.
+	Sink func(name string, src []byte) error
+}
+
 type Generator struct {
 	parser        *schema.Parser
 	nofmt         bool
 	nogoify       bool
 	debug         bool
+	tests         bool
+	strict        bool
+	fastJSON      bool
+	lenient       bool
+	eventsFile    string
+	noAttachments bool
+	noUploads     bool
+	noLongPoll    bool
+	noCache       bool
+	config        Config
+	schemaDir     string
+	outDir        string
+	pkgName       string
+	sink          func(name string, src []byte) error
 	goifyReplacer *strings.Replacer
 }
 
-func NewGenerator(nofmt, nogoify, debug bool, objectsSchema []byte) Generator {
+func NewGenerator(opts Options, objectsSchema []byte) Generator {
 	repl := []string{
 		"_", "",
 		" ", "",
@@ -41,72 +85,113 @@ func NewGenerator(nofmt, nogoify, debug bool, objectsSchema []byte) Generator {
 		"Url", "URL",
 	}
 
+	outDir, pkgName := opts.OutDir, opts.Package
+	if outDir == "" {
+		outDir = defaultPkgName
+	}
+	if pkgName == "" {
+		pkgName = defaultPkgName
+	}
+
 	return Generator{
 		parser:        schema.NewParser(objectsSchema),
-		nofmt:         nofmt,
-		nogoify:       nogoify,
-		debug:         debug,
+		nofmt:         opts.NoFmt,
+		nogoify:       opts.NoGoify,
+		debug:         opts.Debug,
+		tests:         opts.Tests,
+		strict:        opts.Strict,
+		fastJSON:      opts.FastJSON,
+		lenient:       opts.Lenient,
+		eventsFile:    opts.Events,
+		noAttachments: opts.NoAttachments,
+		noUploads:     opts.NoUploads,
+		noLongPoll:    opts.NoLongPoll,
+		noCache:       opts.NoCache,
+		config:        opts.Config,
+		schemaDir:     opts.SchemaDir,
+		outDir:        outDir,
+		pkgName:       pkgName,
+		sink:          opts.Sink,
 		goifyReplacer: strings.NewReplacer(repl...),
 	}
 }
 
-func (g Generator) Generate() (err error) {
-	err = g.generateObjects()
-	if err != nil {
-		return err
-	}
-
-	err = g.generateResponses()
-	if err != nil {
-		return fmt.Errorf("responses: %w", err)
-	}
+// emitter generates one part of the package.
+type emitter struct {
+	name    string
+	enabled bool
+	emit    func() error
+}
 
-	err = g.generateMethods()
-	if err != nil {
-		return fmt.Errorf("methods: %w", err)
-	}
-
-	err = g.generateMethodsTypeSafe()
-	if err != nil {
-		return fmt.Errorf("methods type-safe: %w", err)
+func (g Generator) emitters() []emitter {
+	return []emitter{
+		{"client", true, g.generateClient},
+		{"objects", true, g.generateObjects},
+		{"responses", true, g.generateResponses},
+		{"methods", true, g.generateMethods},
+		{"methods type-safe", true, g.generateMethodsTypeSafe},
+		{"builders", true, g.generateBuilders},
+		{"requests", true, g.generateRequests},
+		{"attachments", !g.noAttachments, g.generateAttachments},
+		{"uploads", !g.noUploads, g.generateUploads},
+		{"events", g.eventsFile != "", g.generateEvents},
+		{"long poll", !g.noLongPoll, g.generateLongPoll},
+		{"cache", !g.noCache, g.generateCache},
+		{"json", g.fastJSON, g.generateFastJSON},
+		{"lenient", g.lenient, g.generateLenient},
+		{"strict", g.strict, g.generateStrict},
+		{"tests", g.tests, g.generateTests},
 	}
+}
 
-	err = g.generateBuilders()
-	if err != nil {
-		return fmt.Errorf("builders: %w", err)
+func (g Generator) Generate() error {
+	for _, e := range g.emitters() {
+		if !e.enabled {
+			continue
+		}
+		start := time.Now()
+		if err := e.emit(); err != nil {
+			return fmt.Errorf("%s: %w", e.name, err)
+		}
+		g.debugf("%s: %v", e.name, time.Since(start))
 	}
-
-	err = g.generateRequests()
-	if err != nil {
-		return fmt.Errorf("requests: %w", err)
+	if g.debug {
+		return g.debugFallbacks()
 	}
+	return nil
+}
 
-	return
+func (g Generator) readSchema(file schema.SchemaType) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(g.schemaDir, string(file)))
 }
 
 func (g Generator) writeSource(name string, b *bytes.Buffer) error {
-	if g.nofmt {
-		return ioutil.WriteFile(name, b.Bytes(), 0677)
+	src := b.Bytes()
+	if !g.nofmt {
+		var err error
+		src, err = format.Source(src)
+		if err != nil {
+			return err
+		}
 	}
 
-	src, err := format.Source(b.Bytes())
-	if err != nil {
-		return err
+	name = filepath.Join(g.outDir, name)
+	if g.sink != nil {
+		return g.sink(name, src)
 	}
-
 	return ioutil.WriteFile(name, src, 0677)
 }
 
 type callback = func(b *bytes.Buffer, schema []byte) error
 
-func (g Generator) generate(schemaFile, outputName string, cb callback) error {
-	sch, err := ioutil.ReadFile(schemaFile)
+func (g Generator) generate(schemaFile schema.SchemaType, outputName string, cb callback) error {
+	sch, err := g.readSchema(schemaFile)
 	if err != nil {
 		return err
 	}
 
 	b := bytes.NewBuffer(nil)
-	b.WriteString(genPrefix + "\n\npackage " + pkgName + "\n")
+	b.WriteString(genPrefix + "\n\npackage " + g.pkgName + "\n")
 
 	err = cb(b, sch)
 	if err != nil {
@@ -116,13 +201,31 @@ func (g Generator) generate(schemaFile, outputName string, cb callback) error {
 	return g.writeSource(outputName, b)
 }
 
+func (g Generator) generateClient() error {
+	b := bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\npackage " + g.pkgName + "\n\n")
+	b.WriteString("import \"" + runtimePkg + "\"\n\n")
+	b.WriteString("// VK is the VK API client the generated methods are attached to.\n")
+	b.WriteString("type VK struct {\n")
+	b.WriteString("\t*runtime.VK\n")
+	b.WriteString("}\n\n")
+	b.WriteString("// NewVK returns a client authorized with the access token.\n")
+	b.WriteString("func NewVK(token string) *VK {\n")
+	b.WriteString("\treturn &VK{runtime.NewVK(token)}\n")
+	b.WriteString("}\n\n")
+	b.WriteString("// Params are raw method parameters.\n")
+	b.WriteString("type Params = runtime.Params\n")
+	return g.writeSource("vk.gen.go", b)
+}
+
 func (g Generator) generateObjects() error {
-	return g.generate("objects.json", pkgName+"/objects.gen.go",
+	return g.generate(schema.ObjectsSchema, "objects.gen.go",
 		func(b *bytes.Buffer, objectsSchema []byte) error {
 			objects, err := g.parser.ParseObjects(objectsSchema)
 			if err != nil {
 				return err
 			}
+			g.debugf("objects: %d definitions", len(objects))
 			for _, object := range objects {
 				b.WriteString(g.ObjectDefinitionToGolang(object) + "\n")
 			}
@@ -132,12 +235,13 @@ func (g Generator) generateObjects() error {
 }
 
 func (g Generator) generateResponses() error {
-	return g.generate("responses.json", pkgName+"/responses.gen.go",
+	return g.generate(schema.ResponsesSchema, "responses.gen.go",
 		func(b *bytes.Buffer, responsesSchema []byte) error {
 			responses, err := g.parser.ParseResponses(responsesSchema)
 			if err != nil {
 				return err
 			}
+			g.debugf("responses: %d definitions", len(responses))
 
 			for _, response := range responses {
 				typ := g.ResponseDefinitionToGolang(response)
@@ -148,12 +252,13 @@ func (g Generator) generateResponses() error {
 }
 
 func (g Generator) generateMethods() error {
-	return g.generate("methods.json", pkgName+"/methods.gen.go",
+	return g.generate(schema.MethodsSchema, "methods.gen.go",
 		func(b *bytes.Buffer, methodsSchema []byte) error {
 			methods, err := g.parser.ParseMethods(methodsSchema)
 			if err != nil {
 				return err
 			}
+			g.debugf("methods: %d definitions", len(methods))
 
 			for _, method := range methods {
 				for _, response := range method.Responses {
@@ -161,22 +266,8 @@ func (g Generator) generateMethods() error {
 					if method.Description != nil {
 						b.WriteString("// " + *method.Description + "\n")
 					}
-					methodPostfix := g.goify(response.Name)
-					if len(method.Responses) == 1 || response.Name == "response" {
-						methodPostfix = ""
-					}
-					if strings.HasSuffix(response.Name, "Response") {
-						repl := strings.ReplaceAll(response.Name, "Response", "")
-						if repl != "" {
-							methodPostfix = g.goify(repl)
-						}
-					}
-
 					gresponse := g.objectExprToGolang(response.Expr)
-					if gresponse == "StorageGetWithKeysResponse" {
-						methodPostfix = "With" + methodPostfix
-					}
-					b.WriteString("func (vk *VK) " + g.goify(method.Name) + methodPostfix + "(params Params) (response " + gresponse + ", err error) {\n")
+					b.WriteString("func (vk *VK) " + g.methodFuncName(method, response) + "(params Params) (response " + gresponse + ", err error) {\n")
 					if extended {
 						b.WriteString("\tparams[\"extended\"] = true\n")
 					}
@@ -190,9 +281,29 @@ func (g Generator) generateMethods() error {
 		})
 }
 
+// methodFuncName returns the name of the client method returning the
+// response of the method.
+func (g Generator) methodFuncName(method schema.MethodDefinition, response schema.ObjectDefinition) string {
+	methodPostfix := g.goify(response.Name)
+	if len(method.Responses) == 1 || response.Name == "response" {
+		methodPostfix = ""
+	}
+	if strings.HasSuffix(response.Name, "Response") {
+		repl := strings.ReplaceAll(response.Name, "Response", "")
+		if repl != "" {
+			methodPostfix = g.goify(repl)
+		}
+	}
+	if g.objectExprToGolang(response.Expr) == "StorageGetWithKeysResponse" {
+		methodPostfix = "With" + methodPostfix
+	}
+	return g.goify(method.Name) + methodPostfix
+}
+
 func (g Generator) generateMethodsTypeSafe() error {
-	return g.generate("methods.json", pkgName+"/methods_safe.gen.go",
+	return g.generate(schema.MethodsSchema, "methods_safe.gen.go",
 		func(b *bytes.Buffer, methodsSchema []byte) error {
+			b.WriteString("import \"context\"\n\n")
 			methods, err := g.parser.ParseMethods(methodsSchema)
 			if err != nil {
 				return err
@@ -204,27 +315,14 @@ func (g Generator) generateMethodsTypeSafe() error {
 					if method.Description != nil {
 						b.WriteString("// " + *method.Description + "\n")
 					}
-					methodPostfix := g.goify(response.Name)
-					if len(method.Responses) == 1 || response.Name == "response" {
-						methodPostfix = ""
-					}
-					if strings.HasSuffix(response.Name, "Response") {
-						repl := strings.ReplaceAll(response.Name, "Response", "")
-						if repl != "" {
-							methodPostfix = g.goify(repl)
-						}
-					}
 					gresponse := g.objectExprToGolang(response.Expr)
-					if gresponse == "StorageGetWithKeysResponse" {
-						methodPostfix = "With" + methodPostfix
-					}
-					b.WriteString("func (vk *VK) " + g.goify(method.Name) + methodPostfix + "Safe(req " + g.goify(method.Name) + ") (response " + gresponse + ", err error) {\n")
+					b.WriteString("func (vk *VK) " + g.methodFuncName(method, response) + "Safe(req " + g.goify(method.Name) + ") (response " + gresponse + ", err error) {\n")
 					if extended {
-						b.WriteString("\tparams := req.params()\n")
+						b.WriteString("\tparams := req.Params()\n")
 						b.WriteString("\tparams[\"extended\"] = true\n")
-						b.WriteString("\terr = vk.RequestUnmarshal(\"" + method.Name + "\", params, &response)\n")
+						b.WriteString("\terr = vk.CallUnmarshal(context.Background(), req, params, &response)\n")
 					} else {
-						b.WriteString("\terr = vk.RequestUnmarshal(\"" + method.Name + "\", req.params(), &response)\n")
+						b.WriteString("\terr = vk.CallUnmarshal(context.Background(), req, req.Params(), &response)\n")
 					}
 
 					b.WriteString("\treturn\n")
@@ -237,7 +335,7 @@ func (g Generator) generateMethodsTypeSafe() error {
 }
 
 func (g Generator) generateBuilders() error {
-	return g.generate("methods.json", pkgName+"/builders.gen.go",
+	return g.generate(schema.MethodsSchema, "builders.gen.go",
 		func(b *bytes.Buffer, methodsSchema []byte) error {
 			b.WriteString("import \"github.com/SevereCloud/vksdk/api\"\n\n")
 			methods, err := g.parser.ParseMethods(methodsSchema)
@@ -296,8 +394,9 @@ func (g Generator) generateBuilders() error {
 }
 
 func (g Generator) generateRequests() error {
-	return g.generate("methods.json", pkgName+"/requests.gen.go",
+	return g.generate(schema.MethodsSchema, "requests.gen.go",
 		func(b *bytes.Buffer, methodsSchema []byte) error {
+			b.WriteString("import \"" + runtimePkg + "\"\n\n")
 			methods, err := g.parser.ParseMethods(methodsSchema)
 			if err != nil {
 				return err
@@ -317,8 +416,8 @@ func (g Generator) generateRequests() error {
 				b.WriteString("type " + requestName + " struct{\n")
 				for _, parameter := range method.Parameters {
 					paramName := g.goify(parameter.Name)
-					paramType := g.objectExprToGolang(parameter.ObjectExpr)
-					if _, isBuiltin := builtinTypes[paramType]; !isBuiltin && !strings.HasPrefix(paramType, "[]") {
+					paramType := g.requestParamType(parameter)
+					if _, isBuiltin := builtinTypes[paramType]; !isBuiltin && !isIDType(paramType) && !strings.HasPrefix(paramType, "[]") {
 						paramType = "*" + paramType
 					}
 					b.WriteString("\t" + paramName + " " + paramType)
@@ -329,11 +428,29 @@ func (g Generator) generateRequests() error {
 				}
 				b.WriteString("}\n\n")
 
-				b.WriteString("func (req " + requestName + ") params() Params {\n")
+				b.WriteString("// Method returns the API method name.\n")
+				b.WriteString("func (req " + requestName + ") Method() string {\n")
+				b.WriteString("\treturn \"" + method.Name + "\"\n")
+				b.WriteString("}\n\n")
+
+				b.WriteString("// AccessTokenType returns the token types the method accepts.\n")
+				b.WriteString("func (req " + requestName + ") AccessTokenType() []string {\n")
+				b.WriteString("\treturn []string{")
+				for i, typ := range method.AccessType {
+					if i > 0 {
+						b.WriteString(", ")
+					}
+					b.WriteString(strconv.Quote(typ))
+				}
+				b.WriteString("}\n")
+				b.WriteString("}\n\n")
+
+				b.WriteString("// Params returns the set parameters.\n")
+				b.WriteString("func (req " + requestName + ") Params() Params {\n")
 				b.WriteString("\tparams := make(Params)\n")
 				for _, parameter := range method.Parameters {
 					pname := g.goify(parameter.Name)
-					ptype := g.objectExprToGolang(parameter.ObjectExpr)
+					ptype := g.requestParamType(parameter)
 					b.WriteString("\tif ")
 					if strings.HasPrefix(ptype, "[]") {
 						b.WriteString("len(req." + pname + ") > 0")
@@ -341,7 +458,7 @@ func (g Generator) generateRequests() error {
 						b.WriteString("req." + pname)
 					} else if ptype == "string" {
 						b.WriteString("req." + pname + " != \"\"")
-					} else if ptype == "int64" || ptype == "float64" {
+					} else if ptype == "int64" || ptype == "float64" || isIDType(ptype) {
 						b.WriteString("req." + pname + " != 0")
 					} else {
 						b.WriteString("req." + pname + " != nil")
@@ -359,6 +476,30 @@ func (g Generator) generateRequests() error {
 		})
 }
 
+// idParamTypes maps integer request parameters to the runtime ID types.
+var idParamTypes = map[string]string{
+	"owner_id": "runtime.OwnerID",
+	"peer_id":  "runtime.PeerID",
+	"peer_ids": "runtime.PeerID",
+}
+
+// requestParamType returns the Go type of the request struct field.
+func (g Generator) requestParamType(param schema.MethodParam) string {
+	gtype := g.objectExprToGolang(param.ObjectExpr)
+	idType, ok := idParamTypes[param.Name]
+	switch {
+	case ok && gtype == "int64":
+		return idType
+	case ok && gtype == "[]int64":
+		return "[]" + idType
+	}
+	return gtype
+}
+
+func isIDType(gtype string) bool {
+	return gtype == "runtime.OwnerID" || gtype == "runtime.PeerID"
+}
+
 func (g Generator) goify(name string) string {
 	if g.nogoify {
 		return name
@@ -378,16 +519,31 @@ func (g Generator) goify(name string) string {
 	return g.goifyReplacer.Replace(string(runes))
 }
 
+// objectTypeName returns the Go type name of the objects.json definition.
+func (g Generator) objectTypeName(name string) string {
+	gname := g.goify(name)
+	if gname == "LeadsComplete" || gname == "LeadsStart" {
+		gname += "Object"
+	}
+	return gname
+}
+
+// responseTypeName returns the Go type name of the responses.json definition.
+func (g Generator) responseTypeName(name string) string {
+	gname := g.goify(name)
+	if !strings.HasSuffix(gname, "Response") {
+		gname = gname + "Response"
+	}
+	return gname
+}
+
 func (g Generator) ObjectDefinitionToGolang(obj schema.ObjectDefinition) string {
 	var sb strings.Builder
 	if obj.Expr.Description != nil {
 		sb.WriteString("// " + *obj.Expr.Description + "\n")
 	}
 
-	gname := g.goify(obj.Name)
-	if gname == "LeadsComplete" || gname == "LeadsStart" {
-		gname += "Object"
-	}
+	gname := g.objectTypeName(obj.Name)
 	if obj.Expr.IsBaseType || obj.Expr.IsReference {
 		gtype := g.objectExprToGolang(obj.Expr)
 		// alias
@@ -526,7 +682,7 @@ func (g Generator) objectExprToGolang(expr schema.ObjectExpr) string {
 				jtag := "`json:\"" + prop.Name + "\"`"
 				sb.WriteString("\t" + g.goify(prop.Name) + " " + g.objectExprToGolang(prop.Expr) + " " + jtag + "\n")
 			}
-			sb.WriteString("}\n")
+			sb.WriteString("}")
 			return sb.String()
 		}
 		fallthrough
@@ -544,10 +700,7 @@ func (g Generator) ResponseDefinitionToGolang(resp schema.ResponseDefinition) st
 	if resp.Expr.Description != nil {
 		sb.WriteString("// " + *resp.Expr.Description + "\n")
 	}
-	gname := g.goify(resp.Name)
-	if !strings.HasSuffix(gname, "Response") {
-		gname = gname + "Response"
-	}
+	gname := g.responseTypeName(resp.Name)
 	if forcedType, ok := responseRules[resp.Name]; ok {
 		sb.WriteString("type " + gname + " " + forcedType + "\n")
 		return sb.String()
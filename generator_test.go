@@ -0,0 +1,53 @@
+package main
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestGenerateEmitters(t *testing.T) {
+	dir := writeSchemaDir(t,
+		`"users_user": {"type": "object", "properties": {"id": {"type": "integer"}}}`,
+		`"users_get_response": {"type": "object", "properties": {"response": {"$ref": "objects.json#/definitions/users_user"}}}`,
+		`{"name": "users.get", "responses": {"response": {"$ref": "responses.json#/definitions/users_get_response"}}}`,
+	)
+	core := []string{
+		"builders.gen.go", "methods.gen.go", "methods_safe.gen.go", "objects.gen.go",
+		"requests.gen.go", "responses.gen.go", "vk.gen.go",
+	}
+	tests := []struct {
+		name  string
+		opts  Options
+		files []string
+	}{
+		{
+			name:  "defaults",
+			files: append([]string{"attachments.gen.go", "cache.gen.go", "longpoll.gen.go", "uploads.gen.go"}, core...),
+		},
+		{
+			name:  "attachments and cache off",
+			opts:  Options{NoAttachments: true, NoCache: true},
+			files: append([]string{"longpoll.gen.go", "uploads.gen.go"}, core...),
+		},
+		{
+			name:  "all off",
+			opts:  Options{NoAttachments: true, NoUploads: true, NoLongPoll: true, NoCache: true},
+			files: core,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var files []string
+			for name := range generateFiles(t, dir, tt.opts) {
+				files = append(files, name)
+			}
+			sort.Strings(files)
+			want := append([]string(nil), tt.files...)
+			sort.Strings(want)
+			if !reflect.DeepEqual(files, want) {
+				t.Errorf("files = %q, want %q", files, want)
+			}
+		})
+	}
+}
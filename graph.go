@@ -0,0 +1,62 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/cqln/vkgen/schema"
+	"github.com/urfave/cli/v2"
+)
+
+// dotShapes are the DOT node shapes of the graph node kinds.
+var dotShapes = map[string]string{
+	schema.UsageMethod:   "box",
+	schema.UsageResponse: "parallelogram",
+	schema.UsageObject:   "ellipse",
+}
+
+func graphCmd(c *cli.Context) error {
+	bundle, err := schema.LoadDir(c.String("dir"))
+	if err != nil {
+		return err
+	}
+	graph := bundle.References().Graph(c.StringSlice("root"), c.Int("depth"))
+
+	switch c.String("format") {
+	case "dot":
+		writeDOT(os.Stdout, graph)
+	case "json":
+		data, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	default:
+		return fmt.Errorf("graph: unknown format %q", c.String("format"))
+	}
+	return nil
+}
+
+// writeDOT writes the graph with the cycles in red.
+func writeDOT(w io.Writer, graph *schema.Graph) {
+	fmt.Fprintln(w, "digraph schema {")
+	fmt.Fprintln(w, "\trankdir=LR;")
+	for _, node := range graph.Nodes {
+		attrs := "shape=" + dotShapes[node.Kind]
+		if node.Cyclic {
+			attrs += ", color=red"
+		}
+		fmt.Fprintf(w, "\t%s [%s];\n", strconv.Quote(node.Name), attrs)
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(w, "\t%s -> %s", strconv.Quote(edge.From), strconv.Quote(edge.To))
+		if edge.Cyclic {
+			fmt.Fprint(w, " [color=red]")
+		}
+		fmt.Fprintln(w, ";")
+	}
+	fmt.Fprintln(w, "}")
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+// writeSchemaDir writes inline schemas, the objects and responses
+// definitions and the methods, into a directory.
+func writeSchemaDir(t *testing.T, objects, responses, methods string) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	files := map[schema.SchemaType]string{
+		schema.ObjectsSchema:   `{"title":"objects","definitions":{` + objects + `}}`,
+		schema.ResponsesSchema: `{"title":"responses","definitions":{` + responses + `}}`,
+		schema.MethodsSchema:   `{"methods":[` + methods + `]}`,
+	}
+	for file, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, string(file)), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// newTestGenerator returns a generator reading the schemas in dir.
+func newTestGenerator(t *testing.T, dir string, opts Options) Generator {
+	t.Helper()
+	objschema, err := ioutil.ReadFile(filepath.Join(dir, string(schema.ObjectsSchema)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.SchemaDir = dir
+	return NewGenerator(opts, objschema)
+}
+
+// generateFiles runs the generator on the schemas in dir and returns the
+// generated sources by file name.
+func generateFiles(t *testing.T, dir string, opts Options) map[string]string {
+	t.Helper()
+	files := make(map[string]string)
+	opts.Sink = func(name string, src []byte) error {
+		files[filepath.Base(name)] = string(src)
+		return nil
+	}
+	if err := newTestGenerator(t, dir, opts).Generate(); err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
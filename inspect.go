@@ -0,0 +1,377 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cqln/vkgen/schema"
+	"github.com/urfave/cli/v2"
+)
+
+// shapeNode is a schema expression with its references resolved.
+type shapeNode struct {
+	Name        string        `json:"name,omitempty"`
+	Type        string        `json:"type"`
+	Description string        `json:"description,omitempty"`
+	Required    bool          `json:"required,omitempty"`
+	Constraints []string      `json:"constraints,omitempty"`
+	Enum        []interface{} `json:"enum,omitempty"`
+	Properties  []*shapeNode  `json:"properties,omitempty"`
+	Items       *shapeNode    `json:"items,omitempty"`
+	AllOf       []*shapeNode  `json:"all_of,omitempty"`
+	OneOf       []*shapeNode  `json:"one_of,omitempty"`
+	// Cycle marks a reference to a definition being expanded.
+	Cycle bool `json:"cycle,omitempty"`
+}
+
+type inspectParam struct {
+	Name        string        `json:"name"`
+	Type        string        `json:"type"`
+	Required    bool          `json:"required"`
+	Default     interface{}   `json:"default,omitempty"`
+	Constraints []string      `json:"constraints,omitempty"`
+	Enum        []interface{} `json:"enum,omitempty"`
+	Description string        `json:"description,omitempty"`
+	GoName      string        `json:"go_name"`
+	GoType      string        `json:"go_type"`
+}
+
+type inspectResponse struct {
+	Name     string     `json:"name"`
+	GoMethod string     `json:"go_method"`
+	GoType   string     `json:"go_type"`
+	Shape    *shapeNode `json:"shape"`
+}
+
+type inspectMethod struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	AccessTypes []string          `json:"access_types"`
+	GoRequest   string            `json:"go_request"`
+	GoBuilder   string            `json:"go_builder"`
+	Parameters  []inspectParam    `json:"parameters"`
+	Responses   []inspectResponse `json:"responses"`
+}
+
+type inspectType struct {
+	Name   string     `json:"name"`
+	File   string     `json:"file"`
+	GoType string     `json:"go_type"`
+	Shape  *shapeNode `json:"shape"`
+}
+
+// inspector resolves the shapes of a loaded schema.
+type inspector struct {
+	g         Generator
+	bundle    *schema.Bundle
+	responses map[string]schema.ObjectExpr
+	depth     int
+	expanding map[string]bool
+}
+
+func newInspector(dir string, depth int) (*inspector, error) {
+	objschema, err := ioutil.ReadFile(filepath.Join(dir, string(schema.ObjectsSchema)))
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := schema.LoadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	in := &inspector{
+		g:         NewGenerator(Options{SchemaDir: dir}, objschema),
+		bundle:    bundle,
+		responses: make(map[string]schema.ObjectExpr, len(bundle.Responses)),
+		depth:     depth,
+		expanding: make(map[string]bool),
+	}
+	for _, resp := range bundle.Responses {
+		in.responses[resp.Name] = resp.Expr.ObjectExpr
+	}
+	return in, nil
+}
+
+// constraints describes the bounds and format of the expression.
+func constraints(expr schema.ObjectExpr) []string {
+	var list []string
+	bound := func(name string, v interface{}) {
+		list = append(list, fmt.Sprintf("%s=%v", name, v))
+	}
+	if expr.Minimum != nil {
+		bound("min", *expr.Minimum)
+	}
+	if expr.Maximum != nil {
+		bound("max", *expr.Maximum)
+	}
+	if expr.MinLength != nil {
+		bound("minLength", *expr.MinLength)
+	}
+	if expr.MaxLength != nil {
+		bound("maxLength", *expr.MaxLength)
+	}
+	if expr.MinItems != nil {
+		bound("minItems", *expr.MinItems)
+	}
+	if expr.MaxItems != nil {
+		bound("maxItems", *expr.MaxItems)
+	}
+	if expr.Format != "" {
+		bound("format", expr.Format)
+	}
+	return list
+}
+
+// resolve returns the expression a reference points to.
+func (in *inspector) resolve(expr schema.ObjectExpr) (schema.ObjectExpr, error) {
+	if resp, ok := in.responses[expr.RefName]; ok {
+		return resp, nil
+	}
+	def, err := expr.Ref()
+	return def.Expr, err
+}
+
+// shape resolves the expression, expanding references up to in.depth deep.
+func (in *inspector) shape(name string, expr schema.ObjectExpr, depth int) *shapeNode {
+	node := &shapeNode{
+		Name:        name,
+		Type:        schema.TypeString(expr),
+		Constraints: constraints(expr),
+		Enum:        expr.Enum,
+	}
+	if expr.Description != nil {
+		node.Description = *expr.Description
+	}
+
+	if expr.IsReference {
+		if in.expanding[expr.RefName] {
+			node.Cycle = true
+			return node
+		}
+		if depth >= in.depth {
+			return node
+		}
+		resolved, err := in.resolve(expr)
+		if err != nil {
+			node.Description = err.Error()
+			return node
+		}
+		in.expanding[expr.RefName] = true
+		defer delete(in.expanding, expr.RefName)
+
+		inner := in.shape(name, resolved, depth+1)
+		inner.Type = expr.RefName + ": " + inner.Type
+		if node.Description != "" {
+			inner.Description = node.Description
+		}
+		return inner
+	}
+
+	if expr.ArrayOf != nil {
+		node.Items = in.shape("", *expr.ArrayOf, depth)
+	}
+	for _, prop := range expr.Properties {
+		child := in.shape(prop.Name, prop.Expr, depth)
+		for _, req := range expr.Required {
+			child.Required = child.Required || req == prop.Name
+		}
+		node.Properties = append(node.Properties, child)
+	}
+	for _, part := range expr.AllOf {
+		node.AllOf = append(node.AllOf, in.shape("", part, depth))
+	}
+	for _, variant := range expr.OneOf {
+		node.OneOf = append(node.OneOf, in.shape("", variant, depth))
+	}
+	return node
+}
+
+func (in *inspector) method(name string) (*inspectMethod, error) {
+	for _, method := range in.bundle.Methods {
+		if method.Name != name {
+			continue
+		}
+
+		requestName := in.g.goify(method.Name)
+		m := &inspectMethod{
+			Name:        method.Name,
+			AccessTypes: method.AccessType,
+			GoRequest:   requestName,
+			GoBuilder:   "New" + requestName + "Builder",
+		}
+		if method.Description != nil {
+			m.Description = *method.Description
+		}
+		for _, param := range method.Parameters {
+			p := inspectParam{
+				Name:        param.Name,
+				Type:        schema.TypeString(param.ObjectExpr),
+				Required:    param.Required,
+				Default:     param.Default,
+				Constraints: constraints(param.ObjectExpr),
+				Enum:        param.Enum,
+				GoName:      in.g.goify(param.Name),
+				GoType:      in.g.requestParamType(param),
+			}
+			if param.Description != nil {
+				p.Description = *param.Description
+			}
+			m.Parameters = append(m.Parameters, p)
+		}
+		for _, resp := range method.Responses {
+			m.Responses = append(m.Responses, inspectResponse{
+				Name:     resp.Name,
+				GoMethod: "VK." + in.g.methodFuncName(method, resp),
+				GoType:   in.g.objectExprToGolang(resp.Expr),
+				Shape:    in.shape("", resp.Expr, 0),
+			})
+		}
+		return m, nil
+	}
+	return nil, fmt.Errorf("inspect: no method %s", name)
+}
+
+func (in *inspector) definition(name string) (*inspectType, error) {
+	for _, obj := range in.bundle.Objects {
+		if obj.Name == name {
+			return &inspectType{
+				Name:   name,
+				File:   string(schema.ObjectsSchema),
+				GoType: in.g.objectTypeName(name),
+				Shape:  in.shape("", obj.Expr, 0),
+			}, nil
+		}
+	}
+	for _, resp := range in.bundle.Responses {
+		if resp.Name == name {
+			return &inspectType{
+				Name:   name,
+				File:   string(schema.ResponsesSchema),
+				GoType: in.g.responseTypeName(name),
+				Shape:  in.shape("", resp.Expr.ObjectExpr, 0),
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("inspect: no definition %s", name)
+}
+
+func inspectCmd(c *cli.Context) error {
+	kind, name := c.Args().Get(0), c.Args().Get(1)
+	if c.NArg() != 2 || kind != "method" && kind != "type" {
+		return errors.New("inspect: want method NAME or type NAME")
+	}
+	in, err := newInspector(c.String("dir"), c.Int("depth"))
+	if err != nil {
+		return err
+	}
+
+	var result interface{}
+	if kind == "method" {
+		result, err = in.method(name)
+	} else {
+		result, err = in.definition(name)
+	}
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	switch result := result.(type) {
+	case *inspectMethod:
+		writeInspectMethod(os.Stdout, result)
+	case *inspectType:
+		writeInspectType(os.Stdout, result)
+	}
+	return nil
+}
+
+func writeInspectMethod(w io.Writer, m *inspectMethod) {
+	fmt.Fprintln(w, m.Name)
+	if m.Description != "" {
+		fmt.Fprintln(w, "  "+m.Description)
+	}
+	fmt.Fprintln(w, "access: "+strings.Join(m.AccessTypes, ", "))
+	fmt.Fprintln(w, "go: "+m.GoRequest+", "+m.GoBuilder)
+
+	fmt.Fprintln(w, "\nparameters:")
+	for _, p := range m.Parameters {
+		line := "  " + p.Name + " " + p.Type
+		if p.Required {
+			line += " required"
+		}
+		if p.Default != nil {
+			line += fmt.Sprintf(" default=%v", p.Default)
+		}
+		if len(p.Constraints) > 0 {
+			line += " " + strings.Join(p.Constraints, " ")
+		}
+		if len(p.Enum) > 0 {
+			line += fmt.Sprintf(" enum=%v", p.Enum)
+		}
+		fmt.Fprintln(w, line+"  -> "+p.GoName+" "+p.GoType)
+		if p.Description != "" {
+			fmt.Fprintln(w, "      "+p.Description)
+		}
+	}
+
+	fmt.Fprintln(w, "\nresponses:")
+	for _, resp := range m.Responses {
+		fmt.Fprintln(w, "  "+resp.Name+" -> "+resp.GoMethod+" "+resp.GoType)
+		writeShape(w, resp.Shape, "    ")
+	}
+}
+
+func writeInspectType(w io.Writer, t *inspectType) {
+	fmt.Fprintln(w, t.Name+" ("+t.File+") -> "+t.GoType)
+	writeShape(w, t.Shape, "  ")
+}
+
+func writeShape(w io.Writer, node *shapeNode, indent string) {
+	line := indent
+	if node.Name != "" {
+		line += node.Name + ": "
+	}
+	line += node.Type
+	if node.Required {
+		line += " required"
+	}
+	if len(node.Constraints) > 0 {
+		line += " " + strings.Join(node.Constraints, " ")
+	}
+	if len(node.Enum) > 0 {
+		line += fmt.Sprintf(" enum=%v", node.Enum)
+	}
+	if node.Cycle {
+		line += " (cycle)"
+	}
+	if node.Description != "" {
+		line += "  // " + node.Description
+	}
+	fmt.Fprintln(w, line)
+
+	if node.Items != nil {
+		writeShape(w, node.Items, indent+"  ")
+	}
+	for _, child := range node.AllOf {
+		writeShape(w, child, indent+"  ")
+	}
+	for _, child := range node.OneOf {
+		writeShape(w, child, indent+"  ")
+	}
+	for _, child := range node.Properties {
+		writeShape(w, child, indent+"  ")
+	}
+}
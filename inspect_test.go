@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+const (
+	inspectObjects = `
+		"wall_comment": {"type": "object", "properties": {
+			"id": {"type": "integer", "minimum": 1},
+			"text": {"type": "string", "description": "Comment text"},
+			"thread": {"type": "array", "items": {"$ref": "objects.json#/definitions/wall_comment"}}
+		}, "required": ["id"]}`
+	inspectResponses = `
+		"wall_getComment_response": {"type": "object", "properties": {"response": {"$ref": "objects.json#/definitions/wall_comment"}}}`
+	inspectMethods = `{
+		"name": "wall.getComment",
+		"description": "Returns a comment.",
+		"access_token_type": ["user"],
+		"parameters": [
+			{"name": "owner_id", "type": "integer", "format": "int64"},
+			{"name": "comment_id", "type": "integer", "required": true, "minimum": 0},
+			{"name": "sort", "type": "string", "enum": ["asc", "desc"], "default": "asc"}
+		],
+		"responses": {"response": {"$ref": "responses.json#/definitions/wall_getComment_response"}}
+	}`
+)
+
+func TestInspectMethod(t *testing.T) {
+	tests := []struct {
+		depth int
+		shape string
+	}{
+		{0, `
+    wall_getComment_response
+`},
+		{1, `
+    wall_getComment_response: wall_comment
+`},
+		{2, `
+    wall_getComment_response: wall_comment: object
+      id: integer required min=1
+      text: string  // Comment text
+      thread: array of wall_comment
+        wall_comment (cycle)
+`},
+	}
+	dir := writeSchemaDir(t, inspectObjects, inspectResponses, inspectMethods)
+	for _, tt := range tests {
+		in, err := newInspector(dir, tt.depth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, err := in.method("wall.getComment")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b bytes.Buffer
+		writeInspectMethod(&b, m)
+		want := `wall.getComment
+  Returns a comment.
+access: user
+go: WallGetComment, NewWallGetCommentBuilder
+
+parameters:
+  owner_id integer format=int64  -> OwnerID runtime.OwnerID
+  comment_id integer required min=0  -> CommentID int64
+  sort string default=asc enum=[asc desc]  -> Sort string
+
+responses:
+  response -> VK.WallGetComment WallGetCommentResponse` + tt.shape
+		if b.String() != want {
+			t.Errorf("depth %d:\n%s\nwant\n%s", tt.depth, b.String(), want)
+		}
+	}
+
+	in, _ := newInspector(dir, 1)
+	if _, err := in.method("wall.get"); err == nil {
+		t.Error("unknown method inspected")
+	}
+}
+
+func TestInspectDefinition(t *testing.T) {
+	in, err := newInspector(writeSchemaDir(t, inspectObjects, inspectResponses, inspectMethods), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"wall_comment", "wall_comment (objects.json) -> WallComment\n  object\n    id: integer required min=1\n"},
+		{"wall_getComment_response", "wall_getComment_response (responses.json) -> WallGetCommentResponse\n  wall_comment: object\n"},
+	}
+	for _, tt := range tests {
+		def, err := in.definition(tt.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b bytes.Buffer
+		writeInspectType(&b, def)
+		if got := b.String(); !strings.HasPrefix(got, tt.want) {
+			t.Errorf("%s:\n%s\nwant prefix\n%s", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+// lenientSet holds the definitions ("base_bool_int") and definition
+// properties ("users_user_full.id") decoded tolerantly.
+type lenientSet map[string]bool
+
+// acceptsEmptyArray reports whether the struct accepts [] in place of an
+// empty object. It does once it decodes anything tolerantly.
+func (set lenientSet) acceptsEmptyArray(s jsonStruct) bool {
+	if set[s.def] {
+		return true
+	}
+	for _, field := range s.fields {
+		if set[s.def+"."+field.name] {
+			return true
+		}
+	}
+	return false
+}
+
+// lenientSet picks the shapes VK is known to send inconsistently:
+//   - integer enums of 0 and 1 (base_bool_int), which also arrive as
+//     true/false or "1";
+//   - integer IDs and counters, which also arrive as strings;
+//   - properties holding objects without required fields, which arrive
+//     as [] when empty.
+//
+// The config overrides are applied on top.
+func (g Generator) lenientSet(objects []schema.ObjectDefinition, responses []schema.ResponseDefinition) lenientSet {
+	set := make(lenientSet)
+	var structs []jsonStruct
+	for _, object := range objects {
+		if isBoolIntEnum(object.Expr) {
+			set[object.Name] = true
+		}
+		if s, ok := g.objectJSONStruct(object); ok && s.base == "" {
+			structs = append(structs, s)
+		}
+	}
+	for _, response := range responses {
+		if s, ok := g.responseJSONStruct(response); ok && s.base == "" {
+			structs = append(structs, s)
+		}
+	}
+
+	for _, s := range structs {
+		for _, field := range s.fields {
+			if field.expr != nil && isFlakyProperty(field.name, *field.expr) {
+				set[s.def+"."+field.name] = true
+			}
+		}
+	}
+
+	g.config.Lenient.apply(set)
+	return set
+}
+
+func isBoolIntEnum(expr schema.ObjectExpr) bool {
+	if !expr.IsEnum || expr.Type != "integer" || len(expr.Enum) != 2 {
+		return false
+	}
+	for _, item := range expr.Enum {
+		if v, ok := item.(int64); !ok || v != 0 && v != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+func isFlakyProperty(name string, expr schema.ObjectExpr) bool {
+	if expr.IsReference {
+		ref, err := expr.Ref()
+		if err != nil {
+			panic(err)
+		}
+		return isOptionalObject(ref.Expr)
+	}
+	if expr.Type == "integer" && !expr.IsEnum {
+		return name == "id" || name == "count" ||
+			strings.HasSuffix(name, "_id") || strings.HasSuffix(name, "_count")
+	}
+	return isOptionalObject(expr)
+}
+
+// isOptionalObject reports whether the expression is a plain object with
+// no required properties, so an empty one is valid.
+func isOptionalObject(expr schema.ObjectExpr) bool {
+	return expr.Type == "object" && len(expr.Properties) > 0 && len(expr.Required) == 0 &&
+		!expr.IsOneOf && !expr.IsAllOf && !expr.IsEnum
+}
+
+// lenientKind tells how a tolerant field is decoded: as a FlexInt, a
+// FlexBool or, for objects, from raw JSON skipping empty arrays.
+func lenientKind(c jsonCodec, field jsonField) string {
+	switch {
+	case field.ptr:
+		return "object"
+	case c.kind == "int64":
+		return "int"
+	case c.kind == "bool":
+		return "bool"
+	}
+	return "object"
+}
+
+// writeLenientDecode writes the tolerant decoding of a field for the fast
+// JSON emitter.
+func writeLenientDecode(b *bytes.Buffer, c jsonCodec, field jsonField, dst string, lenient lenientSet) {
+	switch lenientKind(c, field) {
+	case "int":
+		b.WriteString("\t\t\t" + dst + " = " + convert(c.goType, "int64", "l.FlexInt64()") + "\n")
+	case "bool":
+		b.WriteString("\t\t\t" + dst + " = " + convert(c.goType, "bool", "l.FlexBool()") + "\n")
+	default:
+		b.WriteString("\t\t\tif l.EmptyArray() {\n")
+		b.WriteString("\t\t\t\tbreak\n")
+		b.WriteString("\t\t\t}\n")
+		if field.ptr || c.kind == "any" {
+			b.WriteString("\t\t\tl.Unmarshal(&" + dst + ")\n")
+		} else {
+			writeDecode(b, "\t\t\t", c, dst, 0, lenient)
+		}
+	}
+}
+
+// convert wraps the value of type from into a conversion to the Go type.
+func convert(goType, from, value string) string {
+	if goType == from {
+		return value
+	}
+	return goType + "(" + value + ")"
+}
+
+// generateLenient emits tolerant UnmarshalJSON methods. Integer enums get
+// their own method; structs shadow their flaky fields with tolerant types.
+// With the fast JSON emitter the struct part is handled by its decoders.
+func (g Generator) generateLenient() error {
+	objectsSchema, err := g.readSchema(schema.ObjectsSchema)
+	if err != nil {
+		return err
+	}
+	responsesSchema, err := g.readSchema(schema.ResponsesSchema)
+	if err != nil {
+		return err
+	}
+
+	objects, err := g.parser.ParseObjects(objectsSchema)
+	if err != nil {
+		return err
+	}
+	responses, err := g.parser.ParseResponses(responsesSchema)
+	if err != nil {
+		return err
+	}
+	set := g.lenientSet(objects, responses)
+
+	body := bytes.NewBuffer(nil)
+	var structs []jsonStruct
+	for _, object := range objects {
+		if set[object.Name] && object.Expr.IsEnum && object.Expr.Type == "integer" {
+			name := g.objectTypeName(object.Name)
+			body.WriteString("func (v *" + name + ") UnmarshalJSON(data []byte) error {\n")
+			body.WriteString("\tn, err := runtime.ParseFlexInt(data)\n")
+			body.WriteString("\t*v = " + name + "(n)\n")
+			body.WriteString("\treturn err\n")
+			body.WriteString("}\n\n")
+		}
+		if s, ok := g.objectJSONStruct(object); ok && s.base == "" {
+			structs = append(structs, s)
+		}
+	}
+	for _, response := range responses {
+		if s, ok := g.responseJSONStruct(response); ok && s.base == "" {
+			structs = append(structs, s)
+		}
+	}
+
+	if !g.fastJSON {
+		for _, s := range structs {
+			if set.acceptsEmptyArray(s) {
+				g.writeLenientStruct(body, s, set)
+			}
+		}
+	}
+
+	b := bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\npackage " + g.pkgName + "\n\n")
+	b.WriteString("import (\n")
+	if bytes.Contains(body.Bytes(), []byte("json.")) {
+		b.WriteString("\t\"encoding/json\"\n\n")
+	}
+	b.WriteString("\t\"" + runtimePkg + "\"\n")
+	b.WriteString(")\n\n")
+	b.Write(body.Bytes())
+	return g.writeSource("lenient.gen.go", b)
+}
+
+func (g Generator) writeLenientStruct(b *bytes.Buffer, s jsonStruct, set lenientSet) {
+	type shadow struct {
+		field jsonField
+		codec jsonCodec
+		kind  string
+	}
+	var shadows []shadow
+	for _, field := range s.fields {
+		if !set[s.def+"."+field.name] {
+			continue
+		}
+		c := g.fieldCodec(field, nil)
+		shadows = append(shadows, shadow{field: field, codec: c, kind: lenientKind(c, field)})
+	}
+
+	b.WriteString("func (v *" + s.name + ") UnmarshalJSON(data []byte) error {\n")
+	b.WriteString("\tif runtime.IsEmptyArray(data) {\n")
+	b.WriteString("\t\treturn nil\n")
+	b.WriteString("\t}\n\n")
+	b.WriteString("\ttype plain " + s.name + "\n")
+	if len(shadows) == 0 {
+		b.WriteString("\treturn json.Unmarshal(data, (*plain)(v))\n")
+		b.WriteString("}\n\n")
+		return
+	}
+
+	b.WriteString("\taux := struct {\n")
+	b.WriteString("\t\t*plain\n")
+	for _, sh := range shadows {
+		typ := "json.RawMessage"
+		switch sh.kind {
+		case "int":
+			typ = "runtime.FlexInt"
+		case "bool":
+			typ = "runtime.FlexBool"
+		}
+		b.WriteString("\t\t" + sh.field.goName + " " + typ + " `json:\"" + sh.field.name + "\"`\n")
+	}
+	b.WriteString("\t}{\n")
+	b.WriteString("\t\tplain: (*plain)(v),\n")
+	for _, sh := range shadows {
+		switch sh.kind {
+		case "int":
+			b.WriteString("\t\t" + sh.field.goName + ": runtime.FlexInt(v." + sh.field.goName + "),\n")
+		case "bool":
+			b.WriteString("\t\t" + sh.field.goName + ": runtime.FlexBool(v." + sh.field.goName + "),\n")
+		}
+	}
+	b.WriteString("\t}\n")
+	b.WriteString("\tif err := json.Unmarshal(data, &aux); err != nil {\n")
+	b.WriteString("\t\treturn err\n")
+	b.WriteString("\t}\n\n")
+	for _, sh := range shadows {
+		dst := "v." + sh.field.goName
+		switch sh.kind {
+		case "int", "bool":
+			b.WriteString("\t" + dst + " = " + sh.codec.goType + "(aux." + sh.field.goName + ")\n")
+		default:
+			b.WriteString("\tif len(aux." + sh.field.goName + ") > 0 && !runtime.IsEmptyArray(aux." + sh.field.goName + ") {\n")
+			b.WriteString("\t\tif err := json.Unmarshal(aux." + sh.field.goName + ", &" + dst + "); err != nil {\n")
+			b.WriteString("\t\t\treturn err\n")
+			b.WriteString("\t\t}\n")
+			b.WriteString("\t}\n")
+		}
+	}
+	b.WriteString("\treturn nil\n")
+	b.WriteString("}\n\n")
+}
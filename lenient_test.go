@@ -0,0 +1,123 @@
+package main
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+const (
+	lenientObjects = `
+		"base_bool_int": {"type": "integer", "enum": [0, 1]},
+		"base_city": {"type": "object", "properties": {"id": {"type": "integer"}, "title": {"type": "string"}}},
+		"base_place": {"type": "object", "required": ["id"], "properties": {"id": {"type": "integer"}}},
+		"users_user": {"type": "object", "properties": {
+			"id": {"type": "integer"},
+			"friends_count": {"type": "integer"},
+			"sex": {"type": "integer", "enum": [0, 1, 2]},
+			"age": {"type": "integer"},
+			"name": {"type": "string"},
+			"city": {"$ref": "objects.json#/definitions/base_city"},
+			"place": {"$ref": "objects.json#/definitions/base_place"},
+			"counters": {"type": "object", "properties": {"photos": {"type": "integer"}}}
+		}}`
+	lenientResponses = `
+		"users_get_response": {"type": "object", "properties": {"response": {"$ref": "objects.json#/definitions/users_user"}}}`
+	lenientMethods = `{"name": "users.get", "responses": {"response": {"$ref": "responses.json#/definitions/users_get_response"}}}`
+)
+
+func TestLenientSet(t *testing.T) {
+	dir := writeSchemaDir(t, lenientObjects, lenientResponses, lenientMethods)
+	bundle, err := schema.LoadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		config Overrides
+		want   []string
+	}{
+		{
+			name: "schema",
+			want: []string{
+				"base_bool_int", "base_city.id", "base_place.id",
+				"users_user.city", "users_user.counters", "users_user.friends_count", "users_user.id",
+			},
+		},
+		{
+			name:   "overrides",
+			config: Overrides{Include: []string{"users_user.age"}, Exclude: []string{"users_user.friends_count", "base_place.id"}},
+			want: []string{
+				"base_bool_int", "base_city.id",
+				"users_user.age", "users_user.city", "users_user.counters", "users_user.id",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newTestGenerator(t, dir, Options{Config: Config{Lenient: tt.config}})
+			want := make(lenientSet)
+			for _, name := range tt.want {
+				want[name] = true
+			}
+			if got := g.lenientSet(bundle.Objects, bundle.Responses); !reflect.DeepEqual(got, want) {
+				t.Errorf("lenientSet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateLenient(t *testing.T) {
+	dir := writeSchemaDir(t, lenientObjects, lenientResponses, lenientMethods)
+	tests := []struct {
+		name string
+		opts Options
+		file string
+		want []string
+	}{
+		{
+			name: "encoding/json",
+			opts: Options{Lenient: true},
+			file: "lenient.gen.go",
+			want: []string{
+				"func (v *BaseBoolInt) UnmarshalJSON(data []byte) error {\n\tn, err := runtime.ParseFlexInt(data)",
+				"func (v *BaseCity) UnmarshalJSON(data []byte) error {\n\tif runtime.IsEmptyArray(data) {\n\t\treturn nil\n\t}",
+				"ID runtime.FlexInt `json:\"id\"`",
+				"FriendsCount runtime.FlexInt `json:\"friends_count\"`",
+				"City json.RawMessage `json:\"city\"`",
+				"if len(aux.City) > 0 && !runtime.IsEmptyArray(aux.City) {",
+			},
+		},
+		{
+			name: "fast JSON",
+			opts: Options{Lenient: true, FastJSON: true},
+			file: "json.gen.go",
+			want: []string{
+				"v.ID = l.FlexInt64()",
+				"v.FriendsCount = l.FlexInt64()",
+				"if l.EmptyArray() {\n\t\t\t\tbreak\n\t\t\t}",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := generateFiles(t, dir, tt.opts)
+			src, ok := files[tt.file]
+			if !ok {
+				t.Fatalf("no %s", tt.file)
+			}
+			src = regexp.MustCompile(` +`).ReplaceAllString(src, " ") // undo gofmt alignment
+			for _, want := range tt.want {
+				if !strings.Contains(src, want) {
+					t.Errorf("%s lacks\n%s\n", tt.file, want)
+				}
+			}
+			if tt.opts.FastJSON && strings.Contains(files["lenient.gen.go"], "IsEmptyArray") {
+				t.Error("lenient.gen.go decodes structs the fast JSON decoders handle")
+			}
+		})
+	}
+}
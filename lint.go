@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cqln/vkgen/schema"
+	"github.com/tidwall/gjson"
+	"github.com/urfave/cli/v2"
+)
+
+func lintSchemaCmd(c *cli.Context) error {
+	dir := c.String("dir")
+	problems, err := schema.Lint(dir)
+	if err != nil {
+		return err
+	}
+	collisions, err := goifyCollisions(dir)
+	if err != nil {
+		return err
+	}
+	problems = append(problems, collisions...)
+
+	ignored := make(map[string]bool)
+	for _, rule := range c.StringSlice("ignore") {
+		ignored[rule] = true
+	}
+	var reported []schema.Problem
+	for _, problem := range problems {
+		if !ignored[problem.Rule] {
+			reported = append(reported, problem)
+		}
+	}
+
+	if c.Bool("json") {
+		if reported == nil {
+			reported = []schema.Problem{}
+		}
+		data, err := json.MarshalIndent(reported, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	} else {
+		for _, problem := range reported {
+			fmt.Println(problem)
+		}
+	}
+
+	if len(reported) > 0 {
+		return cli.Exit("", 1)
+	}
+	return nil
+}
+
+// goifyCollisions reports the definitions, methods and properties whose Go
+// names collide. It reads the raw schemas, lint reports what the parser
+// rejects. The generated types share one namespace: the objects and
+// responses under both their declared names and the names references
+// resolve to, the request structs and their builders.
+func goifyCollisions(dir string) ([]schema.Problem, error) {
+	docs := make(map[schema.SchemaType]gjson.Result)
+	for _, file := range []schema.SchemaType{schema.MethodsSchema, schema.ObjectsSchema, schema.ResponsesSchema} {
+		data, err := ioutil.ReadFile(filepath.Join(dir, string(file)))
+		if err != nil {
+			return nil, err
+		}
+		docs[file] = gjson.ParseBytes(data)
+	}
+	g := NewGenerator(Options{}, nil)
+
+	var problems []schema.Problem
+	claim := func(names map[string]string, gname string, file schema.SchemaType, pointer, what string) {
+		if prev, ok := names[gname]; ok {
+			problems = append(problems, schema.Problem{
+				File:    string(file),
+				Pointer: pointer,
+				Rule:    schema.RuleGoifyCollision,
+				Message: fmt.Sprintf("%s %s collides with %s", what, gname, prev),
+			})
+			return
+		}
+		names[gname] = string(file) + "#" + pointer
+	}
+	fields := func(file schema.SchemaType, pointer string, props gjson.Result) {
+		names := make(map[string]string)
+		props.ForEach(func(key, _ gjson.Result) bool {
+			claim(names, g.goify(key.String()), file, pointer+schema.Pointer("properties", key.String()), "field")
+			return true
+		})
+	}
+	// claimType claims the declared name of the definition and, when it
+	// differs, the name references to it resolve to.
+	types := make(map[string]string)
+	claimType := func(declared, key string, file schema.SchemaType, pointer string) {
+		claim(types, declared, file, pointer, "type")
+		if resolved := g.goify(key); resolved != declared {
+			claim(types, resolved, file, pointer, "type referenced as")
+		}
+	}
+
+	docs[schema.ObjectsSchema].Get("definitions").ForEach(func(key, def gjson.Result) bool {
+		pointer := schema.Pointer("definitions", key.String())
+		claimType(g.objectTypeName(key.String()), key.String(), schema.ObjectsSchema, pointer)
+		fields(schema.ObjectsSchema, pointer, def.Get("properties"))
+		return true
+	})
+	docs[schema.ResponsesSchema].Get("definitions").ForEach(func(key, def gjson.Result) bool {
+		pointer := schema.Pointer("definitions", key.String())
+		claimType(g.responseTypeName(key.String()), key.String(), schema.ResponsesSchema, pointer)
+		fields(schema.ResponsesSchema, pointer+schema.Pointer("properties", "response"), def.Get("properties.response.properties"))
+		return true
+	})
+
+	methods := make(map[string]string)
+	for i, method := range docs[schema.MethodsSchema].Get("methods").Array() {
+		pointer := schema.Pointer("methods", strconv.Itoa(i))
+		gname := g.goify(method.Get("name").String())
+		claim(methods, gname, schema.MethodsSchema, pointer, "method")
+		claim(types, gname, schema.MethodsSchema, pointer, "request")
+		claim(types, gname+"Builder", schema.MethodsSchema, pointer, "builder")
+		params := make(map[string]string)
+		for j, param := range method.Get("parameters").Array() {
+			claim(params, g.goify(param.Get("name").String()), schema.MethodsSchema, pointer+schema.Pointer("parameters", strconv.Itoa(j)), "parameter")
+		}
+	}
+	return problems, nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGoifyCollisions(t *testing.T) {
+	method := func(name, params string) string {
+		return `{"name": "` + name + `", "parameters": [` + params + `], "responses": {}}`
+	}
+	tests := []struct {
+		name      string
+		objects   string
+		responses string
+		methods   string
+		want      []string
+	}{
+		{
+			name:      "clean",
+			objects:   `"users_user": {"type": "object", "properties": {"id": {"type": "integer"}}}`,
+			responses: `"users_get_response": {"type": "object", "properties": {"response": {"type": "integer"}}}`,
+			methods:   method("users.get", `{"name": "user_ids", "type": "string"}`),
+		},
+		{
+			name:    "object referenced as a request",
+			objects: `"leads_complete": {"type": "object"}, "leads_start": {"type": "object"}`,
+			methods: method("leads.complete", "") + "," + method("leads.start", ""),
+			want: []string{
+				"methods.json#/methods/0: goify-collision: request LeadsComplete collides with objects.json#/definitions/leads_complete",
+				"methods.json#/methods/1: goify-collision: request LeadsStart collides with objects.json#/definitions/leads_start",
+			},
+		},
+		{
+			name:      "response referenced as a request",
+			responses: `"docs_getUploadServer": {"type": "object", "properties": {"response": {"type": "string"}}}`,
+			methods:   method("docs.getUploadServer", ""),
+			want:      []string{"methods.json#/methods/0: goify-collision: request DocsGetUploadServer collides with responses.json#/definitions/docs_getUploadServer"},
+		},
+		{
+			name:    "builder",
+			objects: `"users_get_builder": {"type": "string"}`,
+			methods: method("users.get", ""),
+			want:    []string{"methods.json#/methods/0: goify-collision: builder UsersGetBuilder collides with objects.json#/definitions/users_get_builder"},
+		},
+		{
+			name:      "object and response",
+			objects:   `"users_get_response": {"type": "string"}`,
+			responses: `"users_get_response": {"type": "object", "properties": {"response": {"type": "string"}}}`,
+			want:      []string{"responses.json#/definitions/users_get_response: goify-collision: type UsersGetResponse collides with objects.json#/definitions/users_get_response"},
+		},
+		{
+			name:    "fields",
+			objects: `"users_user": {"type": "object", "properties": {"user_id": {"type": "integer"}, "userId": {"type": "integer"}}}`,
+			want:    []string{"objects.json#/definitions/users_user/properties/userId: goify-collision: field UserID collides with objects.json#/definitions/users_user/properties/user_id"},
+		},
+		{
+			name:    "methods and parameters",
+			methods: method("users.get", `{"name": "user_id", "type": "integer"}, {"name": "userId", "type": "integer"}`) + "," + method("users_get", ""),
+			want: []string{
+				"methods.json#/methods/0/parameters/1: goify-collision: parameter UserID collides with methods.json#/methods/0/parameters/0",
+				"methods.json#/methods/1: goify-collision: method UsersGet collides with methods.json#/methods/0",
+				"methods.json#/methods/1: goify-collision: request UsersGet collides with methods.json#/methods/0",
+				"methods.json#/methods/1: goify-collision: builder UsersGetBuilder collides with methods.json#/methods/0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			problems, err := goifyCollisions(writeSchemaDir(t, tt.objects, tt.responses, tt.methods))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, problem := range problems {
+				got = append(got, problem.String())
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("goifyCollisions() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+// generateLongPoll emits the constructors of the runtime Long Poll clients
+// fetching their servers with groups.getLongPollServer and
+// messages.getLongPollServer. Clients whose method is missing from the
+// schema are left out.
+func (g Generator) generateLongPoll() error {
+	methodsSchema, err := g.readSchema(schema.MethodsSchema)
+	if err != nil {
+		return err
+	}
+	methods, err := g.parser.ParseMethods(methodsSchema)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]schema.MethodDefinition, len(methods))
+	for _, method := range methods {
+		byName[method.Name] = method
+	}
+
+	b := bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\npackage " + g.pkgName + "\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\t\"context\"\n\n")
+	b.WriteString("\t\"" + runtimePkg + "\"\n")
+	b.WriteString(")\n\n")
+
+	if method, ok := byName["groups.getLongPollServer"]; ok {
+		b.WriteString("// NewBotsLongPoll returns a Bots Long Poll client receiving the events of\n")
+		b.WriteString("// the community. Pass EventHandler.Handle to Run for typed events.\n")
+		b.WriteString("func (vk *VK) NewBotsLongPoll(groupID int64) *runtime.BotsLongPoll {\n")
+		b.WriteString("\treq := " + g.goify(method.Name) + "{" + g.goify("group_id") + ": groupID}\n")
+		g.writeLongPollServer(b, method, "runtime.NewBotsLongPoll")
+		b.WriteString("}\n\n")
+	}
+	if method, ok := byName["messages.getLongPollServer"]; ok {
+		b.WriteString("// NewUserLongPoll returns a User Long Poll client. Set GroupID in req to\n")
+		b.WriteString("// receive the messages of a community.\n")
+		b.WriteString("func (vk *VK) NewUserLongPoll(req " + g.goify(method.Name) + ") *runtime.UserLongPoll {\n")
+		g.writeLongPollServer(b, method, "runtime.NewUserLongPoll")
+		b.WriteString("}\n\n")
+	}
+	return g.writeSource("longpoll.gen.go", b)
+}
+
+func (g Generator) writeLongPollServer(b *bytes.Buffer, method schema.MethodDefinition, constructor string) {
+	gresponse := g.objectExprToGolang(method.Responses[0].Expr)
+	b.WriteString("\treturn " + constructor + "(vk.VK, func(ctx context.Context) (runtime.LongPollServer, error) {\n")
+	b.WriteString("\t\tvar response " + gresponse + "\n")
+	b.WriteString("\t\terr := vk.CallUnmarshal(ctx, req, req.Params(), &response)\n")
+	b.WriteString("\t\treturn runtime.LongPollServer{\n")
+	b.WriteString("\t\t\tServer: response." + g.goify("server") + ",\n")
+	b.WriteString("\t\t\tKey:    response." + g.goify("key") + ",\n")
+	b.WriteString("\t\t\tTS:     response." + g.goify("ts") + ",\n")
+	b.WriteString("\t\t}, err\n")
+	b.WriteString("\t})\n")
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateLongPoll(t *testing.T) {
+	dir := writeSchemaDir(t, "",
+		`"groups_getLongPollServer_response": {"type": "object", "properties": {"response": {"type": "object", "properties": {
+			"server": {"type": "string"}, "key": {"type": "string"}, "ts": {"type": "string"}
+		}}}}`,
+		`{
+			"name": "groups.getLongPollServer",
+			"parameters": [{"name": "group_id", "type": "integer", "required": true}],
+			"responses": {"response": {"$ref": "responses.json#/definitions/groups_getLongPollServer_response"}}
+		}`,
+	)
+	src := generateFiles(t, dir, Options{})["longpoll.gen.go"]
+	for _, want := range []string{
+		"func (vk *VK) NewBotsLongPoll(groupID int64) *runtime.BotsLongPoll {\n\treq := GroupsGetLongPollServer{GroupID: groupID}",
+		"err := vk.CallUnmarshal(ctx, req, req.Params(), &response)",
+	} {
+		if !strings.Contains(src, want) {
+			t.Errorf("longpoll.gen.go lacks\n%s\nin\n%s", want, src)
+		}
+	}
+	if strings.Contains(src, "NewUserLongPoll") {
+		t.Error("longpoll.gen.go has NewUserLongPoll without messages.getLongPollServer")
+	}
+}
@@ -1,46 +1,370 @@
 package main
 
 import (
+	"errors"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
 
+	"github.com/cqln/vkgen/schema"
 	"github.com/urfave/cli/v2"
 )
 
 func generateSchemaCmd(c *cli.Context) error {
-	objschema, err := ioutil.ReadFile("objects.json")
+	dir := c.String("dir")
+	lock, err := schema.ReadLock(dir)
 	if err != nil {
 		return err
 	}
-	return NewGenerator(
-		c.Bool("nofmt"),
-		c.Bool("nogoify"),
-		c.Bool("debug"),
-		objschema,
-	).Generate()
+	if lock != nil {
+		if err := lock.Verify(dir); err != nil {
+			return err
+		}
+	}
+
+	objschema, err := ioutil.ReadFile(filepath.Join(dir, string(schema.ObjectsSchema)))
+	if err != nil {
+		return err
+	}
+	config, err := LoadConfig(c.String("config"))
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.String("out"), 0755); err != nil {
+		return err
+	}
+	events := c.String("events")
+	if c.Bool("noevents") {
+		events = ""
+	}
+	return NewGenerator(Options{
+		NoFmt:         c.Bool("nofmt"),
+		NoGoify:       c.Bool("nogoify"),
+		Debug:         c.Bool("debug"),
+		Tests:         c.Bool("tests"),
+		Strict:        c.Bool("strict"),
+		FastJSON:      c.Bool("fastjson"),
+		Lenient:       c.Bool("lenient"),
+		Events:        events,
+		NoAttachments: c.Bool("noattachments"),
+		NoUploads:     c.Bool("nouploads"),
+		NoLongPoll:    c.Bool("nolongpoll"),
+		NoCache:       c.Bool("nocache"),
+		Config:        config,
+		SchemaDir:     dir,
+		OutDir:        c.String("out"),
+		Package:       c.String("package"),
+	}, objschema).Generate()
+}
+
+func fetchSchemaCmd(c *cli.Context) error {
+	ref := c.Args().First()
+	if ref == "" {
+		return errors.New("fetch: missing ref")
+	}
+	lock, err := schema.Fetch(c.Context, c.String("base-url"), ref, c.String("dir"), c.Bool("update"))
+	if err != nil {
+		return err
+	}
+	for _, file := range schema.SchemaFiles {
+		log.Printf("%s %s", lock.Files[string(file)], file)
+	}
+	return nil
 }
 
 func main() {
 	app := &cli.App{
 		Name:  "vkgen",
 		Usage: "generates Golang sources from VK Schema",
-		Flags: []cli.Flag{
-			&cli.BoolFlag{
-				Name:  "nofmt",
-				Usage: "disable code formatting",
+		Commands: []*cli.Command{
+			{
+				Name:  "generate",
+				Usage: "generate the Go package from the schema",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "dir",
+						Value: ".",
+						Usage: "read the schema files from `DIR`",
+					},
+					&cli.StringFlag{
+						Name:  "out",
+						Value: defaultPkgName,
+						Usage: "write the generated files into `DIR`",
+					},
+					&cli.StringFlag{
+						Name:  "package",
+						Value: defaultPkgName,
+						Usage: "name the generated package `NAME`",
+					},
+					&cli.BoolFlag{
+						Name:  "nofmt",
+						Usage: "disable code formatting",
+					},
+					&cli.BoolFlag{
+						Name:  "nogoify",
+						Usage: "disable names gopherization",
+					},
+					&cli.StringFlag{
+						Name:  "events",
+						Usage: "load Callback API and Long Poll events from schema `FILE` of the schema dir, none by default",
+					},
+					&cli.BoolFlag{
+						Name:  "noevents",
+						Usage: "disable events generation even with --events",
+					},
+					&cli.BoolFlag{
+						Name:  "noattachments",
+						Usage: "disable attachment helpers generation",
+					},
+					&cli.BoolFlag{
+						Name:  "nouploads",
+						Usage: "disable upload helpers generation",
+					},
+					&cli.BoolFlag{
+						Name:  "nolongpoll",
+						Usage: "disable Long Poll constructors generation",
+					},
+					&cli.BoolFlag{
+						Name:  "nocache",
+						Usage: "disable cacheable methods registry generation",
+					},
+					&cli.StringFlag{
+						Name:  "config",
+						Usage: "load generator overrides from JSON `FILE`",
+					},
+					&cli.BoolFlag{
+						Name:  "lenient",
+						Usage: "generate tolerant decoding of known-flaky fields",
+					},
+					&cli.BoolFlag{
+						Name:  "fastjson",
+						Usage: "generate reflection-free JSON (un)marshallers",
+					},
+					&cli.BoolFlag{
+						Name:  "strict",
+						Usage: "generate required fields registry for strict decoding",
+					},
+					&cli.BoolFlag{
+						Name:  "tests",
+						Usage: "generate round-trip and fuzz tests",
+					},
+					&cli.BoolFlag{
+						Name:  "debug",
+						Usage: "print emitter timings, definitions processed and fallbacks taken",
+					},
+				},
+				Action: generateSchemaCmd,
+			},
+			{
+				Name:      "fetch",
+				Usage:     "download and lock the schema at a tag or commit",
+				ArgsUsage: "REF",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "dir",
+						Value: ".",
+						Usage: "write the schema files into `DIR`",
+					},
+					&cli.StringFlag{
+						Name:  "base-url",
+						Value: schema.RepoURL,
+						Usage: "download the schema repo files from `URL`",
+					},
+					&cli.BoolFlag{
+						Name:  "update",
+						Usage: "replace the locked hashes of the ref",
+					},
+				},
+				Action: fetchSchemaCmd,
+			},
+			{
+				Name:      "diff",
+				Usage:     "report the changes between two schema versions",
+				ArgsUsage: "OLD NEW",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "format",
+						Value: "text",
+						Usage: "print the report as text, json or markdown",
+					},
+					&cli.BoolFlag{
+						Name:  "fail",
+						Usage: "exit with status 1 on breaking changes",
+					},
+				},
+				Action: diffSchemaCmd,
+			},
+			{
+				Name:      "apidiff",
+				Usage:     "report the changes of the generated Go API",
+				ArgsUsage: "OLD NEW",
+				Description: "OLD and NEW are schema directories generated in memory\n" +
+					"or directories of an already generated package.",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "events",
+						Usage: "load Callback API and Long Poll events from schema `FILE` of the schema dir, none by default",
+					},
+					&cli.StringFlag{
+						Name:  "config",
+						Usage: "load generator overrides from JSON `FILE`",
+					},
+					&cli.StringFlag{
+						Name:  "package",
+						Usage: "name the package generated from schema dirs `NAME`, \"" + defaultPkgName + "\" by default",
+					},
+					&cli.StringFlag{
+						Name:  "shims",
+						Usage: "write deprecated aliases for renamed identifiers to `FILE`",
+					},
+					&cli.BoolFlag{
+						Name:  "fail",
+						Usage: "exit with status 1 on incompatible changes",
+					},
+				},
+				Action: apiDiffCmd,
+			},
+			{
+				Name:  "lint",
+				Usage: "check the schema for problems",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "dir",
+						Value: ".",
+						Usage: "lint the schema files in `DIR`",
+					},
+					&cli.StringSliceFlag{
+						Name:  "ignore",
+						Usage: "skip the problems of `RULE`",
+					},
+					&cli.BoolFlag{
+						Name:  "json",
+						Usage: "print the problems as JSON",
+					},
+				},
+				Action: lintSchemaCmd,
+			},
+			{
+				Name:      "inspect",
+				Usage:     "describe a method or a type of the schema",
+				ArgsUsage: "method NAME | type NAME",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "dir",
+						Value: ".",
+						Usage: "read the schema files from `DIR`",
+					},
+					&cli.IntFlag{
+						Name:  "depth",
+						Value: 3,
+						Usage: "expand references up to `N` levels deep",
+					},
+					&cli.BoolFlag{
+						Name:  "json",
+						Usage: "print the description as JSON",
+					},
+				},
+				Action: inspectCmd,
+			},
+			{
+				Name:      "whereused",
+				Usage:     "list the methods and definitions using a definition",
+				ArgsUsage: "DEFINITION",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "dir",
+						Value: ".",
+						Usage: "read the schema files from `DIR`",
+					},
+					&cli.BoolFlag{
+						Name:  "direct",
+						Usage: "list the direct references only",
+					},
+					&cli.BoolFlag{
+						Name:  "json",
+						Usage: "print the usages as JSON",
+					},
+				},
+				Action: whereUsedCmd,
+			},
+			{
+				Name:  "graph",
+				Usage: "export the reference graph of the schema",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "dir",
+						Value: ".",
+						Usage: "read the schema files from `DIR`",
+					},
+					&cli.StringSliceFlag{
+						Name:  "root",
+						Usage: "start from the method, definition or method namespace `NAME`",
+					},
+					&cli.IntFlag{
+						Name:  "depth",
+						Usage: "follow up to `N` references from the roots",
+					},
+					&cli.StringFlag{
+						Name:  "format",
+						Value: "dot",
+						Usage: "print the graph as dot or json",
+					},
+				},
+				Action: graphCmd,
 			},
-			&cli.BoolFlag{
-				Name:  "nogoify",
-				Usage: "disable names gopherization",
+			{
+				Name:  "stats",
+				Usage: "report how much of the schema maps to precise Go types",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "dir",
+						Value: ".",
+						Usage: "read the schema files from `DIR`",
+					},
+					&cli.BoolFlag{
+						Name:  "list",
+						Usage: "list the fields and definitions falling back",
+					},
+					&cli.BoolFlag{
+						Name:  "json",
+						Usage: "print the report as JSON",
+					},
+				},
+				Action: statsCmd,
 			},
-			&cli.BoolFlag{
-				Name:  "debug",
-				Usage: "print debug information",
+			{
+				Name:      "validate",
+				Usage:     "validate captured responses against the schema",
+				ArgsUsage: "FILE...",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "method",
+						Required: true,
+						Usage:    "validate responses of the `METHOD`",
+					},
+					&cli.StringFlag{
+						Name:  "response",
+						Usage: "validate against the response `VARIANT`, the best matching when empty",
+					},
+					&cli.StringFlag{
+						Name:  "dir",
+						Value: ".",
+						Usage: "read the schema files from `DIR`",
+					},
+					&cli.BoolFlag{
+						Name:  "unknown",
+						Usage: "report the properties the schema does not declare",
+					},
+					&cli.BoolFlag{
+						Name:  "json",
+						Usage: "print the results as JSON",
+					},
+				},
+				Action: validateCmd,
 			},
 		},
 		HideHelpCommand: true,
-		Action:          generateSchemaCmd,
 	}
 
 	err := app.Run(os.Args)
@@ -0,0 +1,70 @@
+package runtime
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Attachment references a media object the way VK methods accept it:
+// {type}{owner_id}_{id} with an optional _{access_key}.
+type Attachment struct {
+	Type      string
+	OwnerID   OwnerID
+	ID        int64
+	AccessKey string
+}
+
+// String returns the attachment string, such as photo-1_456239017_abc.
+func (a Attachment) String() string {
+	s := a.Type + strconv.FormatInt(int64(a.OwnerID), 10) + "_" + strconv.FormatInt(a.ID, 10)
+	if a.AccessKey != "" {
+		s += "_" + a.AccessKey
+	}
+	return s
+}
+
+// ParseAttachment parses an attachment string.
+func ParseAttachment(s string) (Attachment, error) {
+	var a Attachment
+	i := strings.IndexAny(s, "-0123456789")
+	if i <= 0 {
+		return a, errors.New("attachment: missing type in " + strconv.Quote(s))
+	}
+	a.Type = s[:i]
+
+	parts := strings.SplitN(s[i:], "_", 3)
+	if len(parts) < 2 {
+		return a, errors.New("attachment: missing id in " + strconv.Quote(s))
+	}
+	owner, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return a, errors.New("attachment: bad owner id in " + strconv.Quote(s))
+	}
+	id, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return a, errors.New("attachment: bad id in " + strconv.Quote(s))
+	}
+	a.OwnerID = OwnerID(owner)
+	a.ID = id
+	if len(parts) == 3 {
+		a.AccessKey = parts[2]
+	}
+	return a, nil
+}
+
+// ParseAttachments parses a comma-separated list of attachment strings.
+func ParseAttachments(s string) ([]Attachment, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var attachments []Attachment
+	for _, item := range strings.Split(s, ",") {
+		a, err := ParseAttachment(strings.TrimSpace(item))
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, a)
+	}
+	return attachments, nil
+}
@@ -0,0 +1,66 @@
+package runtime
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAttachment(t *testing.T) {
+	tests := []struct {
+		s string
+		a Attachment
+	}{
+		{"photo1_456239017", Attachment{Type: "photo", OwnerID: 1, ID: 456239017}},
+		{"photo-1_456239017_abc", Attachment{Type: "photo", OwnerID: -1, ID: 456239017, AccessKey: "abc"}},
+		{"video-123456_789_a1b2_c3", Attachment{Type: "video", OwnerID: -123456, ID: 789, AccessKey: "a1b2_c3"}},
+		{"doc0_1", Attachment{Type: "doc", ID: 1}},
+		{"audio_playlist-2_3", Attachment{Type: "audio_playlist", OwnerID: -2, ID: 3}},
+	}
+	for _, tt := range tests {
+		a, err := ParseAttachment(tt.s)
+		if err != nil || a != tt.a {
+			t.Errorf("ParseAttachment(%q) = %+v, %v, want %+v", tt.s, a, err, tt.a)
+		}
+		if s := tt.a.String(); s != tt.s {
+			t.Errorf("%+v.String() = %q, want %q", tt.a, s, tt.s)
+		}
+	}
+}
+
+func TestParseAttachmentError(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"photo",
+		"-1_2",
+		"photo1",
+		"photo-1",
+		"photo1_x",
+		"photoX_1",
+		"photo1-2_3",
+		"photo99999999999999999999_1",
+	} {
+		if a, err := ParseAttachment(s); err == nil {
+			t.Errorf("ParseAttachment(%q) = %+v, want error", s, a)
+		}
+	}
+}
+
+func TestParseAttachments(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []Attachment
+		err  bool
+	}{
+		{"", nil, false},
+		{"photo1_2", []Attachment{{Type: "photo", OwnerID: 1, ID: 2}}, false},
+		{"photo1_2, doc-3_4_key", []Attachment{{Type: "photo", OwnerID: 1, ID: 2}, {Type: "doc", OwnerID: -3, ID: 4, AccessKey: "key"}}, false},
+		{"photo1_2,", nil, true},
+		{"photo1_2,wall", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseAttachments(tt.s)
+		if !reflect.DeepEqual(got, tt.want) || (err != nil) != tt.err {
+			t.Errorf("ParseAttachments(%q) = %+v, %v, want %+v", tt.s, got, err, tt.want)
+		}
+	}
+}
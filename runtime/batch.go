@@ -0,0 +1,147 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MaxExecuteCalls is the number of API calls one execute request may make.
+const MaxExecuteCalls = 25
+
+// Call is a typed request. The generated request structs implement it.
+type Call interface {
+	Method() string
+	Params() Params
+}
+
+// BatchItem is a call queued in a batch. Once the batch is sent, its
+// response is decoded into Result or its error is in Err.
+type BatchItem struct {
+	Method string
+	Params Params
+	Result interface{}
+	Err    error
+}
+
+// Batch queues calls and sends them in execute requests of up to
+// MaxExecuteCalls calls each.
+type Batch struct {
+	vk    *VK
+	items []*BatchItem
+}
+
+// NewBatch returns an empty batch sent with the client.
+func (vk *VK) NewBatch() *Batch {
+	return &Batch{vk: vk}
+}
+
+// Add queues the call; result is a pointer to its response type.
+func (b *Batch) Add(call Call, result interface{}) *BatchItem {
+	item := &BatchItem{Method: call.Method(), Params: call.Params(), Result: result}
+	b.items = append(b.items, item)
+	return item
+}
+
+// Len returns the number of queued calls.
+func (b *Batch) Len() int {
+	return len(b.items)
+}
+
+// Send runs the queued calls and empties the batch. The error is that of
+// the first execute request failing as a whole; the errors of single
+// calls are in their items.
+func (b *Batch) Send(ctx context.Context) error {
+	items := b.items
+	b.items = nil
+
+	var firstErr error
+	for len(items) > 0 {
+		n := len(items)
+		if n > MaxExecuteCalls {
+			n = MaxExecuteCalls
+		}
+		if err := b.vk.execute(ctx, items[:n]); err != nil {
+			for _, item := range items[:n] {
+				item.Err = err
+			}
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+		items = items[n:]
+	}
+	return firstErr
+}
+
+func (vk *VK) execute(ctx context.Context, items []*BatchItem) error {
+	code, err := ExecuteCode(items)
+	if err != nil {
+		return err
+	}
+	response, err := vk.RequestContext(ctx, "execute", Params{"code": code})
+	if err != nil {
+		return err
+	}
+	return decodeExecute(response, items)
+}
+
+// ExecuteCode compiles the calls into a VKScript program returning the
+// array of their responses.
+func ExecuteCode(items []*BatchItem) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("return [")
+	for i, item := range items {
+		args := make(map[string]string, len(item.Params))
+		for key, value := range item.Params {
+			args[key] = FormatValue(value)
+		}
+		js, err := json.Marshal(args)
+		if err != nil {
+			return "", err
+		}
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString("API." + item.Method + "(")
+		sb.Write(js)
+		sb.WriteString(")")
+	}
+	sb.WriteString("];")
+	return sb.String(), nil
+}
+
+// decodeExecute hands the results out to the items. A failed call returns
+// false and adds an entry to execute_errors, in call order. A false result
+// without a matching entry fails with ErrUnknown unless the response type
+// takes it.
+func decodeExecute(response Response, items []*BatchItem) error {
+	var results []json.RawMessage
+	if err := json.Unmarshal(response.Response, &results); err != nil {
+		return fmt.Errorf("execute: %w", err)
+	}
+	if len(results) != len(items) {
+		return fmt.Errorf("execute: %d results for %d calls", len(results), len(items))
+	}
+
+	errs := response.ExecuteErrors
+	for i, item := range items {
+		result := bytes.TrimSpace(results[i])
+		if bytes.Equal(result, []byte("false")) && len(errs) > 0 && errs[0].Method == item.Method {
+			e := errs[0]
+			item.Err = &e
+			errs = errs[1:]
+			continue
+		}
+		if item.Result == nil {
+			continue
+		}
+		item.Err = json.Unmarshal(result, item.Result)
+		if item.Err != nil && bytes.Equal(result, []byte("false")) {
+			item.Err = &ExecuteError{Method: item.Method, Code: ErrUnknown, Message: "call failed without an execute error"}
+		}
+	}
+	return nil
+}
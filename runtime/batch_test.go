@@ -0,0 +1,207 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestExecuteCode(t *testing.T) {
+	tests := []struct {
+		items []*BatchItem
+		code  string
+	}{
+		{nil, "return [];"},
+		{
+			[]*BatchItem{{Method: "users.get", Params: Params{"user_ids": []int64{1, 2}, "fields": "sex", "extended": true}}},
+			`return [API.users.get({"extended":"1","fields":"sex","user_ids":"1,2"})];`,
+		},
+		{
+			[]*BatchItem{
+				{Method: "wall.get", Params: Params{"owner_id": OwnerID(-1), "filter": `"owner"`}},
+				{Method: "groups.getById"},
+			},
+			`return [API.wall.get({"filter":"\"owner\"","owner_id":"-1"}),API.groups.getById({})];`,
+		},
+	}
+	for _, tt := range tests {
+		code, err := ExecuteCode(tt.items)
+		if err != nil || code != tt.code {
+			t.Errorf("ExecuteCode() = %s, %v, want %s", code, err, tt.code)
+		}
+	}
+}
+
+// batchCall is a call with parameters.
+type batchCall struct {
+	method string
+	params Params
+}
+
+func (c batchCall) Method() string {
+	return c.method
+}
+
+func (c batchCall) Params() Params {
+	return c.params
+}
+
+var executeCallRe = regexp.MustCompile(`API\.([\w.]+)\((\{[^}]*\})\)`)
+
+// executeCall is a call of a VKScript program run by the fake execute
+// endpoint.
+type executeCall struct {
+	Method string
+	Args   map[string]string
+}
+
+// executeHandler is a fake execute endpoint running the calls of the
+// program with answer, which returns the result, or false and the error.
+func executeHandler(t *testing.T, answer func(call executeCall) (interface{}, *ExecuteError)) (http.Handler, *[][]executeCall) {
+	var mu sync.Mutex
+	var requests [][]executeCall
+	mux := http.NewServeMux()
+	mux.HandleFunc("/method/execute", func(w http.ResponseWriter, r *http.Request) {
+		var calls []executeCall
+		for _, m := range executeCallRe.FindAllStringSubmatch(r.FormValue("code"), -1) {
+			call := executeCall{Method: m[1]}
+			if err := json.Unmarshal([]byte(m[2]), &call.Args); err != nil {
+				t.Errorf("execute: %v", err)
+			}
+			calls = append(calls, call)
+		}
+		mu.Lock()
+		requests = append(requests, calls)
+		mu.Unlock()
+
+		results := make([]interface{}, 0, len(calls))
+		errs := []*ExecuteError{}
+		for _, call := range calls {
+			result, err := answer(call)
+			if err != nil {
+				results = append(results, false)
+				errs = append(errs, err)
+				continue
+			}
+			results = append(results, result)
+		}
+		writeJSON(w, map[string]interface{}{"response": results, "execute_errors": errs})
+	})
+	return mux, &requests
+}
+
+func TestBatchSend(t *testing.T) {
+	handler, requests := executeHandler(t, func(call executeCall) (interface{}, *ExecuteError) {
+		switch id := call.Args["user_id"]; {
+		case id == "13":
+			return nil, &ExecuteError{Method: call.Method, Code: ErrAccessDenied, Message: "Access denied"}
+		case id == "14":
+			// an execute_errors entry of another method
+			return nil, &ExecuteError{Method: "users.getFollowers", Code: ErrAccessDenied, Message: "Access denied"}
+		case call.Method == "account.setOnline":
+			return false, nil
+		default:
+			return map[string]string{"id": id}, nil
+		}
+	})
+	vk := newTestVK(t, handler)
+
+	b := vk.NewBatch()
+	results := make([]struct {
+		ID string `json:"id"`
+	}, 30)
+	items := make([]*BatchItem, 30)
+	for i := range items {
+		items[i] = b.Add(batchCall{"users.get", Params{"user_id": i}}, &results[i])
+	}
+	var online bool
+	onlineItem := b.Add(batchCall{"account.setOnline", nil}, &online)
+	if err := b.Send(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if b.Len() != 0 {
+		t.Errorf("Len() = %d after Send", b.Len())
+	}
+
+	if len(*requests) != 2 || len((*requests)[0]) != MaxExecuteCalls || len((*requests)[1]) != 6 {
+		t.Fatalf("%d execute requests", len(*requests))
+	}
+	for i, item := range items {
+		var execErr *ExecuteError
+		switch i {
+		case 13:
+			if !errors.As(item.Err, &execErr) || execErr.Code != ErrAccessDenied || ErrorCodeOf(item.Err) != ErrAccessDenied {
+				t.Errorf("item 13: %v, want access denied", item.Err)
+			}
+		case 14:
+			if !errors.As(item.Err, &execErr) || execErr.Method != "users.get" || execErr.Code != ErrUnknown {
+				t.Errorf("item 14: %v, want an unknown error of users.get", item.Err)
+			}
+		default:
+			if item.Err != nil || results[i].ID != strconv.Itoa(i) {
+				t.Errorf("item %d: %+v, %v", i, results[i], item.Err)
+			}
+		}
+	}
+	if onlineItem.Err != nil || online {
+		t.Errorf("account.setOnline: %t, %v", online, onlineItem.Err)
+	}
+}
+
+func TestBatchSendError(t *testing.T) {
+	var requests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/method/execute", func(w http.ResponseWriter, r *http.Request) {
+		if requests++; requests == 2 {
+			apiError(w, ErrAccessDenied, "Access denied")
+			return
+		}
+		results := make([]int, MaxExecuteCalls)
+		apiResponse(w, results)
+	})
+	vk := newTestVK(t, mux)
+
+	b := vk.NewBatch()
+	items := make([]*BatchItem, 30)
+	for i := range items {
+		var result int
+		items[i] = b.Add(batchCall{"users.get", nil}, &result)
+	}
+	err := b.Send(context.Background())
+	if ErrorCodeOf(err) != ErrAccessDenied {
+		t.Fatalf("Send() = %v, want access denied", err)
+	}
+	for i, item := range items {
+		if failed := i >= MaxExecuteCalls; (item.Err != nil) != failed || failed && item.Err != err {
+			t.Errorf("item %d: %v", i, item.Err)
+		}
+	}
+}
+
+func TestDecodeExecute(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		err      bool
+	}{
+		{"results", `{"response":[1,2]}`, false},
+		{"count", `{"response":[1]}`, true},
+		{"not an array", `{"response":{"a":1}}`, true},
+	}
+	for _, tt := range tests {
+		var response Response
+		if err := json.Unmarshal([]byte(tt.response), &response); err != nil {
+			t.Fatal(err)
+		}
+		var a, b int
+		items := []*BatchItem{{Method: "a", Result: &a}, {Method: "b", Result: &b}}
+		if err := decodeExecute(response, items); (err != nil) != tt.err {
+			t.Errorf("%s: decodeExecute() = %v", tt.name, err)
+		}
+	}
+}
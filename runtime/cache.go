@@ -0,0 +1,138 @@
+package runtime
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Store keeps cached responses.
+type Store interface {
+	Get(key string) (json.RawMessage, bool)
+	Set(key string, value json.RawMessage, ttl time.Duration)
+}
+
+// Cache caches the responses of cacheable calls.
+type Cache struct {
+	Store Store
+	TTL   time.Duration
+	// Cacheable tells which methods are safe to cache. The generated
+	// Cacheable function classifies the schema methods.
+	Cacheable func(method string) bool
+	// Scope separates the entries of clients sharing the store, since
+	// responses depend on the token.
+	Scope string
+}
+
+// TokenScope returns a cache scope for the access token that does not
+// reveal it.
+func TokenScope(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return fmt.Sprintf("%x", sum[:8])
+}
+
+// Interceptor returns the interceptor serving cacheable calls from the
+// store and storing their successful responses.
+func (c *Cache) Interceptor() Interceptor {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, inv *Invocation) (json.RawMessage, error) {
+			if c.Cacheable == nil || !c.Cacheable(inv.Method) {
+				return next(ctx, inv)
+			}
+
+			key := c.key(inv)
+			if response, ok := c.Store.Get(key); ok {
+				return response, nil
+			}
+			response, err := next(ctx, inv)
+			if err == nil {
+				c.Store.Set(key, response, c.TTL)
+			}
+			return response, err
+		}
+	}
+}
+
+// key is the scope, the method and the canonical params. The SecretParams
+// enter it hashed, so a token passed in params neither reaches the store
+// nor shares the entries of other tokens.
+func (c *Cache) key(inv *Invocation) string {
+	query := url.Values{}
+	for key, value := range inv.Params {
+		if isSecret(key) {
+			query.Set(key, TokenScope(FormatValue(value)))
+			continue
+		}
+		query.Set(key, FormatValue(value))
+	}
+	return c.Scope + "/" + inv.Method + "?" + query.Encode()
+}
+
+// LRU is an in-memory Store evicting the least recently used entries.
+type LRU struct {
+	Size  int
+	Clock Clock
+
+	mu      sync.Mutex
+	order   *list.List // front is the most recently used
+	entries map[string]*list.Element
+}
+
+type lruEntry struct {
+	key     string
+	value   json.RawMessage
+	expires time.Time
+}
+
+// NewLRU returns a store of up to size entries.
+func NewLRU(size int) *LRU {
+	return &LRU{
+		Size:    size,
+		Clock:   RealClock,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get implements Store.
+func (s *LRU) Get(key string) (json.RawMessage, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*lruEntry)
+	if !s.Clock.Now().Before(entry.expires) {
+		s.order.Remove(elem)
+		delete(s.entries, key)
+		return nil, false
+	}
+	s.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set implements Store.
+func (s *LRU) Set(key string, value json.RawMessage, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expires := s.Clock.Now().Add(ttl)
+	if elem, ok := s.entries[key]; ok {
+		elem.Value = &lruEntry{key: key, value: value, expires: expires}
+		s.order.MoveToFront(elem)
+		return
+	}
+	s.entries[key] = s.order.PushFront(&lruEntry{key: key, value: value, expires: expires})
+	for s.order.Len() > s.Size {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.entries, oldest.Value.(*lruEntry).key)
+	}
+}
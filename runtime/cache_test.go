@@ -0,0 +1,162 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// countingServer answers every method with its name and the user_id
+// param, failing the calls with fail=1, and counts the calls.
+func countingServer(calls *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		if r.FormValue("fail") == "1" {
+			apiError(w, ErrServer, "Internal server error")
+			return
+		}
+		apiResponse(w, r.URL.Path[len("/method/"):]+" "+r.FormValue("user_id"))
+	}
+}
+
+func TestCacheInterceptor(t *testing.T) {
+	var calls int
+	vk := newTestVK(t, countingServer(&calls))
+	clock := newFakeClock()
+	store := NewLRU(10)
+	store.Clock = clock
+	cache := &Cache{
+		Store:     store,
+		TTL:       time.Minute,
+		Cacheable: func(method string) bool { return method == "users.get" },
+		Scope:     TokenScope("token"),
+	}
+	vk.Interceptors = []Interceptor{cache.Interceptor()}
+	vk.Retry = nil
+
+	steps := []struct {
+		name    string
+		method  string
+		params  Params
+		advance time.Duration
+		result  string
+		err     bool
+		calls   int // server calls so far
+	}{
+		{"miss", "users.get", Params{"user_id": 1}, 0, "users.get 1", false, 1},
+		{"hit", "users.get", Params{"user_id": 1}, 0, "users.get 1", false, 1},
+		{"other params", "users.get", Params{"user_id": 2}, 0, "users.get 2", false, 2},
+		{"not cacheable", "wall.get", Params{"user_id": 1}, 0, "wall.get 1", false, 3},
+		{"not cacheable again", "wall.get", Params{"user_id": 1}, 0, "wall.get 1", false, 4},
+		{"error", "users.get", Params{"user_id": 3, "fail": 1}, 0, "", true, 5},
+		{"error not cached", "users.get", Params{"user_id": 3, "fail": 1}, 0, "", true, 6},
+		{"before expiry", "users.get", Params{"user_id": 1}, 59 * time.Second, "users.get 1", false, 6},
+		{"expired", "users.get", Params{"user_id": 1}, time.Second, "users.get 1", false, 7},
+		{"cached again", "users.get", Params{"user_id": 1}, 0, "users.get 1", false, 7},
+	}
+	for _, step := range steps {
+		clock.Advance(step.advance)
+		var result string
+		err := vk.RequestUnmarshalContext(context.Background(), step.method, step.params, &result)
+		if result != step.result || (err != nil) != step.err || calls != step.calls {
+			t.Errorf("%s: %q, %v after %d calls, want %q after %d", step.name, result, err, calls, step.result, step.calls)
+		}
+	}
+}
+
+func TestCacheScope(t *testing.T) {
+	var calls int
+	store := NewLRU(10)
+	get := func(scope string, params Params) {
+		vk := newTestVK(t, countingServer(&calls))
+		cache := &Cache{Store: store, TTL: time.Minute, Cacheable: func(string) bool { return true }, Scope: scope}
+		vk.Interceptors = []Interceptor{cache.Interceptor()}
+		var result string
+		if err := vk.RequestUnmarshalContext(context.Background(), "users.get", params, &result); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	steps := []struct {
+		scope  string
+		params Params
+		calls  int
+	}{
+		{TokenScope("first"), nil, 1},
+		{TokenScope("first"), nil, 1},
+		{TokenScope("second"), nil, 2},
+		{TokenScope("second"), nil, 2},
+		// tokens passed in params keep apart as well
+		{"", Params{"access_token": "first"}, 3},
+		{"", Params{"access_token": "second"}, 4},
+		{"", Params{"access_token": "first"}, 4},
+	}
+	for i, step := range steps {
+		if get(step.scope, step.params); calls != step.calls {
+			t.Errorf("step %d: %d calls, want %d", i, calls, step.calls)
+		}
+	}
+	if TokenScope("first") == TokenScope("second") || strings.Contains(TokenScope("first"), "first") {
+		t.Errorf("TokenScope() = %q", TokenScope("first"))
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	c := &Cache{Scope: "s"}
+	tests := []struct {
+		params Params
+		key    string
+	}{
+		{nil, "s/users.get?"},
+		{Params{"user_ids": []int{1, 2}, "fields": "sex"}, "s/users.get?fields=sex&user_ids=1%2C2"},
+		{Params{"access_token": "t0ken", "v": "5.131"}, "s/users.get?access_token=" + TokenScope("t0ken") + "&v=5.131"},
+		{Params{"client_secret": "s3", "password": "pw"}, "s/users.get?client_secret=" + TokenScope("s3") + "&password=" + TokenScope("pw")},
+	}
+	for _, tt := range tests {
+		key := c.key(&Invocation{Method: "users.get", Params: tt.params})
+		if key != tt.key {
+			t.Errorf("key(%v) = %q, want %q", tt.params, key, tt.key)
+		}
+		for _, secret := range []string{"t0ken", "s3", "pw"} {
+			if strings.Contains(key, secret) {
+				t.Errorf("key(%v) = %q reveals %s", tt.params, key, secret)
+			}
+		}
+	}
+}
+
+func TestLRU(t *testing.T) {
+	clock := newFakeClock()
+	s := NewLRU(2)
+	s.Clock = clock
+	value := func(v string) json.RawMessage { return json.RawMessage(`"` + v + `"`) }
+
+	s.Set("a", value("a"), time.Minute)
+	s.Set("b", value("b"), time.Minute)
+	s.Get("a")                          // a is now the most recently used
+	s.Set("c", value("c"), time.Minute) // evicts b
+	s.Set("a", value("a2"), 2*time.Minute)
+	clock.Advance(time.Minute) // c expires, a does not
+
+	tests := []struct {
+		key   string
+		value string
+		ok    bool
+	}{
+		{"a", `"a2"`, true},
+		{"b", "", false},
+		{"c", "", false},
+	}
+	for _, tt := range tests {
+		v, ok := s.Get(tt.key)
+		if string(v) != tt.value || ok != tt.ok {
+			t.Errorf("Get(%s) = %s, %t, want %s, %t", tt.key, v, ok, tt.value, tt.ok)
+		}
+	}
+	if s.order.Len() != 1 || len(s.entries) != 1 {
+		t.Errorf("%d entries left, want 1", len(s.entries))
+	}
+}
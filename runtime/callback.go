@@ -0,0 +1,42 @@
+package runtime
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// ServeCallback handles a Callback API request: it answers confirmation
+// requests with the code, checks the secret when one is set and passes
+// the other events to the handler. VK resends the event unless the
+// response is "ok", so a handler error answers 500.
+func ServeCallback(w http.ResponseWriter, r *http.Request, confirmationCode, secret string, handler EventHandler) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var event struct {
+		Event
+		Secret string `json:"secret"`
+	}
+	if err := json.NewDecoder(io.LimitReader(r.Body, 16<<20)).Decode(&event); err != nil {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+	if secret != "" && subtle.ConstantTimeCompare([]byte(event.Secret), []byte(secret)) != 1 {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	if event.Type == "confirmation" {
+		_, _ = io.WriteString(w, confirmationCode)
+		return
+	}
+	if err := handler(r.Context(), event.Event); err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	_, _ = io.WriteString(w, "ok")
+}
@@ -0,0 +1,156 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// messageNew is the object of message_new events.
+type messageNew struct {
+	Message struct {
+		Text string `json:"text"`
+	} `json:"message"`
+}
+
+// callbackHandler dispatches the events the way the generated
+// EventHandler does: message_new to OnMessageNew, anything else to
+// Fallback.
+type callbackHandler struct {
+	OnMessageNew func(ctx context.Context, event Event, obj messageNew) error
+	Fallback     func(ctx context.Context, event Event) error
+}
+
+func (h *callbackHandler) Handle(ctx context.Context, event Event) error {
+	switch event.Type {
+	case "message_new":
+		if h.OnMessageNew == nil {
+			break
+		}
+		var obj messageNew
+		if err := json.Unmarshal(event.Object, &obj); err != nil {
+			return err
+		}
+		return h.OnMessageNew(ctx, event, obj)
+	}
+
+	if h.Fallback != nil {
+		return h.Fallback(ctx, event)
+	}
+	return nil
+}
+
+func TestServeCallback(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		body     string
+		secret   string
+		fail     bool // the callbacks fail
+		status   int
+		response string
+		handled  string // callback called, with what
+	}{
+		{
+			name:     "confirmation",
+			body:     `{"type":"confirmation","group_id":1}`,
+			status:   http.StatusOK,
+			response: "c0de",
+		},
+		{
+			name:     "confirmation with secret",
+			body:     `{"type":"confirmation","group_id":1,"secret":"s3"}`,
+			secret:   "s3",
+			status:   http.StatusOK,
+			response: "c0de",
+		},
+		{
+			name:   "wrong secret",
+			body:   `{"type":"message_new","group_id":1,"secret":"nope","object":{}}`,
+			secret: "s3",
+			status: http.StatusForbidden,
+		},
+		{
+			name:   "missing secret",
+			body:   `{"type":"confirmation","group_id":1}`,
+			secret: "s3",
+			status: http.StatusForbidden,
+		},
+		{
+			name:     "typed callback",
+			body:     `{"type":"message_new","group_id":1,"secret":"s3","object":{"message":{"text":"hi"}}}`,
+			secret:   "s3",
+			status:   http.StatusOK,
+			response: "ok",
+			handled:  "message_new hi",
+		},
+		{
+			name:     "fallback",
+			body:     `{"type":"wall_post_new","group_id":1,"object":{}}`,
+			status:   http.StatusOK,
+			response: "ok",
+			handled:  "fallback wall_post_new",
+		},
+		{
+			name:    "handler error",
+			body:    `{"type":"message_new","group_id":1,"object":{"message":{"text":"hi"}}}`,
+			fail:    true,
+			status:  http.StatusInternalServerError,
+			handled: "message_new hi",
+		},
+		{
+			name:   "malformed object",
+			body:   `{"type":"message_new","group_id":1,"object":{"message":[]}}`,
+			status: http.StatusInternalServerError,
+		},
+		{
+			name:   "malformed event",
+			body:   `{"type":`,
+			status: http.StatusBadRequest,
+		},
+		{
+			name:   "method",
+			method: http.MethodGet,
+			status: http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled string
+			var err error
+			if tt.fail {
+				err = errors.New("handler failed")
+			}
+			h := &callbackHandler{
+				OnMessageNew: func(ctx context.Context, event Event, obj messageNew) error {
+					handled = event.Type + " " + obj.Message.Text
+					return err
+				},
+				Fallback: func(ctx context.Context, event Event) error {
+					handled = "fallback " + event.Type
+					return err
+				},
+			}
+			method := tt.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			w := httptest.NewRecorder()
+			ServeCallback(w, httptest.NewRequest(method, "/callback", strings.NewReader(tt.body)), "c0de", tt.secret, h.Handle)
+
+			if w.Code != tt.status {
+				t.Errorf("status %d, want %d", w.Code, tt.status)
+			}
+			if tt.response != "" && w.Body.String() != tt.response {
+				t.Errorf("response %q, want %q", w.Body.String(), tt.response)
+			}
+			if handled != tt.handled {
+				t.Errorf("handled %q, want %q", handled, tt.handled)
+			}
+		})
+	}
+}
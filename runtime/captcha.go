@@ -0,0 +1,64 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/url"
+)
+
+// CaptchaSolver answers the captcha VK asks for with error 14.
+type CaptchaSolver interface {
+	SolveCaptcha(ctx context.Context, sid, img string) (key string, err error)
+}
+
+// CaptchaSolverFunc adapts a function to CaptchaSolver.
+type CaptchaSolverFunc func(ctx context.Context, sid, img string) (string, error)
+
+// SolveCaptcha calls f.
+func (f CaptchaSolverFunc) SolveCaptcha(ctx context.Context, sid, img string) (string, error) {
+	return f(ctx, sid, img)
+}
+
+// ValidationHandler sends the user through the redirect VK asks for with
+// error 17 and returns once the validation is done.
+type ValidationHandler interface {
+	HandleValidation(ctx context.Context, redirectURI string) error
+}
+
+// ValidationHandlerFunc adapts a function to ValidationHandler.
+type ValidationHandlerFunc func(ctx context.Context, redirectURI string) error
+
+// HandleValidation calls f.
+func (f ValidationHandlerFunc) HandleValidation(ctx context.Context, redirectURI string) error {
+	return f(ctx, redirectURI)
+}
+
+// maxChallenges limits how many captchas and validations one call answers.
+const maxChallenges = 3
+
+// answerChallenge lets the hooks answer a captcha or validation error and
+// reports whether the call should be sent again with the updated query.
+// The error is the one of the hook.
+func (vk *VK) answerChallenge(ctx context.Context, query url.Values, err error) (bool, error) {
+	var vkErr *Error
+	if !errors.As(err, &vkErr) {
+		return false, nil
+	}
+
+	switch {
+	case vkErr.Code == ErrCaptcha && vk.CaptchaSolver != nil:
+		key, solveErr := vk.CaptchaSolver.SolveCaptcha(ctx, vkErr.CaptchaSID, vkErr.CaptchaImg)
+		if solveErr != nil {
+			return false, solveErr
+		}
+		query.Set("captcha_sid", vkErr.CaptchaSID)
+		query.Set("captcha_key", key)
+		return true, nil
+	case vkErr.Code == ErrAuthValidation && vk.ValidationHandler != nil:
+		if validateErr := vk.ValidationHandler.HandleValidation(ctx, vkErr.RedirectURI); validateErr != nil {
+			return false, validateErr
+		}
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,91 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// challengeServer is a fake API asking for a captcha or validation the
+// first challenges times and checking the answer is sent back.
+func challengeServer(t *testing.T, code ErrorCode, challenges int, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if *requests++; *requests <= challenges {
+			writeJSON(w, map[string]interface{}{"error": Error{
+				Code:        code,
+				Message:     "challenge",
+				CaptchaSID:  "sid",
+				CaptchaImg:  "https://api.vk.com/captcha.php?sid=sid",
+				RedirectURI: "https://m.vk.com/login?act=security_check",
+			}})
+			return
+		}
+		if code == ErrCaptcha && (r.FormValue("captcha_sid") != "sid" || r.FormValue("captcha_key") != "key") {
+			t.Errorf("captcha answer %q %q", r.FormValue("captcha_sid"), r.FormValue("captcha_key"))
+		}
+		apiResponse(w, 1)
+	}
+}
+
+func TestRequestChallenge(t *testing.T) {
+	errSolver := errors.New("no solution")
+	solver := CaptchaSolverFunc(func(ctx context.Context, sid, img string) (string, error) {
+		if sid != "sid" || img == "" {
+			t.Errorf("SolveCaptcha(%q, %q)", sid, img)
+		}
+		return "key", nil
+	})
+	validator := ValidationHandlerFunc(func(ctx context.Context, redirectURI string) error {
+		if redirectURI == "" {
+			t.Error("empty redirect URI")
+		}
+		return nil
+	})
+
+	tests := []struct {
+		name       string
+		code       ErrorCode
+		challenges int
+		solver     CaptchaSolver
+		validator  ValidationHandler
+		requests   int
+		err        error // nil, errSolver or the API error code below
+		errCode    ErrorCode
+	}{
+		{"captcha solved", ErrCaptcha, 1, solver, nil, 2, nil, 0},
+		{"captcha twice", ErrCaptcha, 2, solver, nil, 3, nil, 0},
+		{"no solver", ErrCaptcha, 1, nil, nil, 1, nil, ErrCaptcha},
+		{"solver fails", ErrCaptcha, 1, CaptchaSolverFunc(func(ctx context.Context, sid, img string) (string, error) {
+			return "", errSolver
+		}), nil, 1, errSolver, 0},
+		{"too many captchas", ErrCaptcha, 10, solver, nil, maxChallenges + 1, nil, ErrCaptcha},
+		{"validation passed", ErrAuthValidation, 1, nil, validator, 2, nil, 0},
+		{"no validation handler", ErrAuthValidation, 1, solver, nil, 1, nil, ErrAuthValidation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			vk := newTestVK(t, challengeServer(t, tt.code, tt.challenges, &requests))
+			vk.CaptchaSolver = tt.solver
+			vk.ValidationHandler = tt.validator
+
+			_, err := vk.Request("wall.post", Params{"message": "hi"})
+			switch {
+			case tt.err != nil:
+				if !errors.Is(err, tt.err) {
+					t.Errorf("Request() error = %v, want %v", err, tt.err)
+				}
+			case tt.errCode != 0:
+				if ErrorCodeOf(err) != tt.errCode {
+					t.Errorf("Request() error = %v, want code %d", err, tt.errCode)
+				}
+			case err != nil:
+				t.Errorf("Request() error = %v", err)
+			}
+			if requests != tt.requests {
+				t.Errorf("%d requests, want %d", requests, tt.requests)
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+)
+
+// Cassette is the JSON file a Recorder keeps its interactions in:
+//
+//	{
+//	  "interactions": [
+//	    {
+//	      "method": "users.get",
+//	      "params": {"user_ids": "1", "v": "5.131"},
+//	      "status": 200,
+//	      "content_type": "application/json; charset=utf-8",
+//	      "body": {"response": [{"id": 1, "first_name": "Pavel"}]}
+//	    }
+//	  ]
+//	}
+//
+// method is the last element of the request path, the API method name for
+// API requests. params are the query and form parameters with the secret
+// ones (SecretParams) left out; uploads have none. body is the response
+// itself when content_type is JSON, with the values of the SecretParams
+// keys masked, and a JSON string otherwise.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Method      string            `json:"method"`
+	Params      map[string]string `json:"params"`
+	Status      int               `json:"status"`
+	ContentType string            `json:"content_type"`
+	Body        json.RawMessage   `json:"body"`
+}
+
+// RecorderMode tells whether a Recorder records or replays.
+type RecorderMode int
+
+// Recorder modes.
+const (
+	RecorderReplay RecorderMode = iota
+	RecorderRecord
+)
+
+// Recorder is an http.RoundTripper recording the requests made through it
+// into a cassette, or replaying them from one. In replay mode a request
+// matching no unused interaction fails.
+type Recorder struct {
+	Mode RecorderMode
+	Path string
+	// Transport makes the requests being recorded.
+	Transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// NewRecorder returns a recorder for the cassette file. In replay mode the
+// cassette is loaded from it.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{Mode: mode, Path: path, Transport: http.DefaultTransport}
+	if mode == RecorderRecord {
+		return r, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &r.cassette); err != nil {
+		return nil, fmt.Errorf("cassette %s: %w", path, err)
+	}
+	r.used = make([]bool, len(r.cassette.Interactions))
+	return r, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	method, params, err := interactionKey(req)
+	if err != nil {
+		return nil, err
+	}
+	if r.Mode == RecorderReplay {
+		return r.replay(req, method, params)
+	}
+
+	resp, err := r.Transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	contentType := resp.Header.Get("Content-Type")
+	if isJSON(contentType) && json.Valid(body) {
+		body = scrubSecrets(body)
+	} else {
+		body, _ = json.Marshal(string(body))
+	}
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Method:      method,
+		Params:      params,
+		Status:      resp.StatusCode,
+		ContentType: contentType,
+		Body:        body,
+	})
+	r.mu.Unlock()
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, method string, params map[string]string) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.used[i] || interaction.Method != method || !equalParams(interaction.Params, params) {
+			continue
+		}
+		r.used[i] = true
+
+		var body []byte
+		var s string
+		if json.Unmarshal(interaction.Body, &s) == nil {
+			body = []byte(s)
+		} else {
+			var buf bytes.Buffer
+			if err := json.Compact(&buf, interaction.Body); err != nil {
+				return nil, err
+			}
+			body = buf.Bytes()
+		}
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Status, http.StatusText(interaction.Status)),
+			StatusCode:    interaction.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": {interaction.ContentType}},
+			Body:          ioutil.NopCloser(bytes.NewReader(body)),
+			ContentLength: int64(len(body)),
+			Request:       req,
+		}, nil
+	}
+
+	data, _ := json.Marshal(params)
+	return nil, fmt.Errorf("cassette %s: no recorded interaction for %s %s", r.Path, method, data)
+}
+
+// Save writes the recorded interactions to the cassette file.
+func (r *Recorder) Save() error {
+	if r.Mode != RecorderRecord {
+		return errors.New("cassette: not recording")
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.Path, append(data, '\n'), 0644)
+}
+
+// Unused returns the interactions not replayed yet.
+func (r *Recorder) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for i, interaction := range r.cassette.Interactions {
+		if !r.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+// interactionKey returns the method and the normalized params of the
+// request, leaving its body readable.
+func interactionKey(req *http.Request) (string, map[string]string, error) {
+	values := url.Values{}
+	for key, vs := range req.URL.Query() {
+		values[key] = vs
+	}
+
+	mediatype, _, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if req.Body != nil && mediatype == "application/x-www-form-urlencoded" {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return "", nil, err
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return "", nil, err
+		}
+		for key, vs := range form {
+			values[key] = vs
+		}
+	}
+
+	params := make(map[string]string, len(values))
+	for key, vs := range values {
+		params[key] = strings.Join(vs, ",")
+	}
+	for _, key := range SecretParams {
+		delete(params, key)
+	}
+	return path.Base(req.URL.Path), params, nil
+}
+
+// scrubSecrets masks the values of the SecretParams keys anywhere in the
+// JSON body. A body without them is kept as is.
+func scrubSecrets(body []byte) []byte {
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil || !scrubValue(v) {
+		return body
+	}
+	scrubbed, err := json.Marshal(v)
+	if err != nil {
+		return body
+	}
+	return scrubbed
+}
+
+// scrubValue masks the secrets in v and reports whether there were any.
+func scrubValue(v interface{}) bool {
+	found := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSecret(key) {
+				v[key] = "***"
+				found = true
+				continue
+			}
+			found = scrubValue(value) || found
+		}
+	case []interface{}:
+		for _, value := range v {
+			found = scrubValue(value) || found
+		}
+	}
+	return found
+}
+
+func isSecret(key string) bool {
+	for _, secret := range SecretParams {
+		if key == secret {
+			return true
+		}
+	}
+	return false
+}
+
+func equalParams(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if v, ok := b[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+func isJSON(contentType string) bool {
+	mediatype, _, _ := mime.ParseMediaType(contentType)
+	return mediatype == "application/json"
+}
@@ -0,0 +1,124 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// cassetteAPI is a local API stand-in to record.
+func cassetteAPI(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/method/users.get", func(w http.ResponseWriter, r *http.Request) {
+		apiResponse(w, []map[string]interface{}{{"id": 1, "first_name": "Pavel"}})
+	})
+	mux.HandleFunc("/method/auth.refreshToken", func(w http.ResponseWriter, r *http.Request) {
+		apiResponse(w, map[string]interface{}{"token": map[string]interface{}{"access_token": "fresh-secret", "expires_in": 0}})
+	})
+	mux.Handle("/upload", uploadHandler(t, "photo", `{"server":1,"photo":"[]","hash":"h"}`))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// cassetteCalls makes the calls recorded and replayed, returning what the
+// client got.
+func cassetteCalls(t *testing.T, vk *VK, uploadURL string) []string {
+	var got []string
+	response, err := vk.Request("users.get", Params{"user_ids": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, string(response.Response))
+	if response, err = vk.Request("auth.refreshToken", nil); err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, string(response.Response))
+	uploaded, err := vk.Upload(context.Background(), uploadURL, "photo", bytes.NewReader(pngHeader))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(got, FormatValue(uploaded["hash"]))
+}
+
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "cassette.json")
+
+	srv := cassetteAPI(t)
+	rec, err := NewRecorder(path, RecorderRecord)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec.Transport = srv.Client().Transport
+	vk := NewVK("token-secret")
+	vk.MethodURL = srv.URL + "/method/"
+	vk.Client = &http.Client{Transport: rec}
+	vk.Limiter = nil
+	recorded := cassetteCalls(t, vk, srv.URL+"/upload")
+	if !strings.Contains(recorded[1], "fresh-secret") {
+		t.Errorf("recording changed the response %s", recorded[1])
+	}
+	if err := rec.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"token-secret", "fresh-secret"} {
+		if bytes.Contains(data, []byte(secret)) {
+			t.Errorf("cassette keeps %s:\n%s", secret, data)
+		}
+	}
+
+	// replay without the server
+	srv.Close()
+	rec, err = NewRecorder(path, RecorderReplay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vk.Client = &http.Client{Transport: rec}
+	replayed := cassetteCalls(t, vk, srv.URL+"/upload")
+	want := []string{recorded[0], `{"token":{"access_token":"***","expires_in":0}}`, recorded[2]}
+	for i := range want {
+		if replayed[i] != want[i] {
+			t.Errorf("replayed %s, want %s", replayed[i], want[i])
+		}
+	}
+	if unused := rec.Unused(); len(unused) != 0 {
+		t.Errorf("unused interactions %+v", unused)
+	}
+	if _, err := vk.Request("users.get", Params{"user_ids": 1}); err == nil {
+		t.Error("an interaction was replayed twice")
+	}
+}
+
+func TestScrubSecrets(t *testing.T) {
+	tests := []struct {
+		body string
+		want string
+	}{
+		{`{"response":{"id":1}}`, `{"response":{"id":1}}`},
+		{`{ "b": 1, "a": 2 }`, `{ "b": 1, "a": 2 }`},
+		{`{"access_token":"x","expires_in":0}`, `{"access_token":"***","expires_in":0}`},
+		{`[{"user":{"password":"x"}},{"client_secret":1}]`, `[{"user":{"password":"***"}},{"client_secret":"***"}]`},
+		{`{"id":12345678901234567890,"access_token":"x"}`, `{"access_token":"***","id":12345678901234567890}`},
+	}
+	for _, tt := range tests {
+		if got := scrubSecrets([]byte(tt.body)); string(got) != tt.want {
+			t.Errorf("scrubSecrets(%s) = %s, want %s", tt.body, got, tt.want)
+		}
+	}
+}
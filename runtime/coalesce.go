@@ -0,0 +1,143 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// coalescer collects the calls made within a window, or up to
+// MaxExecuteCalls of them, into one execute request.
+type coalescer struct {
+	vk     *VK
+	window time.Duration
+
+	mu      sync.Mutex
+	pending []*coalescedCall
+	timer   *time.Timer
+}
+
+type coalescedCall struct {
+	ctx    context.Context
+	item   BatchItem
+	result json.RawMessage
+	done   chan struct{}
+}
+
+// Coalesce makes the client send the calls made within the window as a
+// single execute request, up to MaxExecuteCalls calls each. Calls then
+// take up to window longer. A call failing in execute with an error the
+// client retries or answers is sent again alone. Coalesce(0) turns it off.
+func (vk *VK) Coalesce(window time.Duration) {
+	if window <= 0 {
+		vk.coalescer = nil
+		return
+	}
+	vk.coalescer = &coalescer{vk: vk, window: window}
+}
+
+// coalescable reports whether the call may go into an execute request,
+// which runs with the client token.
+func coalescable(method string, params Params) bool {
+	if method == "execute" {
+		return false
+	}
+	_, ok := params["access_token"]
+	return !ok
+}
+
+func (c *coalescer) do(ctx context.Context, method string, params Params) (json.RawMessage, error) {
+	call := &coalescedCall{
+		ctx:  ctx,
+		item: BatchItem{Method: method, Params: params},
+		done: make(chan struct{}),
+	}
+	call.item.Result = &call.result
+
+	c.mu.Lock()
+	c.pending = append(c.pending, call)
+	switch {
+	case len(c.pending) == MaxExecuteCalls:
+		c.timer.Stop()
+		go c.send(c.take())
+	case len(c.pending) == 1:
+		c.timer = time.AfterFunc(c.window, func() {
+			c.mu.Lock()
+			calls := c.take()
+			c.mu.Unlock()
+			c.send(calls)
+		})
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.result, call.item.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// take empties the queue; c.mu is held.
+func (c *coalescer) take() []*coalescedCall {
+	calls := c.pending
+	c.pending = nil
+	return calls
+}
+
+func (c *coalescer) send(calls []*coalescedCall) {
+	if len(calls) == 0 {
+		return
+	}
+
+	items := make([]*BatchItem, len(calls))
+	for i, call := range calls {
+		items[i] = &call.item
+	}
+	ctx, cancel := callersContext(calls)
+	defer cancel()
+	if err := c.vk.execute(ctx, items); err != nil {
+		for _, item := range items {
+			item.Err = err
+		}
+	}
+	for _, call := range calls {
+		close(call.done)
+	}
+}
+
+// callersContext returns the context of the execute request: it has the
+// values of the first call's context and is canceled once every caller
+// has given up.
+func callersContext(calls []*coalescedCall) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(detachedContext{calls[0].ctx})
+	go func() {
+		for _, call := range calls {
+			select {
+			case <-call.ctx.Done():
+			case <-ctx.Done():
+				return
+			}
+		}
+		cancel()
+	}()
+	return ctx, cancel
+}
+
+// detachedContext keeps the values of a context without its cancellation.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
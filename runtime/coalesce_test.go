@@ -0,0 +1,114 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// executeServer is a fake API running execute requests: calls of the
+// methods in failures fail inside execute with the code, calls sent alone
+// succeed.
+func executeServer(failures map[string]ErrorCode, alone *[]string) http.HandlerFunc {
+	var mu sync.Mutex
+	return func(w http.ResponseWriter, r *http.Request) {
+		method := r.URL.Path[len("/method/"):]
+		if method != "execute" {
+			mu.Lock()
+			*alone = append(*alone, method)
+			mu.Unlock()
+			apiResponse(w, method)
+			return
+		}
+
+		var results []interface{}
+		var errs []ExecuteError
+		for _, m := range executeCallRe.FindAllStringSubmatch(r.FormValue("code"), -1) {
+			if code, ok := failures[m[1]]; ok {
+				results = append(results, false)
+				errs = append(errs, ExecuteError{Method: m[1], Code: code, Message: "failure"})
+				continue
+			}
+			results = append(results, m[1])
+		}
+		writeJSON(w, map[string]interface{}{"response": results, "execute_errors": errs})
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	var alone []string
+	vk := newTestVK(t, executeServer(map[string]ErrorCode{
+		"friends.get": ErrServer,
+		"wall.get":    ErrAccessDenied,
+	}, &alone))
+	vk.Retry.Clock = newFakeClock()
+	vk.Coalesce(time.Hour)
+
+	methods := []string{"users.get", "friends.get", "wall.get"}
+	results := make([]string, len(methods))
+	errs := make([]error, len(methods))
+	var wg sync.WaitGroup
+	for i := range methods {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = vk.RequestUnmarshalContext(context.Background(), methods[i], Params{"i": i}, &results[i])
+		}(i)
+	}
+	// wait for the calls to queue and flush them
+	for {
+		vk.coalescer.mu.Lock()
+		n := len(vk.coalescer.pending)
+		vk.coalescer.mu.Unlock()
+		if n == len(methods) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	vk.coalescer.mu.Lock()
+	vk.coalescer.timer.Stop()
+	calls := vk.coalescer.take()
+	vk.coalescer.mu.Unlock()
+	vk.coalescer.send(calls)
+	wg.Wait()
+
+	if errs[0] != nil || results[0] != "users.get" {
+		t.Errorf("users.get = %q, %v", results[0], errs[0])
+	}
+	// the server error is retried with a call of its own
+	if errs[1] != nil || results[1] != "friends.get" || len(alone) != 1 || alone[0] != "friends.get" {
+		t.Errorf("friends.get = %q, %v, sent alone %v", results[1], errs[1], alone)
+	}
+	var vkErr *Error
+	var execErr *ExecuteError
+	if !errors.As(errs[2], &vkErr) || vkErr.Code != ErrAccessDenied || !errors.As(errs[2], &execErr) || execErr.Method != "wall.get" {
+		t.Errorf("wall.get error = %v", errs[2])
+	}
+}
+
+func TestCallersContext(t *testing.T) {
+	type key struct{}
+	first, cancelFirst := context.WithCancel(context.WithValue(context.Background(), key{}, "first"))
+	second, cancelSecond := context.WithCancel(context.Background())
+	ctx, cancel := callersContext([]*coalescedCall{{ctx: first}, {ctx: second}})
+	defer cancel()
+
+	if ctx.Value(key{}) != "first" {
+		t.Error("values of the first call are lost")
+	}
+	cancelFirst()
+	select {
+	case <-ctx.Done():
+		t.Fatal("canceled while a caller waits")
+	case <-time.After(10 * time.Millisecond):
+	}
+	cancelSecond()
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("not canceled once every caller gave up")
+	}
+}
@@ -0,0 +1,67 @@
+package runtime
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ErrorCode is a VK API error code.
+type ErrorCode int
+
+// VK API error codes the runtime handles.
+const (
+	ErrUnknown        ErrorCode = 1
+	ErrTooMany        ErrorCode = 6
+	ErrFlood          ErrorCode = 9
+	ErrServer         ErrorCode = 10
+	ErrCaptcha        ErrorCode = 14
+	ErrAccessDenied   ErrorCode = 15
+	ErrAuthValidation ErrorCode = 17
+)
+
+// RequestParam is a parameter of the failed request echoed by VK.
+type RequestParam struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Error is a VK API error.
+type Error struct {
+	Code          ErrorCode      `json:"error_code"`
+	Message       string         `json:"error_msg"`
+	Text          string         `json:"error_text"`
+	CaptchaSID    string         `json:"captcha_sid"`
+	CaptchaImg    string         `json:"captcha_img"`
+	RedirectURI   string         `json:"redirect_uri"`
+	RequestParams []RequestParam `json:"request_params"`
+}
+
+func (e *Error) Error() string {
+	return "vk: " + strconv.Itoa(int(e.Code)) + " " + e.Message
+}
+
+// ExecuteError is an error of a single call made by the execute method.
+// It unwraps to the *Error of the same code.
+type ExecuteError struct {
+	Method  string    `json:"method"`
+	Code    ErrorCode `json:"error_code"`
+	Message string    `json:"error_msg"`
+}
+
+func (e *ExecuteError) Error() string {
+	return "vk: " + e.Method + ": " + strconv.Itoa(int(e.Code)) + " " + e.Message
+}
+
+func (e *ExecuteError) Unwrap() error {
+	return &Error{Code: e.Code, Message: e.Message}
+}
+
+// ErrorCodeOf returns the VK API error code of err, or 0 when err is not
+// a VK API error.
+func ErrorCodeOf(err error) ErrorCode {
+	var vkErr *Error
+	if errors.As(err, &vkErr) {
+		return vkErr.Code
+	}
+	return 0
+}
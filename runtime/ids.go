@@ -0,0 +1,79 @@
+package runtime
+
+// ChatPeerOffset is added to a chat ID to get its peer ID.
+const ChatPeerOffset = 2000000000
+
+// OwnerID identifies the owner of an object: a user when positive and a
+// community when negative.
+type OwnerID int64
+
+// GroupOwner returns the owner ID of the community.
+func GroupOwner(groupID int64) OwnerID {
+	return OwnerID(-groupID)
+}
+
+// IsGroup reports whether the owner is a community.
+func (id OwnerID) IsGroup() bool {
+	return id < 0
+}
+
+// IsUser reports whether the owner is a user.
+func (id OwnerID) IsUser() bool {
+	return id > 0
+}
+
+// GroupID returns the community ID, or 0 if the owner is not a community.
+func (id OwnerID) GroupID() int64 {
+	if !id.IsGroup() {
+		return 0
+	}
+	return int64(-id)
+}
+
+// UserID returns the user ID, or 0 if the owner is not a user.
+func (id OwnerID) UserID() int64 {
+	if !id.IsUser() {
+		return 0
+	}
+	return int64(id)
+}
+
+// PeerID identifies a conversation: a user, a community (negative) or a
+// chat (ChatPeerOffset plus the chat ID).
+type PeerID int64
+
+// ChatPeer returns the peer ID of the chat.
+func ChatPeer(chatID int64) PeerID {
+	return PeerID(ChatPeerOffset + chatID)
+}
+
+// IsChat reports whether the peer is a chat.
+func (id PeerID) IsChat() bool {
+	return id > ChatPeerOffset
+}
+
+// IsGroup reports whether the peer is a community.
+func (id PeerID) IsGroup() bool {
+	return id < 0
+}
+
+// IsUser reports whether the peer is a user.
+func (id PeerID) IsUser() bool {
+	return id > 0 && !id.IsChat()
+}
+
+// ChatID returns the chat ID, or 0 if the peer is not a chat.
+func (id PeerID) ChatID() int64 {
+	if !id.IsChat() {
+		return 0
+	}
+	return int64(id - ChatPeerOffset)
+}
+
+// GroupID returns the community ID, or 0 if the peer is not a community.
+func (id PeerID) GroupID() int64 {
+	if !id.IsGroup() {
+		return 0
+	}
+	return int64(-id)
+}
@@ -0,0 +1,51 @@
+package runtime
+
+import "testing"
+
+func TestOwnerID(t *testing.T) {
+	tests := []struct {
+		id      OwnerID
+		group   bool
+		user    bool
+		groupID int64
+		userID  int64
+	}{
+		{GroupOwner(1), true, false, 1, 0},
+		{-1, true, false, 1, 0},
+		{1, false, true, 0, 1},
+		{0, false, false, 0, 0},
+		{GroupOwner(ChatPeerOffset + 5), true, false, ChatPeerOffset + 5, 0},
+	}
+	for _, tt := range tests {
+		if tt.id.IsGroup() != tt.group || tt.id.IsUser() != tt.user || tt.id.GroupID() != tt.groupID || tt.id.UserID() != tt.userID {
+			t.Errorf("OwnerID(%d): group %t %d, user %t %d", tt.id, tt.id.IsGroup(), tt.id.GroupID(), tt.id.IsUser(), tt.id.UserID())
+		}
+	}
+}
+
+func TestPeerID(t *testing.T) {
+	tests := []struct {
+		id      PeerID
+		chat    bool
+		group   bool
+		user    bool
+		chatID  int64
+		groupID int64
+	}{
+		{ChatPeer(1), true, false, false, 1, 0},
+		{2000000001, true, false, false, 1, 0},
+		{ChatPeer(0), false, false, true, 0, 0}, // the offset itself is no chat
+		{ChatPeerOffset - 1, false, false, true, 0, 0},
+		{1, false, false, true, 0, 0},
+		{-1, false, true, false, 0, 1},
+		{-ChatPeerOffset - 1, false, true, false, 0, ChatPeerOffset + 1},
+		{0, false, false, false, 0, 0},
+	}
+	for _, tt := range tests {
+		if tt.id.IsChat() != tt.chat || tt.id.IsGroup() != tt.group || tt.id.IsUser() != tt.user ||
+			tt.id.ChatID() != tt.chatID || tt.id.GroupID() != tt.groupID {
+			t.Errorf("PeerID(%d): chat %t %d, group %t %d, user %t",
+				tt.id, tt.id.IsChat(), tt.id.ChatID(), tt.id.IsGroup(), tt.id.GroupID(), tt.id.IsUser())
+		}
+	}
+}
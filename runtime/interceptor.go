@@ -0,0 +1,186 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Invocation describes a call passing through the interceptors.
+type Invocation struct {
+	// Method is the API method, or UploadMethod for uploads.
+	Method string
+	// Request is the typed request, nil for calls made with raw Params.
+	Request Call
+	Params  Params
+	// AccessTokenType lists the token types the method accepts: user,
+	// group, service or open.
+	AccessTokenType []string
+}
+
+// Invoker makes the call and returns the raw response.
+type Invoker func(ctx context.Context, inv *Invocation) (json.RawMessage, error)
+
+// Interceptor wraps an invoker. Interceptors see the call before passing
+// it to next and the response and error after.
+type Interceptor func(next Invoker) Invoker
+
+// accessTyped is implemented by the generated requests.
+type accessTyped interface {
+	AccessTokenType() []string
+}
+
+// Chain combines interceptors, the first one being the outermost.
+func Chain(interceptors ...Interceptor) Interceptor {
+	return func(next Invoker) Invoker {
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			next = interceptors[i](next)
+		}
+		return next
+	}
+}
+
+// CallUnmarshal makes the typed call with params, usually req.Params(),
+// and decodes the response into obj.
+func (vk *VK) CallUnmarshal(ctx context.Context, req Call, params Params, obj interface{}) error {
+	inv := &Invocation{Method: req.Method(), Request: req, Params: params}
+	if typed, ok := req.(accessTyped); ok {
+		inv.AccessTokenType = typed.AccessTokenType()
+	}
+	return vk.invokeUnmarshal(ctx, inv, obj)
+}
+
+// invoke runs the call through the interceptors.
+func (vk *VK) invoke(ctx context.Context, inv *Invocation) (json.RawMessage, error) {
+	return vk.intercept(vk.send)(ctx, inv)
+}
+
+// intercept wraps the invoker into the interceptors.
+func (vk *VK) intercept(invoker Invoker) Invoker {
+	return Chain(vk.Interceptors...)(invoker)
+}
+
+// send is the innermost invoker. A coalesced call failing with an error
+// the client retries or answers is sent again alone.
+func (vk *VK) send(ctx context.Context, inv *Invocation) (json.RawMessage, error) {
+	if vk.coalescer != nil && coalescable(inv.Method, inv.Params) {
+		result, err := vk.coalescer.do(ctx, inv.Method, inv.Params)
+		if !vk.recoverable(err) {
+			return result, err
+		}
+	}
+	response, err := vk.request(ctx, inv.Method, inv.Params)
+	return response.Response, err
+}
+
+// recoverable reports whether err is the error of a call made by execute
+// that RequestContext would retry or answer the challenge of.
+func (vk *VK) recoverable(err error) bool {
+	var execErr *ExecuteError
+	if !errors.As(err, &execErr) {
+		return false
+	}
+	switch execErr.Code {
+	case ErrCaptcha:
+		return vk.CaptchaSolver != nil
+	case ErrAuthValidation:
+		return vk.ValidationHandler != nil
+	}
+	if vk.Retry == nil {
+		return false
+	}
+	_, ok := vk.Retry.delay(0, err)
+	return ok
+}
+
+// LogEntry is what the Logging interceptor reports of a call.
+type LogEntry struct {
+	Method   string
+	Params   Params // with secrets redacted
+	Response json.RawMessage
+	Err      error
+	Duration time.Duration
+}
+
+// Logging reports every call to log. The params are redacted.
+func Logging(log func(ctx context.Context, entry LogEntry)) Interceptor {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, inv *Invocation) (json.RawMessage, error) {
+			start := time.Now()
+			response, err := next(ctx, inv)
+			log(ctx, LogEntry{
+				Method:   inv.Method,
+				Params:   Redact(inv.Params),
+				Response: response,
+				Err:      err,
+				Duration: time.Since(start),
+			})
+			return response, err
+		}
+	}
+}
+
+// Metrics reports the method, duration and VK API error code (0 on
+// success, -1 for other errors) of every call to observe.
+func Metrics(observe func(method string, d time.Duration, code ErrorCode)) Interceptor {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, inv *Invocation) (json.RawMessage, error) {
+			start := time.Now()
+			response, err := next(ctx, inv)
+			code := ErrorCodeOf(err)
+			if err != nil && code == 0 {
+				code = -1
+			}
+			observe(inv.Method, time.Since(start), code)
+			return response, err
+		}
+	}
+}
+
+// Tracer starts the spans of the Tracing interceptor. Adapt the tracer in
+// use, such as the OpenTelemetry one, to it.
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	SetAttribute(key string, value interface{})
+	End(err error)
+}
+
+// Tracing wraps every call into a span named "vk " and the method, with
+// the vk.method attribute and, for VK API errors, vk.error_code. The call
+// gets the context of the span.
+func Tracing(tracer Tracer) Interceptor {
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, inv *Invocation) (json.RawMessage, error) {
+			ctx, span := tracer.Start(ctx, "vk "+inv.Method)
+			span.SetAttribute("vk.method", inv.Method)
+			response, err := next(ctx, inv)
+			if code := ErrorCodeOf(err); code != 0 {
+				span.SetAttribute("vk.error_code", int(code))
+			}
+			span.End(err)
+			return response, err
+		}
+	}
+}
+
+// SecretParams are the parameters Redact masks.
+var SecretParams = []string{"access_token", "client_secret", "password"}
+
+// Redact returns a copy of params with SecretParams masked.
+func Redact(params Params) Params {
+	redacted := make(Params, len(params))
+	for key, value := range params {
+		redacted[key] = value
+	}
+	for _, key := range SecretParams {
+		if _, ok := redacted[key]; ok {
+			redacted[key] = "***"
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,183 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordCalls is an interceptor recording the methods of the calls.
+func recordCalls(methods *[]string) Interceptor {
+	var mu sync.Mutex
+	return func(next Invoker) Invoker {
+		return func(ctx context.Context, inv *Invocation) (json.RawMessage, error) {
+			mu.Lock()
+			*methods = append(*methods, inv.Method)
+			mu.Unlock()
+			return next(ctx, inv)
+		}
+	}
+}
+
+func TestInterceptedCalls(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/method/", executeServer(nil, new([]string)))
+	mux.Handle("/upload", uploadHandler(t, "file", `{"file":"f"}`))
+
+	tests := []struct {
+		name    string
+		call    func(vk *VK) error
+		methods []string
+	}{
+		{"request", func(vk *VK) error {
+			_, err := vk.Request("users.get", nil)
+			return err
+		}, []string{"users.get"}},
+		{"request unmarshal", func(vk *VK) error {
+			var s string
+			return vk.RequestUnmarshal("users.get", nil, &s)
+		}, []string{"users.get"}},
+		{"upload", func(vk *VK) error {
+			_, err := vk.Upload(context.Background(), strings.TrimSuffix(vk.MethodURL, "method/")+"upload", "file", bytes.NewReader(pngHeader))
+			return err
+		}, []string{UploadMethod}},
+		{"batch", func(vk *VK) error {
+			b := vk.NewBatch()
+			b.Add(testCall("users.get"), nil)
+			b.Add(testCall("friends.get"), nil)
+			return b.Send(context.Background())
+		}, []string{"execute"}},
+		{"coalesced", func(vk *VK) error {
+			vk.Coalesce(time.Millisecond)
+			var s string
+			return vk.RequestUnmarshal("users.get", nil, &s)
+		}, []string{"users.get", "execute"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vk := newTestVK(t, mux)
+			var methods []string
+			vk.Interceptors = []Interceptor{recordCalls(&methods)}
+			if err := tt.call(vk); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(methods, tt.methods) {
+				t.Errorf("intercepted %v, want %v", methods, tt.methods)
+			}
+		})
+	}
+}
+
+type testCall string
+
+func (c testCall) Method() string {
+	return string(c)
+}
+
+func (c testCall) Params() Params {
+	return Params{}
+}
+
+func TestInterceptorAnswers(t *testing.T) {
+	vk := newTestVK(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("call sent")
+	}))
+	vk.Interceptors = []Interceptor{func(next Invoker) Invoker {
+		return func(ctx context.Context, inv *Invocation) (json.RawMessage, error) {
+			return json.RawMessage(`[1]`), nil
+		}
+	}}
+	response, err := vk.Request("users.get", nil)
+	if err != nil || string(response.Response) != `[1]` {
+		t.Errorf("Request() = %s, %v", response.Response, err)
+	}
+}
+
+type testSpan struct {
+	name  string
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+func (s *testSpan) SetAttribute(key string, value interface{}) {
+	s.attrs[key] = value
+}
+
+func (s *testSpan) End(err error) {
+	s.err, s.ended = err, true
+}
+
+type testTracer struct {
+	spans []*testSpan
+}
+
+type spanKey struct{}
+
+func (tr *testTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	span := &testSpan{name: name, attrs: map[string]interface{}{}}
+	tr.spans = append(tr.spans, span)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func TestTracing(t *testing.T) {
+	vk := newTestVK(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "wall.get") {
+			apiError(w, ErrAccessDenied, "Access denied")
+			return
+		}
+		apiResponse(w, 1)
+	}))
+	tracer := &testTracer{}
+	var inner interface{}
+	vk.Interceptors = []Interceptor{Tracing(tracer), func(next Invoker) Invoker {
+		return func(ctx context.Context, inv *Invocation) (json.RawMessage, error) {
+			inner = ctx.Value(spanKey{})
+			return next(ctx, inv)
+		}
+	}}
+
+	if _, err := vk.Request("users.get", nil); err != nil {
+		t.Fatal(err)
+	}
+	if inner != tracer.spans[0] {
+		t.Error("the call did not get the span context")
+	}
+	if _, err := vk.Request("wall.get", nil); err == nil {
+		t.Fatal("wall.get did not fail")
+	}
+
+	ok, failed := tracer.spans[0], tracer.spans[1]
+	if ok.name != "vk users.get" || ok.attrs["vk.method"] != "users.get" || !ok.ended || ok.err != nil {
+		t.Errorf("users.get span = %+v", ok)
+	}
+	if failed.attrs["vk.error_code"] != int(ErrAccessDenied) || failed.err == nil {
+		t.Errorf("wall.get span = %+v", failed)
+	}
+}
+
+func TestLoggingRedacts(t *testing.T) {
+	vk := newTestVK(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiResponse(w, 1)
+	}))
+	var entries []LogEntry
+	vk.Interceptors = []Interceptor{Logging(func(ctx context.Context, entry LogEntry) {
+		entries = append(entries, entry)
+	})}
+	params := Params{"access_token": "secret", "user_ids": 1}
+	if _, err := vk.Request("users.get", params); err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Params["access_token"] != "***" || entries[0].Params["user_ids"] != 1 || string(entries[0].Response) != "1" {
+		t.Errorf("entries = %+v", entries)
+	}
+	if params["access_token"] != "secret" {
+		t.Error("Redact changed the call params")
+	}
+}
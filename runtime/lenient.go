@@ -0,0 +1,134 @@
+package runtime
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FlexInt is an integer VK sometimes sends as a string or a boolean.
+type FlexInt int64
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *FlexInt) UnmarshalJSON(data []byte) error {
+	n, err := ParseFlexInt(data)
+	if err != nil {
+		return err
+	}
+	*v = FlexInt(n)
+	return nil
+}
+
+// FlexBool is a boolean VK sometimes sends as 0/1 or as a string.
+type FlexBool bool
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *FlexBool) UnmarshalJSON(data []byte) error {
+	b, err := ParseFlexBool(data)
+	if err != nil {
+		return err
+	}
+	*v = FlexBool(b)
+	return nil
+}
+
+// ParseFlexInt parses a JSON integer that may also arrive as a string
+// ("1"), a boolean or a number with zero fraction. null and "" are 0.
+func ParseFlexInt(data []byte) (int64, error) {
+	s := string(bytes.TrimSpace(data))
+	switch s {
+	case "null", `""`, "false":
+		return 0, nil
+	case "true":
+		return 1, nil
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return 0, err
+		}
+		s = strings.TrimSpace(unquoted)
+		if s == "" {
+			return 0, nil
+		}
+	}
+
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f != math.Trunc(f) || math.Abs(f) > 1<<63 {
+		return 0, errors.New("json: cannot decode " + string(data) + " as integer")
+	}
+	return int64(f), nil
+}
+
+// ParseFlexBool parses a JSON boolean that may also arrive as a number or
+// a string. null is false.
+func ParseFlexBool(data []byte) (bool, error) {
+	switch s := string(bytes.TrimSpace(data)); s {
+	case "true", `"true"`:
+		return true, nil
+	case "false", `"false"`, "null", `""`:
+		return false, nil
+	}
+	n, err := ParseFlexInt(data)
+	if err != nil {
+		return false, errors.New("json: cannot decode " + string(data) + " as boolean")
+	}
+	return n != 0, nil
+}
+
+// IsEmptyArray reports whether data is an empty JSON array, which VK sends
+// in place of empty objects.
+func IsEmptyArray(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	if len(data) < 2 || data[0] != '[' || data[len(data)-1] != ']' {
+		return false
+	}
+	return len(bytes.TrimSpace(data[1:len(data)-1])) == 0
+}
+
+// FlexInt64 reads an integer the way ParseFlexInt does.
+func (l *Lexer) FlexInt64() int64 {
+	raw := l.Raw()
+	if l.err != nil {
+		return 0
+	}
+	n, err := ParseFlexInt(raw)
+	if err != nil {
+		l.err = err
+	}
+	return n
+}
+
+// FlexBool reads a boolean the way ParseFlexBool does.
+func (l *Lexer) FlexBool() bool {
+	raw := l.Raw()
+	if l.err != nil {
+		return false
+	}
+	b, err := ParseFlexBool(raw)
+	if err != nil {
+		l.err = err
+	}
+	return b
+}
+
+// EmptyArray consumes an empty array sent in place of an object and
+// reports whether it was there.
+func (l *Lexer) EmptyArray() bool {
+	if l.err != nil || l.peek() != '[' {
+		return false
+	}
+	pos := l.pos
+	l.pos++
+	if l.peek() == ']' {
+		l.pos++
+		return true
+	}
+	l.pos = pos
+	return false
+}
@@ -0,0 +1,158 @@
+package runtime
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// flexInput is a shape VK sends for integers and booleans, with the
+// values it decodes to.
+type flexInput struct {
+	data string
+	n    int64
+	b    bool
+	err  bool
+}
+
+var flexInputs = []flexInput{
+	{`true`, 1, true, false},
+	{`false`, 0, false, false},
+	{`1`, 1, true, false},
+	{`0`, 0, false, false},
+	{`-7`, -7, true, false},
+	{`"1"`, 1, true, false},
+	{`" 42 "`, 42, true, false},
+	{`1.0`, 1, true, false},
+	{`1e3`, 1000, true, false},
+	{`""`, 0, false, false},
+	{`null`, 0, false, false},
+	{`1.5`, 0, false, true},
+	{`"abc"`, 0, false, true},
+	{`{}`, 0, false, true},
+}
+
+func TestParseFlexInt(t *testing.T) {
+	for _, tt := range flexInputs {
+		n, err := ParseFlexInt([]byte(tt.data))
+		if n != tt.n || (err != nil) != tt.err {
+			t.Errorf("ParseFlexInt(%s) = %d, %v, want %d", tt.data, n, err, tt.n)
+		}
+
+		// encoding/json path of the generated structs
+		var v struct {
+			N FlexInt `json:"n"`
+		}
+		err = json.Unmarshal([]byte(`{"n":`+tt.data+`}`), &v)
+		if int64(v.N) != tt.n || (err != nil) != tt.err {
+			t.Errorf("json FlexInt %s = %d, %v, want %d", tt.data, v.N, err, tt.n)
+		}
+
+		// fast JSON path, followed by another value to check the lexer
+		// moved past the input
+		l := Lexer{Data: []byte(`[` + tt.data + `,5]`)}
+		l.Delim('[')
+		n = l.FlexInt64()
+		if tt.err {
+			if l.Error() == nil {
+				t.Errorf("Lexer.FlexInt64(%s) = %d, want error", tt.data, n)
+			}
+			continue
+		}
+		l.WantComma()
+		if next := l.Int64(); n != tt.n || next != 5 || l.Error() != nil {
+			t.Errorf("Lexer.FlexInt64(%s) = %d, %v then %d, want %d", tt.data, n, l.Error(), next, tt.n)
+		}
+	}
+}
+
+func TestParseFlexBool(t *testing.T) {
+	tests := append([]flexInput{
+		{`"true"`, 0, true, false},
+		{`"false"`, 0, false, false},
+	}, flexInputs...)
+	for _, tt := range tests {
+		b, err := ParseFlexBool([]byte(tt.data))
+		if b != tt.b || (err != nil) != tt.err {
+			t.Errorf("ParseFlexBool(%s) = %t, %v, want %t", tt.data, b, err, tt.b)
+		}
+
+		var v struct {
+			B FlexBool `json:"b"`
+		}
+		err = json.Unmarshal([]byte(`{"b":`+tt.data+`}`), &v)
+		if bool(v.B) != tt.b || (err != nil) != tt.err {
+			t.Errorf("json FlexBool %s = %t, %v, want %t", tt.data, v.B, err, tt.b)
+		}
+
+		l := Lexer{Data: []byte(tt.data)}
+		b = l.FlexBool()
+		l.Consumed()
+		if b != tt.b || (l.Error() != nil) != tt.err {
+			t.Errorf("Lexer.FlexBool(%s) = %t, %v, want %t", tt.data, b, l.Error(), tt.b)
+		}
+	}
+}
+
+// lenientCity decodes the way the lenient emitter writes it for a struct
+// without required fields.
+type lenientCity struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+func (v *lenientCity) UnmarshalJSON(data []byte) error {
+	if IsEmptyArray(data) {
+		return nil
+	}
+	type plain lenientCity
+	aux := struct {
+		*plain
+		ID FlexInt `json:"id"`
+	}{
+		plain: (*plain)(v),
+		ID:    FlexInt(v.ID),
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	v.ID = int64(aux.ID)
+	return nil
+}
+
+func TestEmptyArray(t *testing.T) {
+	tests := []struct {
+		data  string
+		empty bool
+		city  lenientCity
+	}{
+		{`[]`, true, lenientCity{}},
+		{` [ ] `, true, lenientCity{}},
+		{`{}`, false, lenientCity{}},
+		{`{"id":"3","title":"Omsk"}`, false, lenientCity{ID: 3, Title: "Omsk"}},
+		{`[1]`, false, lenientCity{}},
+	}
+	for _, tt := range tests {
+		if got := IsEmptyArray([]byte(tt.data)); got != tt.empty {
+			t.Errorf("IsEmptyArray(%s) = %t, want %t", tt.data, got, tt.empty)
+		}
+
+		// encoding/json path
+		var city lenientCity
+		err := json.Unmarshal([]byte(tt.data), &city)
+		if wantErr := tt.data == `[1]`; city != tt.city || (err != nil) != wantErr {
+			t.Errorf("json city %s = %+v, %v, want %+v", tt.data, city, err, tt.city)
+		}
+
+		// fast JSON path: EmptyArray consumes [] and leaves anything else
+		l := Lexer{Data: []byte(tt.data)}
+		if got := l.EmptyArray(); got != tt.empty {
+			t.Errorf("Lexer.EmptyArray(%s) = %t, want %t", tt.data, got, tt.empty)
+		}
+		if !tt.empty {
+			l.Skip()
+		}
+		if l.Consumed(); l.Error() != nil {
+			t.Errorf("Lexer.EmptyArray(%s): %v", tt.data, l.Error())
+		}
+	}
+}
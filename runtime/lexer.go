@@ -0,0 +1,422 @@
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Lexer reads JSON tokens for the generated UnmarshalJSON methods. The
+// first error stops the lexer: later reads return zero values and delimiter
+// checks report true, so generated loops terminate.
+type Lexer struct {
+	Data []byte
+
+	pos      int
+	err      error
+	interned [internSize]string
+}
+
+// Error returns the first error met by the lexer.
+func (l *Lexer) Error() error {
+	return l.err
+}
+
+func (l *Lexer) fail(msg string) {
+	if l.err == nil {
+		l.err = errors.New("json: offset " + strconv.Itoa(l.pos) + ": " + msg)
+	}
+}
+
+func (l *Lexer) skipSpace() {
+	for l.pos < len(l.Data) {
+		switch l.Data[l.pos] {
+		case ' ', '\t', '\n', '\r':
+			l.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (l *Lexer) peek() byte {
+	l.skipSpace()
+	if l.pos >= len(l.Data) {
+		return 0
+	}
+	return l.Data[l.pos]
+}
+
+// IsNull consumes null and reports whether it was there.
+func (l *Lexer) IsNull() bool {
+	if l.err != nil {
+		return true
+	}
+	if l.peek() == 'n' && l.literal("null") {
+		return true
+	}
+	return false
+}
+
+func (l *Lexer) literal(lit string) bool {
+	if len(l.Data)-l.pos < len(lit) || string(l.Data[l.pos:l.pos+len(lit)]) != lit {
+		l.fail("invalid literal")
+		return false
+	}
+	l.pos += len(lit)
+	return true
+}
+
+// Delim consumes the delimiter.
+func (l *Lexer) Delim(c byte) {
+	if l.err != nil {
+		return
+	}
+	if l.peek() != c {
+		l.fail("expected '" + string(c) + "'")
+		return
+	}
+	l.pos++
+}
+
+// IsDelim reports whether the next token is the delimiter without
+// consuming it.
+func (l *Lexer) IsDelim(c byte) bool {
+	if l.err != nil {
+		return true
+	}
+	next := l.peek()
+	if next == 0 {
+		l.fail("unexpected end of input")
+		return true
+	}
+	return next == c
+}
+
+// WantComma consumes the comma between elements, if any.
+func (l *Lexer) WantComma() {
+	if l.err == nil && l.peek() == ',' {
+		l.pos++
+	}
+}
+
+// Key reads an object key together with the following colon. The result
+// is only valid until the next read.
+func (l *Lexer) Key() []byte {
+	key := l.stringBytes()
+	l.Delim(':')
+	return key
+}
+
+// String reads a string.
+func (l *Lexer) String() string {
+	if l.IsNull() {
+		return ""
+	}
+	return l.intern(l.stringBytes())
+}
+
+// VK repeats short values such as types, sizes and platforms many times in
+// a response, so the lexer shares the strings up to maxInterned bytes long
+// through a table of internSize slots.
+const (
+	maxInterned = 32
+	internSize  = 32
+)
+
+// intern returns the string of b, sharing the short ones read before.
+func (l *Lexer) intern(b []byte) string {
+	if len(b) == 0 || len(b) > maxInterned {
+		return string(b)
+	}
+	slot := &l.interned[(len(b)*31+int(b[0])*7+int(b[len(b)-1]))%internSize]
+	if *slot != string(b) {
+		*slot = string(b)
+	}
+	return *slot
+}
+
+func (l *Lexer) stringBytes() []byte {
+	if l.err != nil {
+		return nil
+	}
+	if l.peek() != '"' {
+		l.fail("expected string")
+		return nil
+	}
+	l.pos++
+
+	start := l.pos
+	for l.pos < len(l.Data) {
+		switch c := l.Data[l.pos]; {
+		case c == '"':
+			s := l.Data[start:l.pos]
+			l.pos++
+			return s
+		case c == '\\':
+			return l.unescape(start)
+		case c < 0x20:
+			l.fail("control character in string")
+			return nil
+		default:
+			l.pos++
+		}
+	}
+	l.fail("unterminated string")
+	return nil
+}
+
+// unescape decodes the rest of a string containing escape sequences.
+func (l *Lexer) unescape(start int) []byte {
+	buf := make([]byte, 0, l.pos-start+16)
+	buf = append(buf, l.Data[start:l.pos]...)
+	for l.pos < len(l.Data) {
+		c := l.Data[l.pos]
+		switch {
+		case c == '"':
+			l.pos++
+			return buf
+		case c < 0x20:
+			l.fail("control character in string")
+			return nil
+		case c != '\\':
+			buf = append(buf, c)
+			l.pos++
+			continue
+		}
+
+		if l.pos+1 >= len(l.Data) {
+			break
+		}
+		l.pos += 2
+		switch l.Data[l.pos-1] {
+		case '"', '\\', '/':
+			buf = append(buf, l.Data[l.pos-1])
+		case 'b':
+			buf = append(buf, '\b')
+		case 'f':
+			buf = append(buf, '\f')
+		case 'n':
+			buf = append(buf, '\n')
+		case 'r':
+			buf = append(buf, '\r')
+		case 't':
+			buf = append(buf, '\t')
+		case 'u':
+			r := l.hex4()
+			if utf16.IsSurrogate(r) && l.pos+1 < len(l.Data) && l.Data[l.pos] == '\\' && l.Data[l.pos+1] == 'u' {
+				l.pos += 2
+				r = utf16.DecodeRune(r, l.hex4())
+			}
+			if l.err != nil {
+				return nil
+			}
+			buf = append(buf, string(r)...)
+		default:
+			l.fail("invalid escape")
+			return nil
+		}
+	}
+	l.fail("unterminated string")
+	return nil
+}
+
+func (l *Lexer) hex4() rune {
+	if len(l.Data)-l.pos < 4 {
+		l.fail("invalid unicode escape")
+		return utf8.RuneError
+	}
+	var r rune
+	for _, c := range l.Data[l.pos : l.pos+4] {
+		switch {
+		case '0' <= c && c <= '9':
+			c -= '0'
+		case 'a' <= c && c <= 'f':
+			c = c - 'a' + 10
+		case 'A' <= c && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			l.fail("invalid unicode escape")
+			return utf8.RuneError
+		}
+		r = r<<4 | rune(c)
+	}
+	l.pos += 4
+	return r
+}
+
+// number returns the bytes of the next number token.
+func (l *Lexer) number() []byte {
+	if l.err != nil {
+		return nil
+	}
+	l.skipSpace()
+	start := l.pos
+	for l.pos < len(l.Data) {
+		c := l.Data[l.pos]
+		if ('0' <= c && c <= '9') || c == '-' || c == '+' || c == '.' || c == 'e' || c == 'E' {
+			l.pos++
+			continue
+		}
+		break
+	}
+	if start == l.pos {
+		l.fail("expected number")
+		return nil
+	}
+	return l.Data[start:l.pos]
+}
+
+// Int64 reads an integer.
+func (l *Lexer) Int64() int64 {
+	if l.IsNull() {
+		return 0
+	}
+	tok := l.number()
+	if len(tok) == 0 {
+		return 0
+	}
+
+	neg := tok[0] == '-'
+	if neg {
+		tok = tok[1:]
+	}
+	if len(tok) == 0 {
+		l.fail("invalid integer")
+		return 0
+	}
+
+	var n uint64
+	for _, c := range tok {
+		if c < '0' || c > '9' {
+			l.fail("invalid integer")
+			return 0
+		}
+		if n > (1<<63)/10 {
+			l.fail("integer overflow")
+			return 0
+		}
+		n = n*10 + uint64(c-'0')
+		if n > 1<<63 {
+			l.fail("integer overflow")
+			return 0
+		}
+	}
+	if neg {
+		return -int64(n)
+	}
+	if n > 1<<63-1 {
+		l.fail("integer overflow")
+		return 0
+	}
+	return int64(n)
+}
+
+// Float64 reads a number.
+func (l *Lexer) Float64() float64 {
+	if l.IsNull() {
+		return 0
+	}
+	tok := l.number()
+	if len(tok) == 0 {
+		return 0
+	}
+	f, err := strconv.ParseFloat(string(tok), 64)
+	if err != nil {
+		l.fail("invalid number")
+		return 0
+	}
+	return f
+}
+
+// Bool reads a boolean.
+func (l *Lexer) Bool() bool {
+	if l.IsNull() {
+		return false
+	}
+	switch l.peek() {
+	case 't':
+		return l.literal("true")
+	case 'f':
+		l.literal("false")
+	default:
+		l.fail("expected boolean")
+	}
+	return false
+}
+
+// Raw returns the bytes of the next value. The result shares memory with
+// Data.
+func (l *Lexer) Raw() []byte {
+	if l.err != nil {
+		return nil
+	}
+	l.skipSpace()
+	start := l.pos
+	l.Skip()
+	if l.err != nil {
+		return nil
+	}
+	return l.Data[start:l.pos]
+}
+
+// Skip consumes the next value.
+func (l *Lexer) Skip() {
+	if l.err != nil {
+		return
+	}
+	switch c := l.peek(); c {
+	case '{', '[':
+		closing := byte('}')
+		if c == '[' {
+			closing = ']'
+		}
+		l.pos++
+		for !l.IsDelim(closing) {
+			if c == '{' {
+				l.Key()
+			}
+			l.Skip()
+			l.WantComma()
+		}
+		l.Delim(closing)
+	case '"':
+		l.stringBytes()
+	case 't':
+		l.literal("true")
+	case 'f':
+		l.literal("false")
+	case 'n':
+		l.literal("null")
+	default:
+		l.number()
+	}
+}
+
+// Unmarshal decodes the next value with its UnmarshalJSON method, or
+// encoding/json. Generated code falls back to it for types it has no
+// dedicated decoder for.
+func (l *Lexer) Unmarshal(v interface{}) {
+	raw := l.Raw()
+	if l.err != nil {
+		return
+	}
+	var err error
+	if u, ok := v.(json.Unmarshaler); ok {
+		err = u.UnmarshalJSON(raw)
+	} else {
+		err = json.Unmarshal(raw, v)
+	}
+	if err != nil {
+		l.err = err
+	}
+}
+
+// Consumed checks that nothing but whitespace is left.
+func (l *Lexer) Consumed() {
+	if l.err == nil && l.peek() != 0 {
+		l.fail("unexpected data after top-level value")
+	}
+}
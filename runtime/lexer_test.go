@@ -0,0 +1,73 @@
+package runtime
+
+import (
+	"testing"
+)
+
+func TestLexerString(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{`"plain"`, "plain"},
+		{`"a\"b\\c\/d"`, `a"b\c/d`},
+		{`"\b\f\n\r\t"`, "\b\f\n\r\t"},
+		{`"к😀"`, "к😀"},
+		{`null`, ""},
+	}
+	for _, tt := range tests {
+		l := Lexer{Data: []byte(tt.data)}
+		if got := l.String(); got != tt.want || l.Error() != nil {
+			t.Errorf("String(%s) = %q, %v, want %q", tt.data, got, l.Error(), tt.want)
+		}
+	}
+}
+
+func TestLexerIntern(t *testing.T) {
+	l := Lexer{Data: []byte(`["photo","photo","photo"]`)}
+	l.Delim('[')
+	first := l.String()
+	l.WantComma()
+	allocs := testing.AllocsPerRun(1, func() {
+		l.pos = len(`["photo",`)
+		_ = l.String()
+	})
+	if allocs != 0 {
+		t.Errorf("repeated string allocated %v times", allocs)
+	}
+	if first != "photo" {
+		t.Errorf("String() = %q", first)
+	}
+}
+
+func TestLexerInt64(t *testing.T) {
+	tests := []struct {
+		data string
+		want int64
+		err  bool
+	}{
+		{`0`, 0, false},
+		{`-42`, -42, false},
+		{`9223372036854775807`, 1<<63 - 1, false},
+		{`-9223372036854775808`, -1 << 63, false},
+		{`9223372036854775808`, 0, true},
+		{`1.5`, 0, true},
+		{`"1"`, 0, true},
+	}
+	for _, tt := range tests {
+		l := Lexer{Data: []byte(tt.data)}
+		got := l.Int64()
+		if got != tt.want || (l.Error() != nil) != tt.err {
+			t.Errorf("Int64(%s) = %d, %v", tt.data, got, l.Error())
+		}
+	}
+}
+
+func TestLexerSkip(t *testing.T) {
+	l := Lexer{Data: []byte(` {"a":[1,{"b":null}],"c":"d"} `)}
+	l.Skip()
+	l.Consumed()
+	if l.Error() != nil {
+		t.Error(l.Error())
+	}
+}
@@ -0,0 +1,293 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LongPollServer is a Long Poll server address and session.
+type LongPollServer struct {
+	Server string
+	Key    string
+	TS     string
+}
+
+// ServerFunc fetches a Long Poll server with the getLongPollServer method.
+type ServerFunc func(ctx context.Context) (LongPollServer, error)
+
+// Event is a Bots Long Poll or Callback API event.
+type Event struct {
+	Type    string          `json:"type"`
+	Object  json.RawMessage `json:"object"`
+	GroupID int64           `json:"group_id"`
+	EventID string          `json:"event_id"`
+	V       string          `json:"v"`
+}
+
+// EventHandler handles events. A returned error stops the poll loop.
+type EventHandler func(ctx context.Context, event Event) error
+
+// UserEvent is a User Long Poll update: the event code followed by its
+// fields.
+type UserEvent []json.RawMessage
+
+// Code returns the event code.
+func (e UserEvent) Code() int64 {
+	if len(e) == 0 {
+		return 0
+	}
+	code, _ := ParseFlexInt(e[0])
+	return code
+}
+
+// LongPoll is the poll loop shared by the Bots and User Long Poll clients.
+// It handles the key and ts, the failed 1/2/3 recovery codes and backs
+// off exponentially after network errors.
+type LongPoll struct {
+	Client     *http.Client
+	Wait       time.Duration // how long the server holds a request
+	Backoff    time.Duration // first delay after a failed request
+	MaxBackoff time.Duration
+	Query      url.Values // extra query parameters
+
+	getServer ServerFunc
+	server    LongPollServer
+	err       error
+}
+
+func newLongPoll(vk *VK, getServer ServerFunc) LongPoll {
+	return LongPoll{
+		Client:     vk.Client,
+		Wait:       25 * time.Second,
+		Backoff:    time.Second,
+		MaxBackoff: time.Minute,
+		Query:      url.Values{},
+		getServer:  getServer,
+	}
+}
+
+// Poll makes one request and returns the updates. It returns no updates
+// when the session had to be recovered.
+func (lp *LongPoll) Poll(ctx context.Context) ([]json.RawMessage, error) {
+	if lp.server.Key == "" {
+		if err := lp.refresh(ctx, false); err != nil {
+			return nil, err
+		}
+	}
+
+	query := url.Values{}
+	for key, values := range lp.Query {
+		query[key] = values
+	}
+	query.Set("act", "a_check")
+	query.Set("key", lp.server.Key)
+	query.Set("ts", lp.server.TS)
+	query.Set("wait", strconv.Itoa(int(lp.Wait/time.Second)))
+
+	server := lp.server.Server
+	if !strings.Contains(server, "://") {
+		server = "https://" + server
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := lp.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("long poll: %s", resp.Status)
+	}
+
+	var result struct {
+		TS      json.RawMessage   `json:"ts"`
+		Updates []json.RawMessage `json:"updates"`
+		Failed  int               `json:"failed"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("long poll: %w", err)
+	}
+
+	switch result.Failed {
+	case 0:
+		lp.server.TS = rawTS(result.TS)
+		return result.Updates, nil
+	case 1: // history is outdated, continue from the new ts
+		lp.server.TS = rawTS(result.TS)
+		return nil, nil
+	case 2: // key expired
+		return nil, lp.refresh(ctx, true)
+	case 3: // information lost, start over
+		return nil, lp.refresh(ctx, false)
+	}
+	return nil, fmt.Errorf("long poll: failed %d", result.Failed)
+}
+
+func (lp *LongPoll) refresh(ctx context.Context, keepTS bool) error {
+	server, err := lp.getServer(ctx)
+	if err != nil {
+		return err
+	}
+	if keepTS && lp.server.TS != "" {
+		server.TS = lp.server.TS
+	}
+	lp.server = server
+	return nil
+}
+
+// run polls until ctx is done, the handler fails or getLongPollServer
+// returns a VK API error.
+func (lp *LongPoll) run(ctx context.Context, handle func(json.RawMessage) error) error {
+	backoff := lp.Backoff
+	for {
+		updates, err := lp.Poll(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var vkErr *Error
+		if errors.As(err, &vkErr) {
+			return err
+		}
+		if err != nil {
+			if err := sleep(ctx, backoff); err != nil {
+				return err
+			}
+			if backoff *= 2; backoff > lp.MaxBackoff {
+				backoff = lp.MaxBackoff
+			}
+			continue
+		}
+		backoff = lp.Backoff
+
+		for _, update := range updates {
+			if err := handle(update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Err returns the error that stopped the loop started by Events. It is
+// valid once the events channel is closed.
+func (lp *LongPoll) Err() error {
+	return lp.err
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func rawTS(ts json.RawMessage) string {
+	return string(bytes.Trim(ts, `"`))
+}
+
+// BotsLongPoll receives community events.
+type BotsLongPoll struct {
+	LongPoll
+}
+
+// NewBotsLongPoll returns a Bots Long Poll client using getServer to fetch
+// groups.getLongPollServer.
+func NewBotsLongPoll(vk *VK, getServer ServerFunc) *BotsLongPoll {
+	return &BotsLongPoll{newLongPoll(vk, getServer)}
+}
+
+// Run passes the events to the handler until ctx is done or the handler
+// returns an error.
+func (lp *BotsLongPoll) Run(ctx context.Context, handler EventHandler) error {
+	return lp.run(ctx, func(update json.RawMessage) error {
+		var event Event
+		if err := json.Unmarshal(update, &event); err != nil {
+			return err
+		}
+		return handler(ctx, event)
+	})
+}
+
+// Events runs the poll loop in the background and sends the events to the
+// returned channel, closed once the loop stops. Err reports why it stopped.
+func (lp *BotsLongPoll) Events(ctx context.Context) <-chan Event {
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+		lp.err = lp.Run(ctx, func(ctx context.Context, event Event) error {
+			select {
+			case events <- event:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	return events
+}
+
+// UserLongPoll receives user events.
+type UserLongPoll struct {
+	LongPoll
+}
+
+// User Long Poll modes, combined into the mode query parameter.
+const (
+	ModeAttachments = 2
+	ModeExtended    = 8
+	ModePts         = 32
+	ModeExtra       = 64
+	ModeRandomID    = 128
+)
+
+// NewUserLongPoll returns a User Long Poll client using getServer to fetch
+// messages.getLongPollServer.
+func NewUserLongPoll(vk *VK, getServer ServerFunc) *UserLongPoll {
+	lp := &UserLongPoll{newLongPoll(vk, getServer)}
+	lp.Query.Set("mode", strconv.Itoa(ModeAttachments|ModeExtended|ModeExtra|ModeRandomID))
+	lp.Query.Set("version", "3")
+	return lp
+}
+
+// Run passes the events to the handler until ctx is done or the handler
+// returns an error.
+func (lp *UserLongPoll) Run(ctx context.Context, handler func(ctx context.Context, event UserEvent) error) error {
+	return lp.run(ctx, func(update json.RawMessage) error {
+		var event UserEvent
+		if err := json.Unmarshal(update, &event); err != nil {
+			return err
+		}
+		return handler(ctx, event)
+	})
+}
+
+// Events runs the poll loop in the background and sends the events to the
+// returned channel, closed once the loop stops. Err reports why it stopped.
+func (lp *UserLongPoll) Events(ctx context.Context) <-chan UserEvent {
+	events := make(chan UserEvent)
+	go func() {
+		defer close(events)
+		lp.err = lp.Run(ctx, func(ctx context.Context, event UserEvent) error {
+			select {
+			case events <- event:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+	return events
+}
@@ -0,0 +1,152 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// pollServer is a local Long Poll stand-in answering the a_check requests
+// with the scripted responses in turn.
+type pollServer struct {
+	t         *testing.T
+	srv       *httptest.Server
+	mu        sync.Mutex
+	responses []string
+	requests  []string // key and ts of the requests
+	refreshes int
+}
+
+func newPollServer(t *testing.T, responses ...string) *pollServer {
+	s := &pollServer{t: t, responses: responses}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.srv.Close)
+	return s
+}
+
+func (s *pollServer) serve(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("act") != "a_check" {
+		s.t.Errorf("act = %q", r.FormValue("act"))
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, r.FormValue("key")+" "+r.FormValue("ts"))
+	if len(s.responses) == 0 {
+		s.mu.Unlock()
+		// hold the request like the real server until the client leaves
+		<-r.Context().Done()
+		return
+	}
+	response := s.responses[0]
+	s.responses = s.responses[1:]
+	s.mu.Unlock()
+
+	if response == "502" {
+		http.Error(w, "bad gateway", http.StatusBadGateway)
+		return
+	}
+	_, _ = w.Write([]byte(response))
+}
+
+// getServer issues a new key on every call, starting from ts 10.
+func (s *pollServer) getServer(ctx context.Context) (LongPollServer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refreshes++
+	return LongPollServer{Server: s.srv.URL, Key: "key" + strconv.Itoa(s.refreshes), TS: "10"}, nil
+}
+
+func newTestPoll(t *testing.T, s *pollServer) *BotsLongPoll {
+	lp := NewBotsLongPoll(NewVK("token"), s.getServer)
+	lp.Client = s.srv.Client()
+	lp.Backoff = time.Millisecond
+	return lp
+}
+
+func TestLongPollPoll(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		updates  int
+		next     string // key and ts of the following request
+		err      bool
+	}{
+		{"updates", `{"ts":"11","updates":[{"type":"a"},{"type":"b"}]}`, 2, "key1 11", false},
+		{"numeric ts", `{"ts":11,"updates":[]}`, 0, "key1 11", false},
+		{"outdated history", `{"failed":1,"ts":42}`, 0, "key1 42", false},
+		{"key expired", `{"failed":2}`, 0, "key2 10", false},
+		{"information lost", `{"failed":3}`, 0, "key2 10", false},
+		{"unknown failure", `{"failed":4}`, 0, "key1 10", true},
+		{"status", "502", 0, "key1 10", true},
+		{"malformed", `<html>`, 0, "key1 10", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newPollServer(t, tt.response, `{"ts":"1","updates":[]}`)
+			lp := newTestPoll(t, s)
+			ctx := context.Background()
+
+			updates, err := lp.Poll(ctx)
+			if (err != nil) != tt.err || len(updates) != tt.updates {
+				t.Fatalf("Poll() = %d updates, %v", len(updates), err)
+			}
+			if _, err := lp.Poll(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if got := s.requests[1]; got != tt.next {
+				t.Errorf("next request %q, want %q", got, tt.next)
+			}
+		})
+	}
+}
+
+func TestLongPollEvents(t *testing.T) {
+	s := newPollServer(t,
+		`{"ts":"11","updates":[{"type":"message_new","group_id":1}]}`,
+		"502",
+		`{"failed":2}`,
+		`{"ts":"12","updates":[{"type":"message_reply","group_id":1}]}`,
+	)
+	lp := newTestPoll(t, s)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := lp.Events(ctx)
+	var types []string
+	for event := range events {
+		if types = append(types, event.Type); len(types) == 2 {
+			cancel()
+		}
+	}
+	if len(types) != 2 || types[0] != "message_new" || types[1] != "message_reply" {
+		t.Errorf("events = %v", types)
+	}
+	if !errors.Is(lp.Err(), context.Canceled) {
+		t.Errorf("Err() = %v, want %v", lp.Err(), context.Canceled)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	want := []string{"key1 10", "key1 11", "key1 11", "key2 11"}
+	for i, request := range want {
+		if s.requests[i] != request {
+			t.Errorf("request %d = %q, want %q", i, s.requests[i], request)
+		}
+	}
+}
+
+func TestLongPollEventsError(t *testing.T) {
+	apiErr := &Error{Code: ErrAccessDenied, Message: "Access denied"}
+	lp := NewBotsLongPoll(NewVK("token"), func(ctx context.Context) (LongPollServer, error) {
+		return LongPollServer{}, apiErr
+	})
+	for range lp.Events(context.Background()) {
+		t.Error("unexpected event")
+	}
+	if !errors.Is(lp.Err(), apiErr) {
+		t.Errorf("Err() = %v, want %v", lp.Err(), apiErr)
+	}
+}
@@ -0,0 +1,82 @@
+package runtime
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Requests per second VK allows per access token.
+const (
+	UserRPS  = 3
+	GroupRPS = 20
+)
+
+// Clock tells the time and sleeps. Tests replace it with a fake clock.
+type Clock interface {
+	Now() time.Time
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) Sleep(ctx context.Context, d time.Duration) error {
+	return sleep(ctx, d)
+}
+
+// RealClock is the system clock.
+var RealClock Clock = realClock{}
+
+// RateLimiter keeps each access token within RPS requests per second. It
+// may be shared by clients.
+type RateLimiter struct {
+	RPS   int
+	Clock Clock
+
+	mu   sync.Mutex
+	sent map[string][]time.Time // the last RPS request times per token
+}
+
+// NewRateLimiter returns a limiter allowing rps requests per second per
+// token.
+func NewRateLimiter(rps int) *RateLimiter {
+	return &RateLimiter{RPS: rps, Clock: RealClock}
+}
+
+// Wait blocks until a request with the token is allowed.
+func (l *RateLimiter) Wait(ctx context.Context, token string) error {
+	for {
+		delay := l.reserve(token)
+		if delay <= 0 {
+			return nil
+		}
+		if err := l.Clock.Sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// reserve records a request and returns zero if it is allowed now, or how
+// long to wait before trying again.
+func (l *RateLimiter) reserve(token string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.sent == nil {
+		l.sent = make(map[string][]time.Time)
+	}
+
+	now := l.Clock.Now()
+	sent := l.sent[token]
+	if len(sent) >= l.RPS {
+		if delay := sent[0].Add(time.Second).Sub(now); delay > 0 {
+			return delay
+		}
+		sent = sent[1:]
+	}
+	l.sent[token] = append(sent, now)
+	return 0
+}
@@ -0,0 +1,113 @@
+package runtime
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock whose Sleep advances the time at once and records
+// the delay.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.sleeps = append(c.sleeps, d)
+	return ctx.Err()
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestRateLimiter(t *testing.T) {
+	tests := []struct {
+		name   string
+		steps  []string // token to wait for, or "+d" to advance the clock
+		sleeps []time.Duration
+	}{
+		{"within limit", []string{"a", "a", "a"}, nil},
+		{"over limit", []string{"a", "a", "a", "a"}, []time.Duration{time.Second}},
+		{"per token", []string{"a", "a", "a", "b", "b", "b"}, nil},
+		{"window moves", []string{"a", "+400ms", "a", "a", "a"}, []time.Duration{600 * time.Millisecond}},
+		{"window passed", []string{"a", "a", "a", "+1s", "a", "a", "a"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := newFakeClock()
+			l := NewRateLimiter(3)
+			l.Clock = clock
+			for _, step := range tt.steps {
+				if step[0] == '+' {
+					d, _ := time.ParseDuration(step[1:])
+					clock.Advance(d)
+					continue
+				}
+				if err := l.Wait(context.Background(), step); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if len(clock.sleeps) != len(tt.sleeps) {
+				t.Fatalf("sleeps = %v, want %v", clock.sleeps, tt.sleeps)
+			}
+			for i := range tt.sleeps {
+				if clock.sleeps[i] != tt.sleeps[i] {
+					t.Errorf("sleeps = %v, want %v", clock.sleeps, tt.sleeps)
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiterCanceled(t *testing.T) {
+	l := NewRateLimiter(1)
+	l.Clock = newFakeClock()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Wait(ctx, "a"); err != context.Canceled {
+		t.Errorf("Wait() = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestRequestRateLimit(t *testing.T) {
+	requests := 0
+	vk := newTestVK(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		apiResponse(w, 1)
+	}))
+	clock := newFakeClock()
+	vk.Limiter = NewRateLimiter(2)
+	vk.Limiter.Clock = clock
+
+	for i := 0; i < 5; i++ {
+		if _, err := vk.Request("users.get", nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 5 || len(clock.sleeps) != 2 {
+		t.Errorf("%d requests, sleeps %v", requests, clock.sleeps)
+	}
+}
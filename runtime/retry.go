@@ -0,0 +1,47 @@
+package runtime
+
+import (
+	"errors"
+	"time"
+)
+
+// RetryPolicy retries requests failing with the listed VK API error codes,
+// doubling the delay after each attempt.
+type RetryPolicy struct {
+	MaxRetries int
+	Codes      []ErrorCode
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	Clock      Clock
+}
+
+// NewRetryPolicy returns a policy retrying "too many requests" and
+// internal server errors three times.
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: 3,
+		Codes:      []ErrorCode{ErrTooMany, ErrServer},
+		Backoff:    time.Second / 3,
+		MaxBackoff: 5 * time.Second,
+		Clock:      RealClock,
+	}
+}
+
+// delay returns how long to wait before retrying after the attempt
+// (counted from 0) failed with err, and false if err is not retried.
+func (p *RetryPolicy) delay(attempt int, err error) (time.Duration, bool) {
+	var vkErr *Error
+	if attempt >= p.MaxRetries || !errors.As(err, &vkErr) {
+		return 0, false
+	}
+	for _, code := range p.Codes {
+		if vkErr.Code == code {
+			d := p.Backoff << uint(attempt)
+			if d > p.MaxBackoff || d <= 0 {
+				d = p.MaxBackoff
+			}
+			return d, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,74 @@
+package runtime
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := NewRetryPolicy()
+	p.Backoff = time.Second
+	p.MaxBackoff = 3 * time.Second
+	tests := []struct {
+		name    string
+		attempt int
+		err     error
+		delay   time.Duration
+		retry   bool
+	}{
+		{"too many", 0, &Error{Code: ErrTooMany}, time.Second, true},
+		{"server", 1, &Error{Code: ErrServer}, 2 * time.Second, true},
+		{"capped", 2, &Error{Code: ErrServer}, 3 * time.Second, true},
+		{"exhausted", 3, &Error{Code: ErrServer}, 0, false},
+		{"not listed", 0, &Error{Code: ErrAccessDenied}, 0, false},
+		{"not API error", 0, errors.New("EOF"), 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, retry := p.delay(tt.attempt, tt.err)
+			if delay != tt.delay || retry != tt.retry {
+				t.Errorf("delay(%d, %v) = %v, %v, want %v, %v", tt.attempt, tt.err, delay, retry, tt.delay, tt.retry)
+			}
+		})
+	}
+}
+
+func TestRequestRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		failures []ErrorCode
+		requests int
+		sleeps   int
+		code     ErrorCode // of the returned error
+	}{
+		{"no failures", nil, 1, 0, 0},
+		{"recovers", []ErrorCode{ErrTooMany, ErrServer}, 3, 2, 0},
+		{"exhausted", []ErrorCode{ErrServer, ErrServer, ErrServer, ErrServer}, 4, 3, ErrServer},
+		{"not retried", []ErrorCode{ErrAccessDenied}, 1, 0, ErrAccessDenied},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			vk := newTestVK(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if requests++; requests <= len(tt.failures) {
+					apiError(w, tt.failures[requests-1], "failure")
+					return
+				}
+				apiResponse(w, 1)
+			}))
+			clock := newFakeClock()
+			vk.Retry.Clock = clock
+
+			_, err := vk.Request("users.get", nil)
+			var vkErr *Error
+			if tt.code == 0 && err != nil || tt.code != 0 && (!errors.As(err, &vkErr) || vkErr.Code != tt.code) {
+				t.Errorf("Request() error = %v, want code %d", err, tt.code)
+			}
+			if requests != tt.requests || len(clock.sleeps) != tt.sleeps {
+				t.Errorf("%d requests, sleeps %v", requests, clock.sleeps)
+			}
+		})
+	}
+}
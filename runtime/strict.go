@@ -0,0 +1,297 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DriftKind classifies a difference between a live response and the Go
+// type generated from the schema.
+type DriftKind int
+
+// Kinds of schema drift.
+const (
+	UnknownField DriftKind = iota + 1
+	TypeMismatch
+	MissingField
+)
+
+func (k DriftKind) String() string {
+	switch k {
+	case UnknownField:
+		return "unknown field"
+	case TypeMismatch:
+		return "type mismatch"
+	case MissingField:
+		return "missing required field"
+	}
+	return "unknown"
+}
+
+// DriftIssue is a single difference found in a response.
+type DriftIssue struct {
+	Kind     DriftKind
+	Path     string // JSON path of the value, e.g. $.items[0].id
+	Expected string // JSON kind the Go type expects
+	Actual   string // JSON kind found in the response
+}
+
+// DriftReport lists the differences found in the response of a method.
+type DriftReport struct {
+	Method string
+	Issues []DriftIssue
+}
+
+// DriftHook receives drift reports in strict mode.
+type DriftHook func(report DriftReport)
+
+var requiredFields sync.Map // reflect.Type -> []string
+
+// RegisterRequired records the type of v as generated from the schema,
+// with the JSON fields the schema marks as required. The generated code
+// registers every object and response.
+func RegisterRequired(v interface{}, fields ...string) {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	requiredFields.Store(t, fields)
+}
+
+func (vk *VK) reportDrift(method string, data []byte, typ reflect.Type) {
+	issues, err := CheckDrift(data, typ)
+	if err != nil {
+		issues = append(issues, DriftIssue{
+			Kind:     TypeMismatch,
+			Path:     "$",
+			Expected: jsonKindOf(typ),
+			Actual:   "invalid json",
+		})
+	}
+	if len(issues) > 0 {
+		vk.DriftHook(DriftReport{Method: method, Issues: issues})
+	}
+}
+
+// CheckDrift compares the JSON document with the Go type it is meant to be
+// decoded into and returns the differences found.
+func CheckDrift(data []byte, typ reflect.Type) ([]DriftIssue, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	var issues []DriftIssue
+	checkDrift(v, typ, "$", &issues)
+	return issues, nil
+}
+
+var unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+func checkDrift(v interface{}, t reflect.Type, path string, issues *[]DriftIssue) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	// null decodes into anything, custom decoders and interface{} accept
+	// whatever they want. The generated decoders of schema types decode the
+	// fields they declare, so those are still checked.
+	if v == nil || t.Kind() == reflect.Interface {
+		return
+	}
+	if _, ok := requiredFields.Load(t); !ok && reflect.PtrTo(t).Implements(unmarshalerType) {
+		return
+	}
+
+	mismatch := func() {
+		*issues = append(*issues, DriftIssue{
+			Kind:     TypeMismatch,
+			Path:     path,
+			Expected: jsonKindOf(t),
+			Actual:   jsonKind(v),
+		})
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			mismatch()
+			return
+		}
+		fields := structFields(t)
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			field, ok := lookupField(fields, key)
+			if !ok {
+				*issues = append(*issues, DriftIssue{
+					Kind:   UnknownField,
+					Path:   path + "." + key,
+					Actual: jsonKind(obj[key]),
+				})
+				continue
+			}
+			checkDrift(obj[key], field, path+"."+key, issues)
+		}
+		if req, ok := requiredFields.Load(t); ok {
+			for _, name := range req.([]string) {
+				if _, ok := obj[name]; !ok {
+					*issues = append(*issues, DriftIssue{
+						Kind:     MissingField,
+						Path:     path + "." + name,
+						Expected: jsonKindOf(fields[name]),
+					})
+				}
+			}
+		}
+	case reflect.Map:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			mismatch()
+			return
+		}
+		for key, item := range obj {
+			checkDrift(item, t.Elem(), path+"."+key, issues)
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := v.([]interface{})
+		if !ok {
+			mismatch()
+			return
+		}
+		for i, item := range arr {
+			checkDrift(item, t.Elem(), path+"["+strconv.Itoa(i)+"]", issues)
+		}
+	case reflect.String:
+		if _, ok := v.(string); !ok {
+			mismatch()
+		}
+	case reflect.Bool:
+		if _, ok := v.(bool); !ok {
+			mismatch()
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := v.(json.Number)
+		if !ok {
+			mismatch()
+			return
+		}
+		if _, err := n.Int64(); err != nil {
+			mismatch()
+		}
+	case reflect.Float32, reflect.Float64:
+		if _, ok := v.(json.Number); !ok {
+			mismatch()
+		}
+	}
+}
+
+// structFields returns the JSON names of the struct fields, following
+// embedded structs the same way encoding/json does.
+func structFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := tag
+		if idx := strings.Index(tag, ","); idx != -1 {
+			name = tag[:idx]
+		}
+
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			for embedded, typ := range structFields(ft) {
+				if _, ok := fields[embedded]; !ok {
+					fields[embedded] = typ
+				}
+			}
+			continue
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
+
+// lookupField matches the key like encoding/json: exact match first, then
+// case-insensitive.
+func lookupField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if t, ok := fields[key]; ok {
+		return t, true
+	}
+	for name, t := range fields {
+		if strings.EqualFold(name, key) {
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func jsonKind(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	}
+	return "unknown"
+}
+
+func jsonKindOf(t reflect.Type) string {
+	if t == nil {
+		return "unknown"
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map:
+		return "object"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	}
+	return "any"
+}
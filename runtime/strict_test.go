@@ -0,0 +1,102 @@
+package runtime
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type driftUser struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+// driftDecoded has a generated decoder and is registered as a schema type.
+type driftDecoded struct {
+	ID    int64       `json:"id"`
+	Items []driftUser `json:"items"`
+}
+
+func (v *driftDecoded) UnmarshalJSON(data []byte) error {
+	type plain driftDecoded
+	return json.Unmarshal(data, (*plain)(v))
+}
+
+// driftCustom has a hand-written decoder unknown to the schema.
+type driftCustom struct {
+	ID int64 `json:"id"`
+}
+
+func (v *driftCustom) UnmarshalJSON(data []byte) error {
+	return nil
+}
+
+func init() {
+	RegisterRequired((*driftUser)(nil), "id")
+	RegisterRequired((*driftDecoded)(nil), "id")
+}
+
+func TestCheckDrift(t *testing.T) {
+	tests := []struct {
+		name   string
+		typ    reflect.Type
+		data   string
+		issues []DriftIssue
+	}{
+		{
+			name: "match",
+			typ:  reflect.TypeOf(driftUser{}),
+			data: `{"id":1,"name":"a"}`,
+		},
+		{
+			name: "unknown field",
+			typ:  reflect.TypeOf(driftUser{}),
+			data: `{"id":1,"age":2}`,
+			issues: []DriftIssue{
+				{Kind: UnknownField, Path: "$.age", Actual: "integer"},
+			},
+		},
+		{
+			name: "missing field",
+			typ:  reflect.TypeOf(driftUser{}),
+			data: `{"name":"a"}`,
+			issues: []DriftIssue{
+				{Kind: MissingField, Path: "$.id", Expected: "integer"},
+			},
+		},
+		{
+			name: "type mismatch",
+			typ:  reflect.TypeOf(driftUser{}),
+			data: `{"id":"1"}`,
+			issues: []DriftIssue{
+				{Kind: TypeMismatch, Path: "$.id", Expected: "integer", Actual: "string"},
+			},
+		},
+		{
+			name: "generated decoder",
+			typ:  reflect.TypeOf(driftDecoded{}),
+			data: `{"items":[{"id":1.5}],"extra":true}`,
+			issues: []DriftIssue{
+				{Kind: UnknownField, Path: "$.extra", Actual: "boolean"},
+				{Kind: TypeMismatch, Path: "$.items[0].id", Expected: "integer", Actual: "number"},
+				{Kind: MissingField, Path: "$.id", Expected: "integer"},
+			},
+		},
+		{
+			name: "custom decoder",
+			typ:  reflect.TypeOf(driftCustom{}),
+			data: `{"id":"1","extra":true}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues, err := CheckDrift([]byte(tt.data), tt.typ)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(issues) != len(tt.issues) || len(issues) > 0 && !reflect.DeepEqual(issues, tt.issues) {
+				t.Errorf("CheckDrift() = %v, want %v", issues, tt.issues)
+			}
+		})
+	}
+}
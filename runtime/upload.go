@@ -0,0 +1,141 @@
+package runtime
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+)
+
+// UploadServer is the part of the get*UploadServer responses the upload
+// helpers need.
+type UploadServer struct {
+	UploadURL string `json:"upload_url"`
+}
+
+// UploadError is returned when the upload server rejects the file.
+type UploadError struct {
+	Message string
+}
+
+func (e *UploadError) Error() string {
+	return "upload: " + e.Message
+}
+
+// UploadMethod is the Invocation method of uploads. Their params are the
+// upload_url and the form field; interceptors must not resend them, as the
+// file is read once.
+const UploadMethod = "upload"
+
+// Upload streams file as the multipart form field to the upload server
+// and returns the fields of its response, ready to be passed to the
+// matching save method. The upload runs through the Interceptors.
+func (vk *VK) Upload(ctx context.Context, uploadURL, field string, file io.Reader) (Params, error) {
+	inv := &Invocation{Method: UploadMethod, Params: Params{"upload_url": uploadURL, "field": field}}
+	raw, err := vk.intercept(func(ctx context.Context, inv *Invocation) (json.RawMessage, error) {
+		return vk.upload(ctx, uploadURL, field, file)
+	})(ctx, inv)
+	if err != nil {
+		return nil, err
+	}
+	return decodeUpload(raw)
+}
+
+// upload sends the file and returns the response of the upload server.
+func (vk *VK) upload(ctx context.Context, uploadURL, field string, file io.Reader) (json.RawMessage, error) {
+	body, contentType := multipartBody(field, file)
+	defer body.Close()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadURL, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", vk.UserAgent)
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := vk.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &UploadError{Message: resp.Status}
+	}
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := decodeUpload(raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// decodeUpload returns the fields of the upload server response, or its
+// error.
+func decodeUpload(raw json.RawMessage) (Params, error) {
+	var result Params
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&result); err != nil {
+		return nil, fmt.Errorf("upload: %w", err)
+	}
+	if msg, ok := result["error"]; ok {
+		return nil, &UploadError{Message: FormatValue(msg)}
+	}
+	return result, nil
+}
+
+// multipartBody streams the form through a pipe so the file is never
+// buffered whole. The file name extension follows the sniffed content
+// type, since upload servers check it.
+func multipartBody(field string, file io.Reader) (io.ReadCloser, string) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	go func() {
+		br := bufio.NewReaderSize(file, 512)
+		head, _ := br.Peek(512)
+		name := "file" + fileExtension(http.DetectContentType(head))
+
+		part, err := mw.CreateFormFile(field, name)
+		if err == nil {
+			_, err = io.Copy(part, br)
+		}
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return pr, mw.FormDataContentType()
+}
+
+// fileExtensions are the usual extensions of the sniffed content types.
+var fileExtensions = map[string]string{
+	"application/pdf": ".pdf",
+	"audio/mpeg":      ".mp3",
+	"image/gif":       ".gif",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/webp":      ".webp",
+	"video/mp4":       ".mp4",
+}
+
+func fileExtension(contentType string) string {
+	mediatype, _, _ := mime.ParseMediaType(contentType)
+	if ext, ok := fileExtensions[mediatype]; ok {
+		return ext
+	}
+	if exts, _ := mime.ExtensionsByType(mediatype); len(exts) > 0 {
+		return exts[0]
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+// uploadHandler is a fake upload server receiving the file in the field.
+func uploadHandler(t *testing.T, field string, response string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		file, header, err := r.FormFile(field)
+		if err != nil {
+			t.Errorf("upload: %v", err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		if header.Filename != "file.png" {
+			t.Errorf("upload: file name %q, want file.png", header.Filename)
+		}
+		data, _ := ioutil.ReadAll(file)
+		if !bytes.Equal(data, pngHeader) {
+			t.Errorf("upload: file %q", data)
+		}
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(response))
+	}
+}
+
+func TestUpload(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.Handle("/upload", uploadHandler(t, "photo", `{"server":123,"photo":"[{}]","hash":"abc"}`))
+	vk := newTestVK(t, mux)
+
+	uploaded, err := vk.Upload(context.Background(), strings.TrimSuffix(vk.MethodURL, "method/")+"upload", "photo", bytes.NewReader(pngHeader))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Params{"server": json.Number("123"), "photo": "[{}]", "hash": "abc"}
+	for key, value := range want {
+		if uploaded[key] != value {
+			t.Errorf("uploaded[%s] = %#v, want %#v", key, uploaded[key], value)
+		}
+	}
+}
+
+func TestUploadError(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		message string
+	}{
+		{
+			name: "status",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "oops", http.StatusInternalServerError)
+			},
+			message: "500 Internal Server Error",
+		},
+		{
+			name: "error field",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"error":"ERR_UPLOAD_BAD_IMAGE_SIZE"}`))
+			},
+			message: "ERR_UPLOAD_BAD_IMAGE_SIZE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vk := newTestVK(t, tt.handler)
+			_, err := vk.Upload(context.Background(), vk.MethodURL, "file", bytes.NewReader(pngHeader))
+			var uploadErr *UploadError
+			if !errors.As(err, &uploadErr) || uploadErr.Message != tt.message {
+				t.Errorf("Upload() error = %v, want %s", err, tt.message)
+			}
+		})
+	}
+}
+
+// TestUploadFlow follows the generated helpers: get the upload server,
+// upload the file and save it with the fields of the upload response.
+func TestUploadFlow(t *testing.T) {
+	mux := http.NewServeMux()
+	var uploadURL string
+	mux.HandleFunc("/method/photos.getWallUploadServer", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("group_id") != "1" {
+			t.Errorf("getWallUploadServer: group_id = %q", r.FormValue("group_id"))
+		}
+		apiResponse(w, map[string]interface{}{"upload_url": uploadURL, "album_id": 1})
+	})
+	mux.Handle("/upload", uploadHandler(t, "photo", `{"server":7,"photo":"[{}]","hash":"h"}`))
+	mux.HandleFunc("/method/photos.saveWallPhoto", func(w http.ResponseWriter, r *http.Request) {
+		for key, want := range map[string]string{"server": "7", "photo": "[{}]", "hash": "h", "group_id": "1", "caption": "hi"} {
+			if got := r.FormValue(key); got != want {
+				t.Errorf("saveWallPhoto: %s = %q, want %q", key, got, want)
+			}
+		}
+		apiResponse(w, []map[string]interface{}{{"id": 42, "owner_id": -1}})
+	})
+	vk := newTestVK(t, mux)
+	uploadURL = strings.TrimSuffix(vk.MethodURL, "method/") + "upload"
+
+	ctx := context.Background()
+	var server UploadServer
+	if err := vk.RequestUnmarshalContext(ctx, "photos.getWallUploadServer", Params{"group_id": 1}, &server); err != nil {
+		t.Fatal(err)
+	}
+	uploaded, err := vk.Upload(ctx, server.UploadURL, "photo", bytes.NewReader(pngHeader))
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := Params{"group_id": 1, "caption": "hi"}
+	params.Merge(uploaded, "photo", "server", "hash")
+	var saved []struct {
+		ID int64 `json:"id"`
+	}
+	if err := vk.RequestUnmarshalContext(ctx, "photos.saveWallPhoto", params, &saved); err != nil {
+		t.Fatal(err)
+	}
+	if len(saved) != 1 || saved[0].ID != 42 {
+		t.Errorf("saved = %+v", saved)
+	}
+}
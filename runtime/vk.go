@@ -0,0 +1,227 @@
+// Package runtime implements the VK API client the generated code is built on.
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"reflect"
+)
+
+const (
+	// Version is the VK API version requested by default.
+	Version = "5.131"
+	// MethodURL is the base URL of the VK API methods.
+	MethodURL = "https://api.vk.com/method/"
+	// UserAgent is sent with every request by default.
+	UserAgent = "vkgen"
+)
+
+// Params are raw method parameters.
+type Params map[string]interface{}
+
+// VK is a VK API client.
+type VK struct {
+	AccessToken string
+	Version     string
+	MethodURL   string
+	UserAgent   string
+	Client      *http.Client
+
+	// Limiter keeps the requests within the rate limit of the token.
+	Limiter *RateLimiter
+	// Retry retries the requests failing with transient errors.
+	Retry *RetryPolicy
+	// Interceptors wrap every call the client makes, the first one being
+	// the outermost: the API requests, the execute requests sending
+	// batches and coalesced calls, and the uploads.
+	Interceptors []Interceptor
+
+	// CaptchaSolver answers captchas; without it error 14 is returned.
+	CaptchaSolver CaptchaSolver
+	// ValidationHandler passes validations; without it error 17 is
+	// returned.
+	ValidationHandler ValidationHandler
+
+	// DriftHook enables strict decoding. When set, every response is
+	// checked against the Go type it is decoded into and the hook receives
+	// the unknown fields, type mismatches and missing required fields.
+	DriftHook DriftHook
+
+	coalescer *coalescer
+}
+
+// NewVK returns a client authorized with the access token. It keeps to
+// the user token rate limit; set Limiter to NewRateLimiter(GroupRPS) for
+// community tokens.
+func NewVK(token string) *VK {
+	return &VK{
+		AccessToken: token,
+		Version:     Version,
+		MethodURL:   MethodURL,
+		UserAgent:   UserAgent,
+		Client:      http.DefaultClient,
+		Limiter:     NewRateLimiter(UserRPS),
+		Retry:       NewRetryPolicy(),
+	}
+}
+
+// Response is the envelope of every VK API response.
+type Response struct {
+	Response      json.RawMessage `json:"response"`
+	Error         *Error          `json:"error"`
+	ExecuteErrors []ExecuteError  `json:"execute_errors"`
+}
+
+// Request calls the method and returns the raw envelope. A VK API error
+// is returned as *Error.
+func (vk *VK) Request(method string, params Params) (Response, error) {
+	return vk.RequestContext(context.Background(), method, params)
+}
+
+// RequestContext is Request with a context. The call runs through the
+// Interceptors; it waits for the Limiter, is sent again once the
+// CaptchaSolver or ValidationHandler answers and is retried as the Retry
+// policy says.
+func (vk *VK) RequestContext(ctx context.Context, method string, params Params) (Response, error) {
+	var response Response
+	raw, err := vk.intercept(func(ctx context.Context, inv *Invocation) (json.RawMessage, error) {
+		var err error
+		response, err = vk.request(ctx, inv.Method, inv.Params)
+		return response.Response, err
+	})(ctx, &Invocation{Method: method, Params: params})
+	// an interceptor may answer without sending the call
+	response.Response = raw
+	return response, err
+}
+
+// request sends the call, bypassing the interceptors.
+func (vk *VK) request(ctx context.Context, method string, params Params) (Response, error) {
+	query := url.Values{}
+	for key, value := range params {
+		query.Set(key, FormatValue(value))
+	}
+	if _, ok := params["access_token"]; !ok && vk.AccessToken != "" {
+		query.Set("access_token", vk.AccessToken)
+	}
+	if _, ok := params["v"]; !ok {
+		query.Set("v", vk.Version)
+	}
+
+	attempt, challenges := 0, 0
+	for {
+		if vk.Limiter != nil {
+			if err := vk.Limiter.Wait(ctx, query.Get("access_token")); err != nil {
+				return Response{}, err
+			}
+		}
+
+		response, err := vk.do(ctx, method, query)
+		if err == nil {
+			return response, nil
+		}
+
+		if challenges < maxChallenges {
+			resend, hookErr := vk.answerChallenge(ctx, query, err)
+			if hookErr != nil {
+				return response, hookErr
+			}
+			if resend {
+				challenges++
+				continue
+			}
+		}
+
+		if vk.Retry == nil {
+			return response, err
+		}
+		delay, ok := vk.Retry.delay(attempt, err)
+		if !ok {
+			return response, err
+		}
+		if err := vk.Retry.Clock.Sleep(ctx, delay); err != nil {
+			return response, err
+		}
+		attempt++
+	}
+}
+
+func (vk *VK) do(ctx context.Context, method string, query url.Values) (Response, error) {
+	var response Response
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, vk.MethodURL+method, bytes.NewBufferString(query.Encode()))
+	if err != nil {
+		return response, err
+	}
+	req.Header.Set("User-Agent", vk.UserAgent)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := vk.Client.Do(req)
+	if err != nil {
+		return response, err
+	}
+	defer resp.Body.Close()
+
+	mediatype, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediatype != "application/json" {
+		return response, fmt.Errorf("%s: unexpected content-type %q", method, resp.Header.Get("Content-Type"))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return response, err
+	}
+	if response.Error != nil {
+		return response, response.Error
+	}
+	return response, nil
+}
+
+// RequestUnmarshal calls the method and decodes the response into obj.
+func (vk *VK) RequestUnmarshal(method string, params Params, obj interface{}) error {
+	return vk.RequestUnmarshalContext(context.Background(), method, params, obj)
+}
+
+// RequestUnmarshalContext is RequestUnmarshal with a context.
+func (vk *VK) RequestUnmarshalContext(ctx context.Context, method string, params Params, obj interface{}) error {
+	return vk.invokeUnmarshal(ctx, &Invocation{Method: method, Params: params}, obj)
+}
+
+// invokeUnmarshal runs the call through the interceptors and decodes the
+// response into obj. The call goes into an execute request when the
+// client coalesces calls.
+func (vk *VK) invokeUnmarshal(ctx context.Context, inv *Invocation, obj interface{}) error {
+	raw, err := vk.invoke(ctx, inv)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(raw, obj)
+	if vk.DriftHook != nil {
+		vk.reportDrift(inv.Method, raw, reflect.TypeOf(obj))
+	}
+	return err
+}
+
+// Merge copies the keys present in src to p, replacing the values set.
+func (p Params) Merge(src Params, keys ...string) {
+	for _, key := range keys {
+		if value, ok := src[key]; ok {
+			p[key] = value
+		}
+	}
+}
+
+// Defaults copies the keys present in src and missing in p to p.
+func (p Params) Defaults(src Params, keys ...string) {
+	for _, key := range keys {
+		if _, ok := p[key]; ok {
+			continue
+		}
+		if value, ok := src[key]; ok {
+			p[key] = value
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package runtime
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// newTestVK returns a client of the API served by handler, without rate
+// limiting.
+func newTestVK(t *testing.T, handler http.Handler) *VK {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	vk := NewVK("token")
+	vk.MethodURL = srv.URL + "/method/"
+	vk.Client = srv.Client()
+	vk.Limiter = nil
+	return vk
+}
+
+// writeJSON writes v as the JSON response.
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// apiResponse wraps the method response into the VK envelope.
+func apiResponse(w http.ResponseWriter, response interface{}) {
+	writeJSON(w, map[string]interface{}{"response": response})
+}
+
+// apiError writes the VK API error.
+func apiError(w http.ResponseWriter, code ErrorCode, msg string) {
+	writeJSON(w, map[string]interface{}{
+		"error": map[string]interface{}{"error_code": code, "error_msg": msg},
+	})
+}
@@ -0,0 +1,211 @@
+package runtime
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+)
+
+// Writer builds JSON for the generated MarshalJSON methods. The output is
+// what encoding/json of current Go releases produces for the same value;
+// older releases escape \b and \f as \u0008 and \u000c and invalid UTF-8
+// as \ufffd instead of writing U+FFFD.
+type Writer struct {
+	buf    []byte
+	err    error
+	pooled bool
+}
+
+var writerBufs = sync.Pool{
+	New: func() interface{} { return make([]byte, 0, 1024) },
+}
+
+// NewWriter returns a writer building the JSON in a pooled buffer, released
+// by Bytes.
+func NewWriter() *Writer {
+	return &Writer{buf: writerBufs.Get().([]byte)[:0], pooled: true}
+}
+
+// Bytes returns the written JSON and the first error met. A pooled writer
+// returns a copy and must not be used afterwards.
+func (w *Writer) Bytes() ([]byte, error) {
+	if !w.pooled {
+		return w.buf, w.err
+	}
+	data := append([]byte(nil), w.buf...)
+	writerBufs.Put(w.buf)
+	w.buf, w.pooled = nil, false
+	return data, w.err
+}
+
+// RawByte writes c as is.
+func (w *Writer) RawByte(c byte) {
+	w.buf = append(w.buf, c)
+}
+
+// RawString writes s as is.
+func (w *Writer) RawString(s string) {
+	w.buf = append(w.buf, s...)
+}
+
+// Field writes an object key prefixed by a comma unless first is set.
+// The key must already be quoted and followed by a colon.
+func (w *Writer) Field(first *bool, key string) {
+	if !*first {
+		w.buf = append(w.buf, ',')
+	}
+	*first = false
+	w.buf = append(w.buf, key...)
+}
+
+// Null writes null.
+func (w *Writer) Null() {
+	w.buf = append(w.buf, "null"...)
+}
+
+// Bool writes a boolean.
+func (w *Writer) Bool(v bool) {
+	w.buf = strconv.AppendBool(w.buf, v)
+}
+
+// Int64 writes an integer.
+func (w *Writer) Int64(v int64) {
+	w.buf = strconv.AppendInt(w.buf, v, 10)
+}
+
+// Float64 writes a number the way encoding/json does.
+func (w *Writer) Float64(v float64) {
+	if math.IsInf(v, 0) || math.IsNaN(v) {
+		if w.err == nil {
+			w.err = errors.New("json: unsupported value: " + strconv.FormatFloat(v, 'g', -1, 64))
+		}
+		return
+	}
+
+	format := byte('f')
+	if abs := math.Abs(v); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	w.buf = strconv.AppendFloat(w.buf, v, format, -1, 64)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(w.buf)
+		if n >= 4 && w.buf[n-4] == 'e' && w.buf[n-3] == '-' && w.buf[n-2] == '0' {
+			w.buf[n-2] = w.buf[n-1]
+			w.buf = w.buf[:n-1]
+		}
+	}
+}
+
+const hex = "0123456789abcdef"
+
+// String writes a quoted string escaped the way encoding/json does,
+// including HTML-safe escaping.
+func (w *Writer) String(s string) {
+	w.buf = append(w.buf, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			w.buf = append(w.buf, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				w.buf = append(w.buf, '\\', c)
+			case '\n':
+				w.buf = append(w.buf, '\\', 'n')
+			case '\r':
+				w.buf = append(w.buf, '\\', 'r')
+			case '\t':
+				w.buf = append(w.buf, '\\', 't')
+			case '\b':
+				w.buf = append(w.buf, '\\', 'b')
+			case '\f':
+				w.buf = append(w.buf, '\\', 'f')
+			default:
+				w.buf = append(w.buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			w.buf = append(w.buf, s[start:i]...)
+			w.buf = append(w.buf, string(utf8.RuneError)...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			w.buf = append(w.buf, s[start:i]...)
+			w.buf = append(w.buf, '\\', 'u', '2', '0', '2', hex[r&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	w.buf = append(w.buf, s[start:]...)
+	w.buf = append(w.buf, '"')
+}
+
+// Marshal writes v encoded with encoding/json. Generated code falls back
+// to it for types it has no dedicated encoder for. The output of a
+// MarshalJSON method encoding/json would keep as is is written directly.
+func (w *Writer) Marshal(v interface{}) {
+	if m, ok := v.(json.Marshaler); ok && !isNilPointer(v) {
+		if b, err := m.MarshalJSON(); err == nil && compactSafe(b) {
+			w.buf = append(w.buf, b...)
+			return
+		}
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		if w.err == nil {
+			w.err = err
+		}
+		return
+	}
+	w.buf = append(w.buf, b...)
+}
+
+func isNilPointer(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+// compactSafe reports whether encoding/json writes the MarshalJSON output
+// unchanged: without whitespace between tokens and with nothing to escape
+// for HTML.
+func compactSafe(b []byte) bool {
+	inString, escaped := false, false
+	for _, c := range b {
+		switch {
+		case c == '<' || c == '>' || c == '&' || c == 0xE2: // U+2028, U+2029
+			return false
+		case inString:
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			return false
+		}
+	}
+	return len(b) > 0
+}
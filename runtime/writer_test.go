@@ -0,0 +1,76 @@
+package runtime
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestWriterString(t *testing.T) {
+	tests := []string{
+		"",
+		"plain",
+		"quote \" backslash \\",
+		"\n\r\t\b\f\x00\x1f",
+		"<a href=\"x\">&</a>",
+		"line \u2028 separators \u2029",
+		"invalid \xff utf-8",
+		"кириллица",
+	}
+	for _, s := range tests {
+		var w Writer
+		w.String(s)
+		got, _ := w.Bytes()
+		want, _ := json.Marshal(s)
+		if string(got) != string(want) {
+			t.Errorf("String(%q) = %s, want %s", s, got, want)
+		}
+	}
+}
+
+func TestWriterFloat64(t *testing.T) {
+	for _, f := range []float64{0, 1.5, -2, 1e-7, 1e21, 123456789, 1e-9} {
+		var w Writer
+		w.Float64(f)
+		got, _ := w.Bytes()
+		want, _ := json.Marshal(f)
+		if string(got) != string(want) {
+			t.Errorf("Float64(%v) = %s, want %s", f, got, want)
+		}
+	}
+
+	var w Writer
+	w.Float64(math.Inf(1))
+	if _, err := w.Bytes(); err == nil {
+		t.Error("Float64(+Inf) did not fail")
+	}
+}
+
+type rawMarshaler string
+
+func (m rawMarshaler) MarshalJSON() ([]byte, error) {
+	return []byte(m), nil
+}
+
+func TestWriterMarshal(t *testing.T) {
+	tests := []interface{}{
+		map[string]int{"a": 1},
+		rawMarshaler(`{"a":"b c"}`),
+		rawMarshaler(`{ "a" : [1, 2] }`),
+		rawMarshaler(`"<b>"`),
+		json.RawMessage(` [1,  2]`),
+		(*json.RawMessage)(nil),
+	}
+	for _, v := range tests {
+		w := NewWriter()
+		w.Marshal(v)
+		got, err := w.Bytes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, _ := json.Marshal(v)
+		if string(got) != string(want) {
+			t.Errorf("Marshal(%#v) = %s, want %s", v, got, want)
+		}
+	}
+}
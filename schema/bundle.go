@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+)
+
+// Bundle is a parsed schema directory.
+type Bundle struct {
+	Methods   []MethodDefinition
+	Objects   []ObjectDefinition
+	Responses []ResponseDefinition
+}
+
+// LoadDir parses the methods, objects and responses schemas in dir.
+func LoadDir(dir string) (*Bundle, error) {
+	read := func(file SchemaType) ([]byte, error) {
+		return ioutil.ReadFile(filepath.Join(dir, string(file)))
+	}
+
+	objects, err := read(ObjectsSchema)
+	if err != nil {
+		return nil, err
+	}
+	methods, err := read(MethodsSchema)
+	if err != nil {
+		return nil, err
+	}
+	responses, err := read(ResponsesSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	p := NewParser(objects)
+	var b Bundle
+	if b.Objects, err = p.ParseObjects(objects); err != nil {
+		return nil, fmt.Errorf("%s: %w", ObjectsSchema, err)
+	}
+	if b.Methods, err = p.ParseMethods(methods); err != nil {
+		return nil, fmt.Errorf("%s: %w", MethodsSchema, err)
+	}
+	if b.Responses, err = p.ParseResponses(responses); err != nil {
+		return nil, fmt.Errorf("%s: %w", ResponsesSchema, err)
+	}
+	return &b, nil
+}
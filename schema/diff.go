@@ -0,0 +1,324 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// ChangeKind names a kind of schema change.
+type ChangeKind string
+
+// Change kinds.
+const (
+	MethodAdded       ChangeKind = "method-added"
+	MethodRemoved     ChangeKind = "method-removed"
+	ParamAdded        ChangeKind = "param-added"
+	ParamRemoved      ChangeKind = "param-removed"
+	ParamTypeChanged  ChangeKind = "param-type-changed"
+	ParamRequired     ChangeKind = "param-required"
+	ParamOptional     ChangeKind = "param-optional"
+	AccessAdded       ChangeKind = "access-added"
+	AccessRemoved     ChangeKind = "access-removed"
+	ResponseAdded     ChangeKind = "response-added"
+	ResponseRemoved   ChangeKind = "response-removed"
+	ResponseChanged   ChangeKind = "response-changed"
+	ObjectAdded       ChangeKind = "object-added"
+	ObjectRemoved     ChangeKind = "object-removed"
+	ObjectTypeChanged ChangeKind = "object-type-changed"
+	FieldAdded        ChangeKind = "field-added"
+	FieldRemoved      ChangeKind = "field-removed"
+	FieldTypeChanged  ChangeKind = "field-type-changed"
+	EnumValueAdded    ChangeKind = "enum-value-added"
+	EnumValueRemoved  ChangeKind = "enum-value-removed"
+)
+
+// Change is a difference between two schema versions. Path is the method
+// or definition, with the parameter or field path after a slash:
+// "messages.send/peer_id", "messages_message/geo/place". Old and New are
+// the changed types, access types or enum values.
+type Change struct {
+	Kind     ChangeKind `json:"kind"`
+	Path     string     `json:"path"`
+	Breaking bool       `json:"breaking"`
+	Old      string     `json:"old,omitempty"`
+	New      string     `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	s := string(c.Kind) + " " + c.Path
+	switch {
+	case c.Old != "" && c.New != "":
+		s += ": " + c.Old + " -> " + c.New
+	case c.Old != "":
+		s += ": " + c.Old
+	case c.New != "":
+		s += ": " + c.New
+	}
+	return s
+}
+
+// Diff compares two schema versions. Removals, type changes, newly required
+// params and narrowed access types are breaking.
+func Diff(old, new *Bundle) []Change {
+	var d differ
+
+	newMethods := make(map[string]MethodDefinition, len(new.Methods))
+	for _, method := range new.Methods {
+		newMethods[method.Name] = method
+	}
+	oldMethods := make(map[string]bool, len(old.Methods))
+	for _, method := range old.Methods {
+		oldMethods[method.Name] = true
+		if newMethod, ok := newMethods[method.Name]; ok {
+			d.method(method, newMethod)
+		} else {
+			d.add(MethodRemoved, method.Name, true, "", "")
+		}
+	}
+	for _, method := range new.Methods {
+		if !oldMethods[method.Name] {
+			d.add(MethodAdded, method.Name, false, "", "")
+		}
+	}
+
+	d.definitions(old.definitions(), new.definitions())
+	return d.changes
+}
+
+// definitions lists the objects and responses together.
+func (b *Bundle) definitions() []ObjectDefinition {
+	defs := append([]ObjectDefinition(nil), b.Objects...)
+	for _, resp := range b.Responses {
+		defs = append(defs, ObjectDefinition{Name: resp.Name, Expr: resp.Expr.ObjectExpr})
+	}
+	return defs
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(kind ChangeKind, path string, breaking bool, old, new string) {
+	d.changes = append(d.changes, Change{Kind: kind, Path: path, Breaking: breaking, Old: old, New: new})
+}
+
+func (d *differ) method(old, new MethodDefinition) {
+	newParams := make(map[string]MethodParam, len(new.Parameters))
+	for _, param := range new.Parameters {
+		newParams[param.Name] = param
+	}
+	oldParams := make(map[string]bool, len(old.Parameters))
+	for _, param := range old.Parameters {
+		oldParams[param.Name] = true
+		path := old.Name + "/" + param.Name
+		newParam, ok := newParams[param.Name]
+		if !ok {
+			d.add(ParamRemoved, path, true, "", "")
+			continue
+		}
+		if oldType, newType := TypeString(param.ObjectExpr), TypeString(newParam.ObjectExpr); oldType != newType {
+			d.add(ParamTypeChanged, path, true, oldType, newType)
+		}
+		if !param.Required && newParam.Required {
+			d.add(ParamRequired, path, true, "", "")
+		}
+		if param.Required && !newParam.Required {
+			d.add(ParamOptional, path, false, "", "")
+		}
+		d.enum(path, param.ObjectExpr, newParam.ObjectExpr)
+	}
+	for _, param := range new.Parameters {
+		if !oldParams[param.Name] {
+			d.add(ParamAdded, new.Name+"/"+param.Name, param.Required, "", TypeString(param.ObjectExpr))
+		}
+	}
+
+	removed, added := setDiff(old.AccessType, new.AccessType)
+	for _, access := range removed {
+		d.add(AccessRemoved, old.Name, true, access, "")
+	}
+	for _, access := range added {
+		d.add(AccessAdded, old.Name, false, "", access)
+	}
+
+	oldResponses := make(map[string]ObjectExpr, len(old.Responses))
+	for _, resp := range old.Responses {
+		oldResponses[resp.Name] = resp.Expr
+	}
+	newResponses := make(map[string]bool, len(new.Responses))
+	for _, resp := range new.Responses {
+		newResponses[resp.Name] = true
+		path := new.Name + "/" + resp.Name
+		oldExpr, ok := oldResponses[resp.Name]
+		if !ok {
+			d.add(ResponseAdded, path, false, "", TypeString(resp.Expr))
+			continue
+		}
+		if oldType, newType := TypeString(oldExpr), TypeString(resp.Expr); oldType != newType {
+			d.add(ResponseChanged, path, true, oldType, newType)
+		}
+	}
+	for _, resp := range old.Responses {
+		if !newResponses[resp.Name] {
+			d.add(ResponseRemoved, old.Name+"/"+resp.Name, true, TypeString(resp.Expr), "")
+		}
+	}
+}
+
+func (d *differ) definitions(old, new []ObjectDefinition) {
+	newDefs := make(map[string]ObjectExpr, len(new))
+	for _, def := range new {
+		newDefs[def.Name] = def.Expr
+	}
+	oldDefs := make(map[string]bool, len(old))
+	for _, def := range old {
+		oldDefs[def.Name] = true
+		newExpr, ok := newDefs[def.Name]
+		switch {
+		case !ok:
+			d.add(ObjectRemoved, def.Name, true, "", "")
+		case TypeString(def.Expr) != TypeString(newExpr) && !(isObject(def.Expr) && isObject(newExpr)):
+			d.add(ObjectTypeChanged, def.Name, true, TypeString(def.Expr), TypeString(newExpr))
+		default:
+			d.expr(def.Name, def.Expr, newExpr)
+		}
+	}
+	for _, def := range new {
+		if !oldDefs[def.Name] {
+			d.add(ObjectAdded, def.Name, false, "", "")
+		}
+	}
+}
+
+// isObject reports whether the expression is an object compared field by
+// field, whatever its composition.
+func isObject(expr ObjectExpr) bool {
+	return expr.Type == "object" || expr.IsAllOf || len(expr.Properties) > 0
+}
+
+// expr compares the fields of two versions of an object, descending into
+// inline objects and arrays of them.
+func (d *differ) expr(path string, old, new ObjectExpr) {
+	d.enum(path, old, new)
+	if old.ArrayOf != nil && new.ArrayOf != nil {
+		d.expr(path+"[]", *old.ArrayOf, *new.ArrayOf)
+		return
+	}
+
+	oldFields, newFields := fields(old), fields(new)
+	for _, field := range oldFields {
+		fieldPath := path + "/" + field.Name
+		newExpr, ok := findField(newFields, field.Name)
+		if !ok {
+			d.add(FieldRemoved, fieldPath, true, "", "")
+			continue
+		}
+		if oldType, newType := TypeString(field.Expr), TypeString(newExpr); oldType != newType {
+			d.add(FieldTypeChanged, fieldPath, true, oldType, newType)
+			continue
+		}
+		d.expr(fieldPath, field.Expr, newExpr)
+	}
+	for _, field := range newFields {
+		if _, ok := findField(oldFields, field.Name); !ok {
+			d.add(FieldAdded, path+"/"+field.Name, false, "", TypeString(field.Expr))
+		}
+	}
+}
+
+func (d *differ) enum(path string, old, new ObjectExpr) {
+	removed, added := setDiff(enumValues(old), enumValues(new))
+	for _, value := range removed {
+		d.add(EnumValueRemoved, path, true, value, "")
+	}
+	for _, value := range added {
+		d.add(EnumValueAdded, path, false, "", value)
+	}
+}
+
+// fields returns the properties of the object, including those of its
+// inline allOf parts.
+func fields(expr ObjectExpr) []ObjectDefinition {
+	props := append([]ObjectDefinition(nil), expr.Properties...)
+	for _, part := range expr.AllOf {
+		if !part.IsReference {
+			props = append(props, fields(part)...)
+		}
+	}
+	return props
+}
+
+func findField(fields []ObjectDefinition, name string) (ObjectExpr, bool) {
+	for _, field := range fields {
+		if field.Name == name {
+			return field.Expr, true
+		}
+	}
+	return ObjectExpr{}, false
+}
+
+func enumValues(expr ObjectExpr) []string {
+	values := make([]string, len(expr.Enum))
+	for i, value := range expr.Enum {
+		values[i] = fmt.Sprint(value)
+	}
+	return values
+}
+
+// setDiff returns the elements of a missing from b and of b missing from a.
+func setDiff(a, b []string) (removed, added []string) {
+	in := func(s []string, v string) bool {
+		for _, e := range s {
+			if e == v {
+				return true
+			}
+		}
+		return false
+	}
+	for _, v := range a {
+		if !in(b, v) {
+			removed = append(removed, v)
+		}
+	}
+	for _, v := range b {
+		if !in(a, v) {
+			added = append(added, v)
+		}
+	}
+	return removed, added
+}
+
+// TypeString describes the type of the expression: the referenced
+// definition, the JSON type or a composition of them.
+func TypeString(expr ObjectExpr) string {
+	switch {
+	case expr.IsReference:
+		return expr.RefName
+	case expr.ArrayOf != nil:
+		return "array of " + TypeString(*expr.ArrayOf)
+	case expr.IsAllOf:
+		return "allOf(" + typeStrings(expr.AllOf) + ")"
+	case expr.IsOneOf:
+		return "oneOf(" + typeStrings(expr.OneOf) + ")"
+	case expr.Type == "":
+		return "any"
+	case strings.HasPrefix(expr.Type, "["):
+		// a list of types, keep it independent of the formatting
+		var types []string
+		for _, typ := range gjson.Parse(expr.Type).Array() {
+			types = append(types, typ.String())
+		}
+		return strings.Join(types, " | ")
+	}
+	return expr.Type
+}
+
+func typeStrings(exprs []ObjectExpr) string {
+	types := make([]string, len(exprs))
+	for i, expr := range exprs {
+		types[i] = TypeString(expr)
+	}
+	return strings.Join(types, ", ")
+}
@@ -0,0 +1,156 @@
+package schema
+
+import (
+	"testing"
+)
+
+const (
+	diffObjects = `
+		"users_user": {"type": "object", "properties": {
+			"id": {"type": "integer"},
+			"sex": {"type": "integer", "enum": [0, 1, 2]},
+			"city": {"type": "object", "properties": {"title": {"type": "string"}}}
+		}}`
+	diffResponses = `
+		"users_get_response": {"type": "object", "properties": {"response": {
+			"type": "array", "items": {"$ref": "objects.json#/definitions/users_user"}
+		}}}`
+	diffMethods = `{
+		"name": "users.get",
+		"access_token_type": ["user", "group"],
+		"parameters": [
+			{"name": "user_ids", "type": "string"},
+			{"name": "name_case", "type": "string", "enum": ["nom", "gen"]}
+		],
+		"responses": {"response": {"$ref": "responses.json#/definitions/users_get_response"}}
+	}`
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name      string
+		objects   string
+		responses string
+		methods   string
+		want      []Change
+	}{
+		{
+			name: "unchanged",
+		},
+		{
+			name:    "method added",
+			methods: diffMethods + `,{"name": "users.search", "access_token_type": ["user"], "parameters": [], "responses": {}}`,
+			want:    []Change{{Kind: MethodAdded, Path: "users.search"}},
+		},
+		{
+			name:    "method removed",
+			methods: `{"name": "users.search", "access_token_type": ["user"], "parameters": [], "responses": {}}`,
+			want: []Change{
+				{Kind: MethodRemoved, Path: "users.get", Breaking: true},
+				{Kind: MethodAdded, Path: "users.search"},
+			},
+		},
+		{
+			name: "params",
+			methods: `{
+				"name": "users.get",
+				"access_token_type": ["user", "service"],
+				"parameters": [
+					{"name": "user_ids", "type": "array", "items": {"type": "string"}, "required": true},
+					{"name": "name_case", "type": "string", "enum": ["nom", "dat"]},
+					{"name": "fields", "type": "string"}
+				],
+				"responses": {"response": {"$ref": "responses.json#/definitions/users_get_response"}}
+			}`,
+			want: []Change{
+				{Kind: ParamTypeChanged, Path: "users.get/user_ids", Breaking: true, Old: "string", New: "array of string"},
+				{Kind: ParamRequired, Path: "users.get/user_ids", Breaking: true},
+				{Kind: EnumValueRemoved, Path: "users.get/name_case", Breaking: true, Old: "gen"},
+				{Kind: EnumValueAdded, Path: "users.get/name_case", New: "dat"},
+				{Kind: ParamAdded, Path: "users.get/fields", New: "string"},
+				{Kind: AccessRemoved, Path: "users.get", Breaking: true, Old: "group"},
+				{Kind: AccessAdded, Path: "users.get", New: "service"},
+			},
+		},
+		{
+			name: "response variants",
+			methods: `{
+				"name": "users.get",
+				"access_token_type": ["user", "group"],
+				"parameters": [
+					{"name": "user_ids", "type": "string"},
+					{"name": "name_case", "type": "string", "enum": ["nom", "gen"]}
+				],
+				"responses": {"extendedResponse": {"$ref": "responses.json#/definitions/users_get_response"}}
+			}`,
+			want: []Change{
+				{Kind: ResponseAdded, Path: "users.get/extendedResponse", New: "users_get_response"},
+				{Kind: ResponseRemoved, Path: "users.get/response", Breaking: true, Old: "users_get_response"},
+			},
+		},
+		{
+			name: "fields",
+			objects: `
+				"users_user": {"type": "object", "properties": {
+					"id": {"type": "string"},
+					"sex": {"type": "integer", "enum": [0, 1, 2, 3]},
+					"city": {"type": "object", "properties": {"name": {"type": "string"}}}
+				}},
+				"users_fields": {"type": "string"}`,
+			want: []Change{
+				{Kind: FieldTypeChanged, Path: "users_user/id", Breaking: true, Old: "integer", New: "string"},
+				{Kind: EnumValueAdded, Path: "users_user/sex", New: "3"},
+				{Kind: FieldRemoved, Path: "users_user/city/title", Breaking: true},
+				{Kind: FieldAdded, Path: "users_user/city/name", New: "string"},
+				{Kind: ObjectAdded, Path: "users_fields"},
+			},
+		},
+		{
+			name:      "response type",
+			responses: `"users_get_response": {"type": "object", "properties": {"response": {"$ref": "objects.json#/definitions/users_user"}}}`,
+			want: []Change{
+				{Kind: ObjectTypeChanged, Path: "users_get_response", Breaking: true, Old: "array of users_user", New: "users_user"},
+			},
+		},
+	}
+	old := parseBundle(t, diffObjects, diffResponses, diffMethods)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects, responses, methods := diffObjects, diffResponses, diffMethods
+			if tt.objects != "" {
+				objects = tt.objects
+			}
+			if tt.responses != "" {
+				responses = tt.responses
+			}
+			if tt.methods != "" {
+				methods = tt.methods
+			}
+			changes := Diff(old, parseBundle(t, objects, responses, methods))
+			if len(changes) != len(tt.want) {
+				t.Fatalf("Diff() = %v, want %v", changes, tt.want)
+			}
+			for i := range tt.want {
+				if changes[i] != tt.want[i] {
+					t.Errorf("change %d = %+v, want %+v", i, changes[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestChangeString(t *testing.T) {
+	tests := []struct {
+		change Change
+		want   string
+	}{
+		{Change{Kind: MethodAdded, Path: "users.search"}, "method-added users.search"},
+		{Change{Kind: AccessRemoved, Path: "users.get", Old: "group"}, "access-removed users.get: group"},
+		{Change{Kind: ParamTypeChanged, Path: "users.get/user_ids", Old: "string", New: "integer"}, "param-type-changed users.get/user_ids: string -> integer"},
+	}
+	for _, tt := range tests {
+		if got := tt.change.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LockFile is the name of the lock file Fetch writes next to the schema.
+const LockFile = "schema.lock"
+
+// Lock pins the fetched schema files by their content hashes.
+type Lock struct {
+	Ref   string            `json:"ref"`
+	Files map[string]string `json:"files"` // file name to "sha256:<hex>"
+}
+
+// ReadLock reads the lock file of the schema directory. It returns nil if
+// there is none.
+func ReadLock(dir string) (*Lock, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, LockFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var lock Lock
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("%s: %w", LockFile, err)
+	}
+	return &lock, nil
+}
+
+// Write writes the lock file into the schema directory.
+func (l *Lock) Write(dir string) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, LockFile), append(data, '\n'), 0644)
+}
+
+// Verify checks the schema files in the directory against the lock.
+func (l *Lock) Verify(dir string) error {
+	for name, sum := range l.Files {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		if got := contentHash(data); got != sum {
+			return fmt.Errorf("%s: hash %s does not match locked %s", name, got, sum)
+		}
+	}
+	return nil
+}
+
+// Fetch downloads the schema files at the ref, a tag or a commit, from
+// baseURL (RepoURL for upstream) into the directory and locks them. When
+// the directory is already locked at the same ref, the downloaded files
+// must match the lock unless update is set. The downloads stop when ctx is
+// done.
+func Fetch(ctx context.Context, baseURL, ref, dir string, update bool) (*Lock, error) {
+	old, err := ReadLock(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	lock := &Lock{Ref: ref, Files: make(map[string]string)}
+	files := make(map[string][]byte)
+	for _, file := range SchemaFiles {
+		name := string(file)
+		data, err := downloadSchemeFromURL(ctx, strings.TrimSuffix(baseURL, "/")+"/"+ref+"/"+name)
+		if err != nil {
+			return nil, err
+		}
+		files[name] = data
+		lock.Files[name] = contentHash(data)
+
+		if old != nil && old.Ref == ref && !update {
+			if sum, ok := old.Files[name]; ok && sum != lock.Files[name] {
+				return nil, fmt.Errorf("%s at %s: hash %s does not match locked %s", name, ref, lock.Files[name], sum)
+			}
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			return nil, err
+		}
+	}
+	return lock, lock.Write(dir)
+}
+
+func contentHash(data []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// schemaRepo is a local stand-in of the raw files of the schema repo,
+// serving the files by ref.
+type schemaRepo struct {
+	mu    sync.Mutex
+	files map[string]string // "ref/file" to content
+}
+
+func (r *schemaRepo) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	content, ok := r.files[strings.TrimPrefix(req.URL.Path, "/")]
+	r.mu.Unlock()
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	_, _ = w.Write([]byte(content))
+}
+
+func (r *schemaRepo) set(ref, content string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, file := range SchemaFiles {
+		r.files[ref+"/"+string(file)] = content
+	}
+}
+
+func TestFetch(t *testing.T) {
+	repo := &schemaRepo{files: make(map[string]string)}
+	repo.set("v1", `{"v":1}`)
+	repo.set("v2", `{"v":2}`)
+	repo.set("broken", `{"v":`)
+	srv := httptest.NewServer(repo)
+	defer srv.Close()
+	dir := tempDir(t)
+	ctx := context.Background()
+
+	lock, err := Fetch(ctx, srv.URL+"/", "v1", dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lock.Ref != "v1" || len(lock.Files) != len(SchemaFiles) {
+		t.Errorf("lock = %+v", lock)
+	}
+	written, err := ReadLock(dir)
+	if err != nil || written == nil || written.Files[string(MethodsSchema)] != contentHash([]byte(`{"v":1}`)) {
+		t.Fatalf("ReadLock() = %+v, %v", written, err)
+	}
+	if err := written.Verify(dir); err != nil {
+		t.Error(err)
+	}
+
+	tests := []struct {
+		name   string
+		ref    string
+		update bool
+		err    string
+	}{
+		{"same files", "v1", false, ""},
+		{"missing ref", "v3", false, "404"},
+		{"invalid JSON", "broken", false, "invalid JSON"},
+		{"changed upstream", "v1", false, "does not match locked"},
+		{"changed upstream updated", "v1", true, ""},
+		{"other ref", "v2", false, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.name == "changed upstream" {
+				repo.set("v1", `{"v":"changed"}`)
+			}
+			_, err := Fetch(ctx, srv.URL, tt.ref, dir, tt.update)
+			if tt.err == "" && err != nil || tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)) {
+				t.Errorf("Fetch(%s) error = %v, want %q", tt.ref, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestFetchCanceled(t *testing.T) {
+	srv := httptest.NewServer(&schemaRepo{files: make(map[string]string)})
+	defer srv.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Fetch(ctx, srv.URL, "v1", tempDir(t), false); err == nil || !strings.Contains(err.Error(), "canceled") {
+		t.Errorf("Fetch() error = %v", err)
+	}
+}
+
+func TestLockVerify(t *testing.T) {
+	dir := tempDir(t)
+	data := []byte(`{"methods":[]}`)
+	if err := ioutil.WriteFile(filepath.Join(dir, string(MethodsSchema)), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	lock := &Lock{Ref: "v1", Files: map[string]string{string(MethodsSchema): contentHash(data)}}
+	if err := lock.Verify(dir); err != nil {
+		t.Error(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, string(MethodsSchema)), []byte(`{}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := lock.Verify(dir); err == nil {
+		t.Error("Verify() accepted a changed file")
+	}
+}
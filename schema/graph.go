@@ -0,0 +1,157 @@
+package schema
+
+import (
+	"sort"
+	"strings"
+)
+
+// GraphNode is a method or definition of the reference graph.
+type GraphNode struct {
+	Name string `json:"name"`
+	Kind string `json:"kind"`
+	// Cyclic marks the nodes of a reference cycle.
+	Cyclic bool `json:"cyclic,omitempty"`
+}
+
+// GraphEdge is a reference between two nodes.
+type GraphEdge struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Cyclic bool   `json:"cyclic,omitempty"`
+}
+
+// Graph is the reference graph between methods, responses and objects.
+type Graph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+	// Cycles are the strongly connected components of more than one node
+	// and the self-referencing definitions.
+	Cycles [][]string `json:"cycles"`
+}
+
+// Graph returns the graph reachable from the roots, all of it without
+// roots, following up to maxDepth references (unlimited when zero). A
+// root is a method or definition name, or a method namespace such as
+// "messages".
+func (r *References) Graph(roots []string, maxDepth int) *Graph {
+	depth := make(map[string]int)
+	var queue []string
+	visit := func(name string, d int) {
+		if _, ok := depth[name]; !ok {
+			depth[name] = d
+			queue = append(queue, name)
+		}
+	}
+	for _, name := range r.order {
+		if len(roots) == 0 {
+			visit(name, 0)
+			continue
+		}
+		for _, root := range roots {
+			if name == root || r.kinds[name] == UsageMethod && strings.HasPrefix(name, root+".") {
+				visit(name, 0)
+			}
+		}
+	}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if maxDepth > 0 && depth[name] >= maxDepth {
+			continue
+		}
+		for _, used := range r.Uses(name) {
+			visit(used, depth[name]+1)
+		}
+	}
+
+	g := &Graph{Cycles: [][]string{}}
+	component := r.components()
+	size := make(map[int]int)
+	for _, c := range component {
+		size[c]++
+	}
+	cyclic := func(from, to string) bool {
+		return component[from] == component[to] && (size[component[from]] > 1 || from == to)
+	}
+
+	cycles := make(map[int][]string)
+	for _, name := range r.order {
+		if _, ok := depth[name]; !ok {
+			continue
+		}
+		node := GraphNode{Name: name, Kind: r.kinds[name]}
+		for _, used := range r.Uses(name) {
+			if _, ok := depth[used]; !ok {
+				continue
+			}
+			edge := GraphEdge{From: name, To: used, Cyclic: cyclic(name, used)}
+			node.Cyclic = node.Cyclic || edge.Cyclic
+			g.Edges = append(g.Edges, edge)
+		}
+		if size[component[name]] > 1 {
+			node.Cyclic = true
+		}
+		if node.Cyclic {
+			cycles[component[name]] = append(cycles[component[name]], name)
+		}
+		g.Nodes = append(g.Nodes, node)
+	}
+	for _, names := range cycles {
+		sort.Strings(names)
+		g.Cycles = append(g.Cycles, names)
+	}
+	sort.Slice(g.Cycles, func(i, j int) bool {
+		return g.Cycles[i][0] < g.Cycles[j][0]
+	})
+	return g
+}
+
+// components numbers the strongly connected components of the whole
+// graph, using Tarjan's algorithm.
+func (r *References) components() map[string]int {
+	index := make(map[string]int)
+	lowlink := make(map[string]int)
+	onStack := make(map[string]bool)
+	component := make(map[string]int)
+	var stack []string
+	next, count := 0, 0
+
+	var connect func(name string)
+	connect = func(name string) {
+		index[name] = next
+		lowlink[name] = next
+		next++
+		stack = append(stack, name)
+		onStack[name] = true
+
+		for _, used := range r.Uses(name) {
+			if _, ok := index[used]; !ok {
+				connect(used)
+				if lowlink[used] < lowlink[name] {
+					lowlink[name] = lowlink[used]
+				}
+			} else if onStack[used] && index[used] < lowlink[name] {
+				lowlink[name] = index[used]
+			}
+		}
+
+		if lowlink[name] == index[name] {
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				component[top] = count
+				if top == name {
+					break
+				}
+			}
+			count++
+		}
+	}
+	for _, name := range r.order {
+		if _, ok := index[name]; !ok {
+			connect(name)
+		}
+	}
+	return component
+}
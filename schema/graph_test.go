@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+const (
+	graphObjects = `
+		"base_city": {"type": "string"},
+		"users_user": {"type": "object", "properties": {"city": {"$ref": "objects.json#/definitions/base_city"}}},
+		"wall_comment": {"type": "object", "properties": {"thread": {"$ref": "objects.json#/definitions/wall_thread"}}},
+		"wall_thread": {"type": "object", "properties": {"items": {
+			"type": "array", "items": {"$ref": "objects.json#/definitions/wall_comment"}
+		}}},
+		"base_tree": {"type": "object", "properties": {"children": {
+			"type": "array", "items": {"$ref": "objects.json#/definitions/base_tree"}
+		}}}`
+	graphResponses = `
+		"users_get_response": {"type": "object", "properties": {"response": {
+			"type": "array", "items": {"$ref": "objects.json#/definitions/users_user"}
+		}}},
+		"wall_get_comments_response": {"type": "object", "properties": {"response": {
+			"$ref": "objects.json#/definitions/wall_comment"
+		}}}`
+	graphMethods = `{
+		"name": "users.get",
+		"responses": {"response": {"$ref": "responses.json#/definitions/users_get_response"}}
+	}, {
+		"name": "users.search",
+		"parameters": [{"name": "city", "$ref": "objects.json#/definitions/base_city"}],
+		"responses": {"response": {"$ref": "responses.json#/definitions/users_get_response"}}
+	}, {
+		"name": "wall.getComments",
+		"responses": {"response": {"$ref": "responses.json#/definitions/wall_get_comments_response"}}
+	}`
+)
+
+func TestGraph(t *testing.T) {
+	refs := parseBundle(t, graphObjects, graphResponses, graphMethods).References()
+	tests := []struct {
+		name     string
+		roots    []string
+		maxDepth int
+		nodes    []string // cyclic nodes end with *
+		edges    []string // cyclic edges end with *
+		cycles   [][]string
+	}{
+		{
+			name: "whole schema",
+			nodes: []string{
+				"base_city", "base_tree*", "users.get", "users.search", "users_get_response",
+				"users_user", "wall.getComments", "wall_comment*", "wall_get_comments_response", "wall_thread*",
+			},
+			edges: []string{
+				"base_tree->base_tree*", "users.get->users_get_response", "users.search->base_city",
+				"users.search->users_get_response", "users_get_response->users_user", "users_user->base_city",
+				"wall.getComments->wall_get_comments_response", "wall_comment->wall_thread*",
+				"wall_get_comments_response->wall_comment", "wall_thread->wall_comment*",
+			},
+			cycles: [][]string{{"base_tree"}, {"wall_comment", "wall_thread"}},
+		},
+		{
+			name:   "namespace",
+			roots:  []string{"users"},
+			nodes:  []string{"base_city", "users.get", "users.search", "users_get_response", "users_user"},
+			edges:  []string{"users.get->users_get_response", "users.search->base_city", "users.search->users_get_response", "users_get_response->users_user", "users_user->base_city"},
+			cycles: [][]string{},
+		},
+		{
+			name:     "depth",
+			roots:    []string{"wall.getComments"},
+			maxDepth: 2,
+			nodes:    []string{"wall.getComments", "wall_comment*", "wall_get_comments_response"},
+			edges:    []string{"wall.getComments->wall_get_comments_response", "wall_get_comments_response->wall_comment"},
+			cycles:   [][]string{{"wall_comment"}},
+		},
+		{
+			name:   "cycle",
+			roots:  []string{"wall_thread"},
+			nodes:  []string{"wall_comment*", "wall_thread*"},
+			edges:  []string{"wall_comment->wall_thread*", "wall_thread->wall_comment*"},
+			cycles: [][]string{{"wall_comment", "wall_thread"}},
+		},
+		{
+			name:   "self reference",
+			roots:  []string{"base_tree", "base_city"},
+			nodes:  []string{"base_city", "base_tree*"},
+			edges:  []string{"base_tree->base_tree*"},
+			cycles: [][]string{{"base_tree"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := refs.Graph(tt.roots, tt.maxDepth)
+			var nodes, edges []string
+			for _, node := range g.Nodes {
+				nodes = append(nodes, node.Name+mark(node.Cyclic))
+			}
+			for _, edge := range g.Edges {
+				edges = append(edges, edge.From+"->"+edge.To+mark(edge.Cyclic))
+			}
+			sort.Strings(nodes)
+			sort.Strings(edges)
+			if !reflect.DeepEqual(nodes, tt.nodes) {
+				t.Errorf("nodes = %q, want %q", nodes, tt.nodes)
+			}
+			if !reflect.DeepEqual(edges, tt.edges) {
+				t.Errorf("edges = %q, want %q", edges, tt.edges)
+			}
+			if !reflect.DeepEqual(g.Cycles, tt.cycles) {
+				t.Errorf("cycles = %q, want %q", g.Cycles, tt.cycles)
+			}
+		})
+	}
+}
+
+func mark(cyclic bool) string {
+	if cyclic {
+		return "*"
+	}
+	return ""
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	return dir
+}
+
+// writeSchema writes inline schemas, the objects and responses definitions
+// and the methods, into a directory.
+func writeSchema(t *testing.T, objects, responses, methods string) string {
+	t.Helper()
+	dir := tempDir(t)
+	files := map[SchemaType]string{
+		ObjectsSchema:   `{"title":"objects","definitions":{` + objects + `}}`,
+		ResponsesSchema: `{"title":"responses","definitions":{` + responses + `}}`,
+		MethodsSchema:   `{"methods":[` + methods + `]}`,
+	}
+	for file, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, string(file)), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// parseBundle loads the bundle of inline schemas as writeSchema takes them.
+func parseBundle(t *testing.T, objects, responses, methods string) *Bundle {
+	t.Helper()
+	b, err := LoadDir(writeSchema(t, objects, responses, methods))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
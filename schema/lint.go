@@ -0,0 +1,323 @@
+package schema
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// Lint rules.
+const (
+	RuleDanglingRef        = "dangling-ref"
+	RuleMissingType        = "missing-type"
+	RuleResponseProperty   = "response-property"
+	RuleArrayItems         = "array-items"
+	RuleEnumNames          = "enum-names"
+	RuleAllOfConflict      = "allof-conflict"
+	RuleOneOfDiscriminator = "oneof-discriminator"
+	RuleUnused             = "unused"
+	RuleGoifyCollision     = "goify-collision"
+)
+
+// Problem is a lint finding at a JSON pointer of a schema file.
+type Problem struct {
+	File    string `json:"file"`
+	Pointer string `json:"pointer"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (p Problem) String() string {
+	return p.File + "#" + p.Pointer + ": " + p.Rule + ": " + p.Message
+}
+
+// Pointer returns the JSON pointer of the path elements.
+func Pointer(elems ...string) string {
+	var b strings.Builder
+	for _, elem := range elems {
+		elem = strings.ReplaceAll(elem, "~", "~0")
+		elem = strings.ReplaceAll(elem, "/", "~1")
+		b.WriteString("/" + elem)
+	}
+	return b.String()
+}
+
+// linter walks the raw schema documents, which keep what the parser
+// rejects or drops.
+type linter struct {
+	docs     map[string]gjson.Result
+	problems []Problem
+	// uses maps the definitions and methods to the definitions they
+	// reference.
+	uses map[string][]string
+}
+
+// Lint checks the methods, objects and responses schemas in dir.
+func Lint(dir string) ([]Problem, error) {
+	l := &linter{
+		docs: make(map[string]gjson.Result),
+		uses: make(map[string][]string),
+	}
+	for _, file := range []SchemaType{MethodsSchema, ObjectsSchema, ResponsesSchema} {
+		data, err := ioutil.ReadFile(filepath.Join(dir, string(file)))
+		if err != nil {
+			return nil, err
+		}
+		l.docs[string(file)] = gjson.ParseBytes(data)
+	}
+
+	for i, method := range l.docs[string(MethodsSchema)].Get("methods").Array() {
+		name := method.Get("name").String()
+		for j, param := range method.Get("parameters").Array() {
+			l.expr(string(MethodsSchema), Pointer("methods", fmt.Sprint(i), "parameters", fmt.Sprint(j)), name, param)
+		}
+		method.Get("responses").ForEach(func(key, resp gjson.Result) bool {
+			l.expr(string(MethodsSchema), Pointer("methods", fmt.Sprint(i), "responses", key.String()), name, resp)
+			return true
+		})
+	}
+	for _, file := range []SchemaType{ObjectsSchema, ResponsesSchema} {
+		l.docs[string(file)].Get("definitions").ForEach(func(key, def gjson.Result) bool {
+			pointer := Pointer("definitions", key.String())
+			if file == ResponsesSchema && !def.Get("properties.response").Exists() {
+				l.report(string(file), pointer, RuleResponseProperty, "response definition without a response property")
+			}
+			l.expr(string(file), pointer, key.String(), def)
+			return true
+		})
+	}
+	l.unused()
+	return l.problems, nil
+}
+
+func (l *linter) report(file, pointer, rule, format string, args ...interface{}) {
+	l.problems = append(l.problems, Problem{File: file, Pointer: pointer, Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+// resolve returns the definition the reference points to and its name.
+func (l *linter) resolve(ref string) (gjson.Result, string, bool) {
+	i := strings.Index(ref, "#")
+	if i < 0 {
+		return gjson.Result{}, "", false
+	}
+	doc, ok := l.docs[ref[:i]]
+	if !ok {
+		return gjson.Result{}, "", false
+	}
+	node := doc
+	for _, elem := range strings.Split(strings.TrimPrefix(ref[i+1:], "/"), "/") {
+		elem = strings.ReplaceAll(strings.ReplaceAll(elem, "~1", "/"), "~0", "~")
+		node = node.Get(gjsonEscape(elem))
+		if !node.Exists() {
+			return node, "", false
+		}
+	}
+	return node, resolveReferenceName(ref), true
+}
+
+func gjsonEscape(key string) string {
+	var b strings.Builder
+	for _, r := range key {
+		if strings.ContainsRune(`.*?|#@\`, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// expr checks the schema node at the pointer, owned by the definition or
+// method owner.
+func (l *linter) expr(file, pointer, owner string, node gjson.Result) {
+	if ref := node.Get(`\$ref`); ref.Exists() {
+		if _, name, ok := l.resolve(ref.String()); ok {
+			l.uses[owner] = append(l.uses[owner], name)
+		} else {
+			l.report(file, pointer, RuleDanglingRef, "%s does not resolve", ref.String())
+		}
+		return
+	}
+
+	if allOf := node.Get("allOf"); allOf.Exists() {
+		parts := allOf.Array()
+		if len(parts) == 0 {
+			l.report(file, pointer, RuleAllOfConflict, "empty allOf")
+		}
+		for i, part := range parts {
+			partPointer := pointer + Pointer("allOf", fmt.Sprint(i))
+			if !part.Get("type").Exists() && part.Get("properties").Exists() {
+				// an object fragment, typed by the enclosing allOf
+				l.properties(file, partPointer, owner, part)
+				continue
+			}
+			l.expr(file, partPointer, owner, part)
+		}
+		l.allOf(file, pointer, parts)
+		return
+	}
+
+	if oneOf := node.Get("oneOf"); oneOf.Exists() {
+		variants := oneOf.Array()
+		for i, variant := range variants {
+			l.expr(file, pointer+Pointer("oneOf", fmt.Sprint(i)), owner, variant)
+		}
+		l.oneOf(file, pointer, variants)
+		return
+	}
+
+	typ := node.Get("type")
+	if !typ.Exists() {
+		l.report(file, pointer, RuleMissingType, "no type, $ref, allOf or oneOf")
+	}
+	if typ.String() == "array" {
+		if items := node.Get("items"); items.Exists() {
+			l.expr(file, pointer+"/items", owner, items)
+		} else {
+			l.report(file, pointer, RuleArrayItems, "array without items")
+		}
+	}
+
+	if enum, names := node.Get("enum"), node.Get("enumNames"); enum.Exists() && names.Exists() {
+		if n, m := len(enum.Array()), len(names.Array()); n != m {
+			l.report(file, pointer, RuleEnumNames, "%d enum values but %d enumNames", n, m)
+		}
+	}
+
+	l.properties(file, pointer, owner, node)
+}
+
+func (l *linter) properties(file, pointer, owner string, node gjson.Result) {
+	node.Get("properties").ForEach(func(key, prop gjson.Result) bool {
+		l.expr(file, pointer+Pointer("properties", key.String()), owner, prop)
+		return true
+	})
+}
+
+// propertyTypes lists the properties of the schema node, following refs,
+// with their types.
+func (l *linter) propertyTypes(node gjson.Result, depth int) map[string]string {
+	props := make(map[string]string)
+	if depth > 8 {
+		return props
+	}
+	if ref := node.Get(`\$ref`); ref.Exists() {
+		if def, _, ok := l.resolve(ref.String()); ok {
+			return l.propertyTypes(def, depth+1)
+		}
+		return props
+	}
+	for _, part := range node.Get("allOf").Array() {
+		for name, typ := range l.propertyTypes(part, depth+1) {
+			props[name] = typ
+		}
+	}
+	node.Get("properties").ForEach(func(key, prop gjson.Result) bool {
+		typ := prop.Get(`\$ref`).String()
+		if typ == "" {
+			typ = prop.Get("type").Raw
+		}
+		props[key.String()] = typ
+		return true
+	})
+	return props
+}
+
+// allOf reports the properties the parts declare with different types.
+func (l *linter) allOf(file, pointer string, parts []gjson.Result) {
+	seen := make(map[string]string)
+	var conflicts []string
+	for _, part := range parts {
+		for name, typ := range l.propertyTypes(part, 0) {
+			if prev, ok := seen[name]; ok && prev != typ {
+				conflicts = append(conflicts, fmt.Sprintf("%s is %s and %s", name, prev, typ))
+			}
+			seen[name] = typ
+		}
+	}
+	sort.Strings(conflicts)
+	for _, conflict := range conflicts {
+		l.report(file, pointer, RuleAllOfConflict, "%s", conflict)
+	}
+}
+
+// oneOf reports the variants that can only be told apart by trying them:
+// they neither have distinct JSON types nor share a property with
+// distinct constant values.
+func (l *linter) oneOf(file, pointer string, variants []gjson.Result) {
+	if len(variants) < 2 {
+		return
+	}
+
+	resolved := make([]gjson.Result, len(variants))
+	types := make(map[string]bool)
+	for i, variant := range variants {
+		resolved[i] = variant
+		if ref := variant.Get(`\$ref`); ref.Exists() {
+			resolved[i], _, _ = l.resolve(ref.String())
+		}
+		types[resolved[i].Get("type").String()] = true
+	}
+	if len(types) == len(variants) && !types[""] {
+		return
+	}
+
+	// a discriminator property has an enum in every variant, the enums
+	// not overlapping
+	var candidates []string
+	resolved[0].Get("properties").ForEach(func(key, _ gjson.Result) bool {
+		candidates = append(candidates, key.String())
+		return true
+	})
+	for _, name := range candidates {
+		values := make(map[string]bool)
+		usable := true
+		for _, variant := range resolved {
+			enum := variant.Get("properties." + gjsonEscape(name) + ".enum")
+			if !enum.Exists() {
+				usable = false
+				break
+			}
+			for _, value := range enum.Array() {
+				if values[value.Raw] {
+					usable = false
+				}
+				values[value.Raw] = true
+			}
+		}
+		if usable {
+			return
+		}
+	}
+	l.report(file, pointer, RuleOneOfDiscriminator, "%d variants without distinct types or a discriminator property", len(variants))
+}
+
+// unused reports the definitions no method reaches.
+func (l *linter) unused() {
+	reached := make(map[string]bool)
+	var reach func(name string)
+	reach = func(name string) {
+		if reached[name] {
+			return
+		}
+		reached[name] = true
+		for _, used := range l.uses[name] {
+			reach(used)
+		}
+	}
+	for _, method := range l.docs[string(MethodsSchema)].Get("methods").Array() {
+		reach(method.Get("name").String())
+	}
+
+	for _, file := range []SchemaType{ObjectsSchema, ResponsesSchema} {
+		l.docs[string(file)].Get("definitions").ForEach(func(key, _ gjson.Result) bool {
+			if !reached[key.String()] {
+				l.report(string(file), Pointer("definitions", key.String()), RuleUnused, "not reachable from any method")
+			}
+			return true
+		})
+	}
+}
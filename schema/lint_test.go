@@ -0,0 +1,107 @@
+package schema
+
+import (
+	"strings"
+	"testing"
+)
+
+const (
+	lintResponses = `"users_get_response": {"type": "object", "properties": {"response": {"$ref": "objects.json#/definitions/users_user"}}}`
+	lintMethods   = `{"name": "users.get", "parameters": [{"name": "user_ids", "type": "string"}], "responses": {"response": {"$ref": "responses.json#/definitions/users_get_response"}}}`
+)
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name      string
+		user      string // properties of users_user
+		objects   string // more object definitions
+		responses string // more response definitions
+		want      []string
+	}{
+		{
+			name: "clean",
+			user: `"id": {"type": "integer"}, "photos": {"type": "array", "items": {"type": "string"}}`,
+		},
+		{
+			name: "dangling ref",
+			user: `"city": {"$ref": "objects.json#/definitions/base_city"}`,
+			want: []string{"objects.json#/definitions/users_user/properties/city: dangling-ref: objects.json#/definitions/base_city does not resolve"},
+		},
+		{
+			name: "missing type",
+			user: `"id": {"description": "ID"}`,
+			want: []string{"objects.json#/definitions/users_user/properties/id: missing-type: no type, $ref, allOf or oneOf"},
+		},
+		{
+			name: "array items",
+			user: `"photos": {"type": "array"}`,
+			want: []string{"objects.json#/definitions/users_user/properties/photos: array-items: array without items"},
+		},
+		{
+			name: "enum names",
+			user: `"sex": {"type": "integer", "enum": [0, 1, 2], "enumNames": ["unknown", "female"]}`,
+			want: []string{"objects.json#/definitions/users_user/properties/sex: enum-names: 3 enum values but 2 enumNames"},
+		},
+		{
+			name:    "allOf conflict",
+			user:    `"full": {"allOf": [{"$ref": "objects.json#/definitions/users_base"}, {"properties": {"id": {"type": "string"}}}]}`,
+			objects: `"users_base": {"type": "object", "properties": {"id": {"type": "integer"}}}`,
+			want:    []string{`objects.json#/definitions/users_user/properties/full: allof-conflict: id is "integer" and "string"`},
+		},
+		{
+			name: "oneOf without discriminator",
+			user: `"owner": {"oneOf": [{"type": "object", "properties": {"id": {"type": "integer"}}}, {"type": "object", "properties": {"name": {"type": "string"}}}]}`,
+			want: []string{"objects.json#/definitions/users_user/properties/owner: oneof-discriminator: 2 variants without distinct types or a discriminator property"},
+		},
+		{
+			name: "oneOf with distinct types",
+			user: `"owner": {"oneOf": [{"type": "integer"}, {"type": "string"}]}`,
+		},
+		{
+			name: "oneOf with discriminator",
+			user: `"owner": {"oneOf": [{"type": "object", "properties": {"type": {"type": "string", "enum": ["user"]}}}, {"type": "object", "properties": {"type": {"type": "string", "enum": ["group"]}}}]}`,
+		},
+		{
+			name:    "unused",
+			objects: `"users_city": {"type": "string"}`,
+			want:    []string{"objects.json#/definitions/users_city: unused: not reachable from any method"},
+		},
+		{
+			name:      "response property",
+			responses: `"users_search_response": {"type": "object"}`,
+			want: []string{
+				"responses.json#/definitions/users_search_response: response-property: response definition without a response property",
+				"responses.json#/definitions/users_search_response: unused: not reachable from any method",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := `"users_user": {"type": "object", "properties": {` + tt.user + `}}`
+			if tt.objects != "" {
+				objects += "," + tt.objects
+			}
+			responses := lintResponses
+			if tt.responses != "" {
+				responses += "," + tt.responses
+			}
+			problems, err := Lint(writeSchema(t, objects, responses, lintMethods))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, problem := range problems {
+				got = append(got, problem.String())
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Lint() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestPointer(t *testing.T) {
+	if got := Pointer("definitions", "a/b", "c~d"); got != "/definitions/a~1b/c~0d" {
+		t.Errorf("Pointer() = %s", got)
+	}
+}
@@ -11,7 +11,8 @@ type MethodDefinition struct {
 }
 
 type MethodParam struct {
-	Name string
+	Name     string
+	Required bool
 	ObjectExpr
 }
 
@@ -48,6 +49,7 @@ func (p *Parser) parseMethod(method gjson.Result) (MethodDefinition, error) {
 		}
 		mdef.Parameters = append(mdef.Parameters, MethodParam{
 			Name:       param.Get("name").String(),
+			Required:   param.Get("required").Bool(),
 			ObjectExpr: paramExpr,
 		})
 	}
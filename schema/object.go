@@ -15,7 +15,9 @@ type ObjectExpr struct {
 	Type        string
 	Description *string
 	Ref         func() (ObjectDefinition, error)
+	RefName     string // name of the referenced definition
 	Properties  []ObjectDefinition
+	Required    []string
 	AllOf       []ObjectExpr
 	OneOf       []ObjectExpr
 	Enum        []interface{}
@@ -27,6 +29,15 @@ type ObjectExpr struct {
 	IsOneOf     bool
 	IsEnum      bool
 	//IsArray     bool
+
+	Default   interface{}
+	Minimum   *float64
+	Maximum   *float64
+	MinLength *int64
+	MaxLength *int64
+	MinItems  *int64
+	MaxItems  *int64
+	Format    string
 }
 
 func (p *Parser) ParseObjects(schema []byte) ([]ObjectDefinition, error) {
@@ -56,6 +67,28 @@ func (p *Parser) parseObjectExpression(obj gjson.Result) (ObjectExpr, error) {
 		expr.Description = &d
 	}
 
+	if def := obj.Get("default"); def.Exists() {
+		expr.Default = def.Value()
+	}
+	for key, bound := range map[string]**float64{"minimum": &expr.Minimum, "maximum": &expr.Maximum} {
+		if v := obj.Get(key); v.Exists() {
+			f := v.Float()
+			*bound = &f
+		}
+	}
+	for key, bound := range map[string]**int64{
+		"minLength": &expr.MinLength,
+		"maxLength": &expr.MaxLength,
+		"minItems":  &expr.MinItems,
+		"maxItems":  &expr.MaxItems,
+	} {
+		if v := obj.Get(key); v.Exists() {
+			n := v.Int()
+			*bound = &n
+		}
+	}
+	expr.Format = obj.Get("format").String()
+
 	var err error
 	if props := obj.Get("properties"); props.Exists() {
 		props.ForEach(func(propName, propData gjson.Result) bool {
@@ -76,11 +109,19 @@ func (p *Parser) parseObjectExpression(obj gjson.Result) (ObjectExpr, error) {
 		return expr, err
 	}
 
+	// method parameters use boolean "required", objects list property names
+	if req := obj.Get("required"); req.Exists() && req.IsArray() {
+		for _, name := range req.Array() {
+			expr.Required = append(expr.Required, name.String())
+		}
+	}
+
 	if ref := obj.Get("$ref"); ref.Exists() {
 		refFn := func() (ObjectDefinition, error) {
 			return p.resolveReference(ref.String())
 		}
 		expr.Ref = refFn
+		expr.RefName = resolveReferenceName(ref.String())
 		expr.IsReference = true
 		return expr, nil
 	}
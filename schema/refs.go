@@ -0,0 +1,166 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Usage kinds.
+const (
+	UsageMethod   = "method"
+	UsageResponse = "response"
+	UsageObject   = "object"
+)
+
+// Usage is a method or definition referencing a definition.
+type Usage struct {
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// Depth is 1 for direct references and grows by one with every
+	// definition in between.
+	Depth int `json:"depth"`
+	// Via is the definition the target is reached through, empty for
+	// direct references.
+	Via string `json:"via,omitempty"`
+	// Paths are the locations of the direct references within the user,
+	// such as "parameters/fields[]" or "properties/items[]".
+	Paths []string `json:"paths,omitempty"`
+}
+
+// reference is a reference to a definition at a path within its user.
+type reference struct {
+	name string
+	path string
+}
+
+// References indexes the references between methods and definitions.
+type References struct {
+	kinds map[string]string
+	uses  map[string][]reference
+	order []string // users in schema order
+}
+
+// References builds the reference index of the bundle.
+func (b *Bundle) References() *References {
+	r := &References{
+		kinds: make(map[string]string),
+		uses:  make(map[string][]reference),
+	}
+	for _, method := range b.Methods {
+		r.add(UsageMethod, method.Name)
+		for _, param := range method.Parameters {
+			r.walk(method.Name, "parameters/"+param.Name, param.ObjectExpr)
+		}
+		for _, resp := range method.Responses {
+			r.walk(method.Name, "responses/"+resp.Name, resp.Expr)
+		}
+	}
+	for _, resp := range b.Responses {
+		r.add(UsageResponse, resp.Name)
+		r.walk(resp.Name, "response", resp.Expr.ObjectExpr)
+	}
+	for _, obj := range b.Objects {
+		r.add(UsageObject, obj.Name)
+		r.walk(obj.Name, "", obj.Expr)
+	}
+	return r
+}
+
+func (r *References) add(kind, name string) {
+	r.kinds[name] = kind
+	r.order = append(r.order, name)
+}
+
+// walk records the references of the expression through refs, allOf,
+// oneOf, arrays and properties.
+func (r *References) walk(user, path string, expr ObjectExpr) {
+	join := func(elem string) string {
+		if path == "" {
+			return elem
+		}
+		return path + "/" + elem
+	}
+
+	if expr.IsReference {
+		r.uses[user] = append(r.uses[user], reference{name: expr.RefName, path: path})
+		return
+	}
+	if expr.ArrayOf != nil {
+		r.walk(user, path+"[]", *expr.ArrayOf)
+	}
+	for i, part := range expr.AllOf {
+		r.walk(user, join(fmt.Sprintf("allOf/%d", i)), part)
+	}
+	for i, variant := range expr.OneOf {
+		r.walk(user, join(fmt.Sprintf("oneOf/%d", i)), variant)
+	}
+	for _, prop := range expr.Properties {
+		r.walk(user, join("properties/"+prop.Name), prop.Expr)
+	}
+}
+
+// Uses returns the definitions the method or definition references
+// directly, in order of appearance.
+func (r *References) Uses(name string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, ref := range r.uses[name] {
+		if !seen[ref.name] {
+			seen[ref.name] = true
+			names = append(names, ref.name)
+		}
+	}
+	return names
+}
+
+// Kind returns the kind of the method or definition, empty if unknown.
+func (r *References) Kind(name string) string {
+	return r.kinds[name]
+}
+
+// WhereUsed returns the methods and definitions referencing the definition
+// directly or transitively, the closest first.
+func (r *References) WhereUsed(name string) []Usage {
+	// users maps the definitions to the users referencing them directly
+	users := make(map[string][]string)
+	for _, user := range r.order {
+		for _, used := range r.Uses(user) {
+			users[used] = append(users[used], user)
+		}
+	}
+
+	found := make(map[string]*Usage)
+	var usages []*Usage
+	queue := []string{name}
+	for len(queue) > 0 {
+		target := queue[0]
+		queue = queue[1:]
+		for _, user := range users[target] {
+			if _, ok := found[user]; ok || user == name {
+				continue
+			}
+			usage := &Usage{Kind: r.kinds[user], Name: user, Depth: 1}
+			if target != name {
+				usage.Depth = found[target].Depth + 1
+				usage.Via = target
+			}
+			for _, ref := range r.uses[user] {
+				if ref.name == target {
+					usage.Paths = append(usage.Paths, ref.path)
+				}
+			}
+			found[user] = usage
+			usages = append(usages, usage)
+			queue = append(queue, user)
+		}
+	}
+
+	result := make([]Usage, len(usages))
+	for i, usage := range usages {
+		result[i] = *usage
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Depth < result[j].Depth
+	})
+	return result
+}
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+)
+
+const (
+	refsObjects = `
+		"base_city": {"type": "string"},
+		"users_user": {"type": "object", "properties": {
+			"city": {"$ref": "objects.json#/definitions/base_city"},
+			"home_city": {"$ref": "objects.json#/definitions/base_city"}
+		}},
+		"users_fields": {"type": "string", "enum": ["sex", "city"]},
+		"wall_comment": {"type": "object", "properties": {
+			"author": {"$ref": "objects.json#/definitions/users_user"},
+			"thread": {"type": "array", "items": {"$ref": "objects.json#/definitions/wall_comment"}}
+		}}`
+	refsResponses = `
+		"users_get_response": {"type": "object", "properties": {"response": {
+			"type": "array", "items": {"$ref": "objects.json#/definitions/users_user"}
+		}}}`
+	refsMethods = `{
+		"name": "users.get",
+		"parameters": [{"name": "fields", "type": "array", "items": {"$ref": "objects.json#/definitions/users_fields"}}],
+		"responses": {"response": {"$ref": "responses.json#/definitions/users_get_response"}}
+	}`
+)
+
+func TestWhereUsed(t *testing.T) {
+	refs := parseBundle(t, refsObjects, refsResponses, refsMethods).References()
+	tests := []struct {
+		name string
+		want []Usage
+	}{
+		{
+			name: "base_city",
+			want: []Usage{
+				{Kind: UsageObject, Name: "users_user", Depth: 1, Paths: []string{"properties/city", "properties/home_city"}},
+				{Kind: UsageResponse, Name: "users_get_response", Depth: 2, Via: "users_user", Paths: []string{"response[]"}},
+				{Kind: UsageObject, Name: "wall_comment", Depth: 2, Via: "users_user", Paths: []string{"properties/author"}},
+				{Kind: UsageMethod, Name: "users.get", Depth: 3, Via: "users_get_response", Paths: []string{"responses/response"}},
+			},
+		},
+		{
+			name: "users_user",
+			want: []Usage{
+				{Kind: UsageResponse, Name: "users_get_response", Depth: 1, Paths: []string{"response[]"}},
+				{Kind: UsageObject, Name: "wall_comment", Depth: 1, Paths: []string{"properties/author"}},
+				{Kind: UsageMethod, Name: "users.get", Depth: 2, Via: "users_get_response", Paths: []string{"responses/response"}},
+			},
+		},
+		{
+			name: "users_fields",
+			want: []Usage{
+				{Kind: UsageMethod, Name: "users.get", Depth: 1, Paths: []string{"parameters/fields[]"}},
+			},
+		},
+		{
+			name: "wall_comment", // only references itself
+			want: []Usage{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := refs.WhereUsed(tt.name); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WhereUsed() =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReferencesUses(t *testing.T) {
+	refs := parseBundle(t, refsObjects, refsResponses, refsMethods).References()
+	tests := []struct {
+		name string
+		kind string
+		uses []string
+	}{
+		{"users.get", UsageMethod, []string{"users_fields", "users_get_response"}},
+		{"users_get_response", UsageResponse, []string{"users_user"}},
+		{"users_user", UsageObject, []string{"base_city"}},
+		{"wall_comment", UsageObject, []string{"users_user", "wall_comment"}},
+		{"base_city", UsageObject, nil},
+		{"missing", "", nil},
+	}
+	for _, tt := range tests {
+		if kind, uses := refs.Kind(tt.name), refs.Uses(tt.name); kind != tt.kind || !reflect.DeepEqual(uses, tt.uses) {
+			t.Errorf("%s: Kind() = %q, Uses() = %v, want %q, %v", tt.name, kind, uses, tt.kind, tt.uses)
+		}
+	}
+}
@@ -13,7 +13,6 @@ type ResponseDefinition struct {
 
 type ResponseExpr struct {
 	ObjectExpr
-	Required []string
 }
 
 func (p *Parser) ParseResponses(schema []byte) ([]ResponseDefinition, error) {
@@ -48,8 +47,5 @@ func (p *Parser) parseResponseExpression(resp gjson.Result, depth int) (Response
 	}
 
 	expr.ObjectExpr = objExpr
-	for _, req := range r.Get("required").Array() {
-		expr.Required = append(expr.Required, req.String())
-	}
 	return expr, nil
 }
@@ -1,8 +1,11 @@
 package schema
 
 import (
+	"context"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"time"
 
 	"github.com/tidwall/gjson"
 )
@@ -13,10 +16,15 @@ const (
 	MethodsSchema   SchemaType = "methods.json"
 	ObjectsSchema   SchemaType = "objects.json"
 	ResponsesSchema SchemaType = "responses.json"
+	ErrorsSchema    SchemaType = "errors.json"
 	UnknownSchema   SchemaType = "unknown"
-	repoMasterURL              = "https://github.com/VKCOM/vk-api-schema/blob/master/"
+	// RepoURL serves the raw files of the vk-api-schema repo by ref.
+	RepoURL = "https://raw.githubusercontent.com/VKCOM/vk-api-schema/"
 )
 
+// SchemaFiles are the files of the vk-api-schema repo.
+var SchemaFiles = []SchemaType{MethodsSchema, ObjectsSchema, ResponsesSchema, ErrorsSchema}
+
 func DetectSchemaType(val gjson.Result) SchemaType {
 	if m := val.Get("methods"); m.Exists() && m.IsArray() {
 		return MethodsSchema
@@ -35,17 +43,30 @@ func DetectSchemaType(val gjson.Result) SchemaType {
 	return UnknownSchema
 }
 
-func downloadSchemeFromURL(url string) (gjson.Result, error) {
-	resp, err := http.Get(url)
+// downloadClient gives up on a stalled download.
+var downloadClient = &http.Client{Timeout: time.Minute}
+
+func downloadSchemeFromURL(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := downloadClient.Do(req)
 	if err != nil {
-		return gjson.Result{}, err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return gjson.Result{}, err
+		return nil, err
+	}
+	if !gjson.ValidBytes(body) {
+		return nil, fmt.Errorf("%s: invalid JSON", url)
 	}
 
-	return gjson.ParseBytes(body), nil
+	return body, nil
 }
@@ -0,0 +1,273 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// Mismatch is a difference between a captured value and the schema at a
+// JSON path such as "$.response.items[0].id".
+type Mismatch struct {
+	Path     string `json:"path"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+func (m Mismatch) String() string {
+	return m.Path + ": expected " + m.Expected + ", got " + m.Actual
+}
+
+// ValidateOptions tune the validation.
+type ValidateOptions struct {
+	// Unknown reports the properties the schema does not declare.
+	Unknown bool
+}
+
+// Validation is the result of validating a response body.
+type Validation struct {
+	Method string `json:"method"`
+	// Response is the response variant validated against, the one with the
+	// fewest mismatches unless requested.
+	Response   string     `json:"response"`
+	Mismatches []Mismatch `json:"mismatches"`
+}
+
+// ValidateResponse validates a captured response body of the method, either
+// the whole {"response": ...} body or the response value alone, against
+// the response variant (such as "response" or "extendedResponse"), or the
+// best matching one when variant is empty.
+func (b *Bundle) ValidateResponse(method, variant string, body []byte, opts ValidateOptions) (*Validation, error) {
+	if !gjson.ValidBytes(body) {
+		return nil, errors.New("validate: invalid JSON")
+	}
+	value := gjson.ParseBytes(body)
+	path := "$"
+	if apiErr := value.Get("error"); apiErr.Exists() {
+		return nil, fmt.Errorf("validate: API error %s", apiErr.Get("error_code").Raw)
+	}
+	if resp := value.Get("response"); resp.Exists() {
+		value = resp
+		path = "$.response"
+	}
+
+	var def *MethodDefinition
+	for i := range b.Methods {
+		if b.Methods[i].Name == method {
+			def = &b.Methods[i]
+		}
+	}
+	if def == nil {
+		return nil, fmt.Errorf("validate: no method %s", method)
+	}
+
+	v := &validator{bundle: b, opts: opts, defs: make(map[string]ObjectExpr)}
+	var best *Validation
+	for _, resp := range def.Responses {
+		if variant != "" && resp.Name != variant {
+			continue
+		}
+		v.mismatches = nil
+		v.value(path, value, resp.Expr)
+		if best == nil || len(v.mismatches) < len(best.Mismatches) {
+			best = &Validation{Method: method, Response: resp.Name, Mismatches: v.mismatches}
+		}
+	}
+	if best == nil {
+		return nil, fmt.Errorf("validate: method %s has no response %s", method, variant)
+	}
+	if best.Mismatches == nil {
+		best.Mismatches = []Mismatch{}
+	}
+	return best, nil
+}
+
+type validator struct {
+	bundle     *Bundle
+	opts       ValidateOptions
+	defs       map[string]ObjectExpr // resolved references
+	mismatches []Mismatch
+}
+
+func (v *validator) mismatch(path, expected, actual string) {
+	v.mismatches = append(v.mismatches, Mismatch{Path: path, Expected: expected, Actual: actual})
+}
+
+// resolve returns the expression of the referenced definition.
+func (v *validator) resolve(expr ObjectExpr) (ObjectExpr, error) {
+	if def, ok := v.defs[expr.RefName]; ok {
+		return def, nil
+	}
+	for _, resp := range v.bundle.Responses {
+		if resp.Name == expr.RefName {
+			v.defs[expr.RefName] = resp.Expr.ObjectExpr
+			return resp.Expr.ObjectExpr, nil
+		}
+	}
+	def, err := expr.Ref()
+	if err != nil {
+		return ObjectExpr{}, err
+	}
+	v.defs[expr.RefName] = def.Expr
+	return def.Expr, nil
+}
+
+// jsonType names the JSON type of the value, telling integers apart.
+func jsonType(value gjson.Result) string {
+	switch value.Type {
+	case gjson.Null:
+		return "null"
+	case gjson.False, gjson.True:
+		return "boolean"
+	case gjson.String:
+		return "string"
+	case gjson.Number:
+		if strings.ContainsAny(value.Raw, ".eE") {
+			return "number"
+		}
+		return "integer"
+	}
+	if value.IsArray() {
+		return "array"
+	}
+	return "object"
+}
+
+// types lists the JSON types the expression allows.
+func types(expr ObjectExpr) []string {
+	if strings.HasPrefix(expr.Type, "[") {
+		var list []string
+		for _, typ := range gjson.Parse(expr.Type).Array() {
+			list = append(list, typ.String())
+		}
+		return list
+	}
+	return []string{expr.Type}
+}
+
+func (v *validator) value(path string, value gjson.Result, expr ObjectExpr) {
+	switch {
+	case expr.IsReference:
+		def, err := v.resolve(expr)
+		if err != nil {
+			v.mismatch(path, expr.RefName, err.Error())
+			return
+		}
+		v.value(path, value, def)
+		return
+	case expr.IsAllOf:
+		// the parts declare the properties together
+		unknown := v.opts.Unknown
+		v.opts.Unknown = false
+		for _, part := range expr.AllOf {
+			v.value(path, value, part)
+		}
+		v.opts.Unknown = unknown
+		if unknown && value.IsObject() {
+			v.unknown(path, value, v.propertyNames(expr))
+		}
+		return
+	case expr.IsOneOf:
+		v.oneOf(path, value, expr)
+		return
+	case expr.Type == "":
+		return // anything goes
+	}
+
+	actual := jsonType(value)
+	allowed := types(expr)
+	ok := false
+	for _, typ := range allowed {
+		ok = ok || typ == actual || typ == "number" && actual == "integer"
+	}
+	if !ok {
+		v.mismatch(path, strings.Join(allowed, " or "), actual)
+		return
+	}
+
+	if len(expr.Enum) > 0 {
+		found := false
+		for _, item := range expr.Enum {
+			found = found || fmt.Sprint(item) == fmt.Sprint(value.Value())
+		}
+		if !found {
+			v.mismatch(path, fmt.Sprintf("one of %v", expr.Enum), value.Raw)
+		}
+	}
+
+	switch actual {
+	case "array":
+		if expr.ArrayOf != nil {
+			for i, item := range value.Array() {
+				v.value(fmt.Sprintf("%s[%d]", path, i), item, *expr.ArrayOf)
+			}
+		}
+	case "object":
+		v.object(path, value, expr)
+	}
+}
+
+func (v *validator) object(path string, value gjson.Result, expr ObjectExpr) {
+	for _, name := range expr.Required {
+		if !value.Get(gjsonEscape(name)).Exists() {
+			v.mismatch(path+"."+name, "present", "missing")
+		}
+	}
+	for _, prop := range expr.Properties {
+		if field := value.Get(gjsonEscape(prop.Name)); field.Exists() {
+			v.value(path+"."+prop.Name, field, prop.Expr)
+		}
+	}
+
+	if v.opts.Unknown && len(expr.Properties) > 0 {
+		v.unknown(path, value, v.propertyNames(expr))
+	}
+}
+
+// unknown reports the properties of the value missing from names.
+func (v *validator) unknown(path string, value gjson.Result, names map[string]bool) {
+	value.ForEach(func(key, field gjson.Result) bool {
+		if !names[key.String()] {
+			v.mismatch(path+"."+key.String(), "no property", jsonType(field))
+		}
+		return true
+	})
+}
+
+// propertyNames lists the properties the expression declares, through
+// references and allOf parts.
+func (v *validator) propertyNames(expr ObjectExpr) map[string]bool {
+	names := make(map[string]bool)
+	if expr.IsReference {
+		if def, err := v.resolve(expr); err == nil {
+			return v.propertyNames(def)
+		}
+		return names
+	}
+	for _, part := range expr.AllOf {
+		for name := range v.propertyNames(part) {
+			names[name] = true
+		}
+	}
+	for _, prop := range expr.Properties {
+		names[prop.Name] = true
+	}
+	return names
+}
+
+// oneOf accepts the value matching any of the variants.
+func (v *validator) oneOf(path string, value gjson.Result, expr ObjectExpr) {
+	saved := v.mismatches
+	for _, variant := range expr.OneOf {
+		v.mismatches = nil
+		v.value(path, value, variant)
+		if len(v.mismatches) == 0 {
+			v.mismatches = saved
+			return
+		}
+	}
+	v.mismatches = saved
+	v.mismatch(path, fmt.Sprintf("one of %d variants", len(expr.OneOf)), jsonType(value))
+}
@@ -0,0 +1,152 @@
+package schema
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const (
+	validateObjects = `
+		"users_user": {"type": "object", "required": ["id"], "properties": {
+			"id": {"type": "integer"},
+			"first_name": {"type": "string"},
+			"sex": {"type": "integer", "enum": [0, 1, 2]},
+			"city": {"$ref": "objects.json#/definitions/base_city"},
+			"counters": {"type": "object", "properties": {"friends": {"type": "integer"}}},
+			"photos": {"type": "array", "items": {"type": "string"}},
+			"online": {"$ref": "objects.json#/definitions/base_value"}
+		}},
+		"users_user_full": {"allOf": [
+			{"$ref": "objects.json#/definitions/users_user"},
+			{"type": "object", "properties": {"status": {"type": "string"}}}
+		]},
+		"base_city": {"type": "object", "properties": {"id": {"type": "integer"}, "title": {"type": "string"}}},
+		"base_value": {"type": "object", "oneOf": [{"type": "integer"}, {"type": "string"}]}`
+	validateResponses = `
+		"users_get_response": {"type": "object", "properties": {"response": {
+			"type": "array", "items": {"$ref": "objects.json#/definitions/users_user"}
+		}}},
+		"users_get_extended_response": {"type": "object", "properties": {"response": {
+			"type": "array", "items": {"$ref": "objects.json#/definitions/users_user_full"}
+		}}}`
+	validateMethods = `{
+		"name": "users.get",
+		"responses": {
+			"response": {"$ref": "responses.json#/definitions/users_get_response"},
+			"extendedResponse": {"$ref": "responses.json#/definitions/users_get_extended_response"}
+		}
+	}`
+)
+
+func TestValidateResponse(t *testing.T) {
+	b := parseBundle(t, validateObjects, validateResponses, validateMethods)
+	tests := []struct {
+		name       string
+		variant    string
+		unknown    bool
+		body       string
+		response   string
+		mismatches []string
+	}{
+		{
+			name:     "valid",
+			body:     `{"response":[{"id":1,"first_name":"A","sex":1,"city":{"id":2,"title":"X"},"online":1}]}`,
+			response: "response",
+		},
+		{
+			name:       "bare value",
+			body:       `[{"id":"1"}]`,
+			response:   "response",
+			mismatches: []string{"$[0].id: expected integer, got string"},
+		},
+		{
+			name:     "nested paths",
+			body:     `{"response":[{"id":1},{"id":2,"city":{"id":"x"},"counters":{"friends":1.5},"photos":["a",3]}]}`,
+			response: "response",
+			mismatches: []string{
+				"$.response[1].city.id: expected integer, got string",
+				"$.response[1].counters.friends: expected integer, got number",
+				"$.response[1].photos[1]: expected string, got integer",
+			},
+		},
+		{
+			name:       "required",
+			body:       `{"response":[{"first_name":"A"}]}`,
+			response:   "response",
+			mismatches: []string{"$.response[0].id: expected present, got missing"},
+		},
+		{
+			name:       "enum",
+			body:       `{"response":[{"id":1,"sex":5}]}`,
+			response:   "response",
+			mismatches: []string{"$.response[0].sex: expected one of [0 1 2], got 5"},
+		},
+		{
+			name:       "oneOf",
+			body:       `{"response":[{"id":1,"online":true}]}`,
+			response:   "response",
+			mismatches: []string{"$.response[0].online: expected one of 2 variants, got boolean"},
+		},
+		{
+			name:       "unknown property",
+			unknown:    true,
+			body:       `{"response":[{"id":1,"extra":true}]}`,
+			response:   "response",
+			mismatches: []string{"$.response[0].extra: expected no property, got boolean"},
+		},
+		{
+			name:     "best variant",
+			unknown:  true,
+			body:     `{"response":[{"id":1,"status":"x"}]}`,
+			response: "extendedResponse",
+		},
+		{
+			name:       "requested variant",
+			variant:    "response",
+			unknown:    true,
+			body:       `{"response":[{"id":1,"status":"x"}]}`,
+			response:   "response",
+			mismatches: []string{"$.response[0].status: expected no property, got string"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := b.ValidateResponse("users.get", tt.variant, []byte(tt.body), ValidateOptions{Unknown: tt.unknown})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var mismatches []string
+			for _, m := range v.Mismatches {
+				mismatches = append(mismatches, m.String())
+			}
+			if v.Response != tt.response || !reflect.DeepEqual(mismatches, tt.mismatches) {
+				t.Errorf("ValidateResponse() = %s %q, want %s %q", v.Response, mismatches, tt.response, tt.mismatches)
+			}
+		})
+	}
+}
+
+func TestValidateResponseError(t *testing.T) {
+	b := parseBundle(t, validateObjects, validateResponses, validateMethods)
+	tests := []struct {
+		name    string
+		method  string
+		variant string
+		body    string
+		err     string
+	}{
+		{"invalid JSON", "users.get", "", `{"response":`, "invalid JSON"},
+		{"API error", "users.get", "", `{"error":{"error_code":5}}`, "API error 5"},
+		{"unknown method", "users.search", "", `[]`, "no method users.search"},
+		{"unknown variant", "users.get", "keysResponse", `[]`, "has no response keysResponse"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := b.ValidateResponse(tt.method, tt.variant, []byte(tt.body), ValidateOptions{})
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("ValidateResponse() error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
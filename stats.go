@@ -0,0 +1,237 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cqln/vkgen/schema"
+	"github.com/urfave/cli/v2"
+)
+
+// Field coverage categories: how the generator types a field.
+const (
+	fieldTyped       = "typed"
+	fieldInterface   = "interface"    // emitted as interface{}
+	fieldRaw         = "raw"          // conflicting allOf field, json.RawMessage
+	fieldOneOf       = "oneof"        // inline oneOf, interface{}
+	fieldUntypedEnum = "untyped_enum" // inline enum without named constants
+)
+
+// namespaceStats counts the fields and definitions of a namespace.
+type namespaceStats struct {
+	Namespace   string         `json:"namespace"`
+	Fields      map[string]int `json:"fields"`
+	Definitions int            `json:"definitions"`
+	// EmptyObjects are the objects without properties, carrying no typed
+	// fields; OneOfs the definitions emitted as structs of optional
+	// variants.
+	EmptyObjects int `json:"empty_objects"`
+	OneOfs       int `json:"oneofs"`
+}
+
+// Coverage returns the share of precisely typed fields.
+func (s *namespaceStats) Coverage() float64 {
+	total := 0
+	for _, n := range s.Fields {
+		total += n
+	}
+	if total == 0 {
+		return 1
+	}
+	return float64(s.Fields[fieldTyped]) / float64(total)
+}
+
+// fallback is a field or definition the generator could not type.
+type fallback struct {
+	Path     string `json:"path"`
+	Category string `json:"category"`
+}
+
+type coverage struct {
+	g          Generator
+	namespaces map[string]*namespaceStats
+	fallbacks  []fallback
+}
+
+// newCoverage classifies the objects and responses of the bundle.
+func newCoverage(g Generator, bundle *schema.Bundle) *coverage {
+	c := &coverage{g: g, namespaces: make(map[string]*namespaceStats)}
+	for _, obj := range bundle.Objects {
+		c.definition(obj.Name, obj.Expr)
+	}
+	for _, resp := range bundle.Responses {
+		if _, ok := responseRules[resp.Name]; ok {
+			c.namespace(resp.Name).Definitions++
+			continue
+		}
+		c.definition(resp.Name, resp.Expr.ObjectExpr)
+	}
+	return c
+}
+
+func (c *coverage) namespace(name string) *namespaceStats {
+	ns := name
+	if i := strings.Index(name, "_"); i >= 0 {
+		ns = name[:i]
+	}
+	s, ok := c.namespaces[ns]
+	if !ok {
+		s = &namespaceStats{Namespace: ns, Fields: make(map[string]int)}
+		c.namespaces[ns] = s
+	}
+	return s
+}
+
+func (c *coverage) count(s *namespaceStats, path, category string) {
+	s.Fields[category]++
+	if category != fieldTyped {
+		c.fallbacks = append(c.fallbacks, fallback{Path: path, Category: category})
+	}
+}
+
+// definition classifies the fields of an objects or responses definition.
+func (c *coverage) definition(name string, expr schema.ObjectExpr) {
+	s := c.namespace(name)
+	s.Definitions++
+	switch {
+	case expr.IsOneOf:
+		s.OneOfs++
+		c.fallbacks = append(c.fallbacks, fallback{Path: name, Category: "oneof_definition"})
+	case expr.IsAllOf:
+		c.allOf(s, name, expr)
+	case expr.Type == "object" && len(expr.Properties) == 0:
+		s.EmptyObjects++
+		c.fallbacks = append(c.fallbacks, fallback{Path: name, Category: "empty_object"})
+	case len(expr.Properties) > 0:
+		for _, prop := range expr.Properties {
+			c.field(s, name+"."+prop.Name, prop.Expr)
+		}
+	case expr.IsEnum:
+		// named constants
+	default:
+		c.field(s, name, expr)
+	}
+}
+
+// field classifies the field the way objectExprToGolang types it.
+func (c *coverage) field(s *namespaceStats, path string, expr schema.ObjectExpr) {
+	switch {
+	case expr.IsReference:
+		c.count(s, path, fieldTyped)
+	case expr.IsAllOf:
+		c.allOf(s, path, expr)
+	case expr.IsOneOf:
+		c.count(s, path, fieldOneOf)
+	case expr.IsEnum:
+		c.count(s, path, fieldUntypedEnum)
+	case expr.Type == "array" && expr.ArrayOf != nil:
+		c.field(s, path+"[]", *expr.ArrayOf)
+	case expr.Type == "object" && len(expr.Properties) > 0:
+		for _, prop := range expr.Properties {
+			c.field(s, path+"."+prop.Name, prop.Expr)
+		}
+	case expr.Type == "integer", expr.Type == "number", expr.Type == "string", expr.Type == "boolean":
+		c.count(s, path, fieldTyped)
+	default:
+		c.count(s, path, fieldInterface)
+	}
+}
+
+// allOf classifies the merged fields the way allofExprToGolang types them.
+func (c *coverage) allOf(s *namespaceStats, path string, expr schema.ObjectExpr) {
+	merged := c.g.allofExtractFields(expr)
+	names := make([]string, 0, len(merged))
+	for name := range merged {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fields := merged[name]
+		conflict := false
+		for i := 1; i < len(fields); i++ {
+			conflict = conflict || isDifferentExprs(fields[i-1], fields[i])
+		}
+		if conflict {
+			c.count(s, path+"."+name, fieldRaw)
+			continue
+		}
+		c.field(s, path+"."+name, fields[0])
+	}
+}
+
+func statsCmd(c *cli.Context) error {
+	dir := c.String("dir")
+	objschema, err := ioutil.ReadFile(filepath.Join(dir, string(schema.ObjectsSchema)))
+	if err != nil {
+		return err
+	}
+	bundle, err := schema.LoadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	cov := newCoverage(NewGenerator(Options{SchemaDir: dir}, objschema), bundle)
+
+	var namespaces []*namespaceStats
+	total := &namespaceStats{Namespace: "total", Fields: make(map[string]int)}
+	for _, s := range cov.namespaces {
+		namespaces = append(namespaces, s)
+		for category, n := range s.Fields {
+			total.Fields[category] += n
+		}
+		total.Definitions += s.Definitions
+		total.EmptyObjects += s.EmptyObjects
+		total.OneOfs += s.OneOfs
+	}
+	// the worst covered first
+	sort.Slice(namespaces, func(i, j int) bool {
+		if ci, cj := namespaces[i].Coverage(), namespaces[j].Coverage(); ci != cj {
+			return ci < cj
+		}
+		return namespaces[i].Namespace < namespaces[j].Namespace
+	})
+
+	if c.Bool("json") {
+		report := struct {
+			Total      *namespaceStats   `json:"total"`
+			Namespaces []*namespaceStats `json:"namespaces"`
+			Fallbacks  []fallback        `json:"fallbacks,omitempty"`
+		}{Total: total, Namespaces: namespaces}
+		if c.Bool("list") {
+			report.Fallbacks = cov.fallbacks
+		}
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "namespace\tcoverage\ttyped\tinterface{}\tRawMessage\toneOf\tuntyped enum\tdefinitions\tempty objects\toneOf defs\t")
+	for _, s := range append(namespaces, total) {
+		fmt.Fprintf(w, "%s\t%.1f%%\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t\n",
+			s.Namespace, 100*s.Coverage(),
+			s.Fields[fieldTyped], s.Fields[fieldInterface], s.Fields[fieldRaw], s.Fields[fieldOneOf], s.Fields[fieldUntypedEnum],
+			s.Definitions, s.EmptyObjects, s.OneOfs)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if c.Bool("list") {
+		fmt.Println()
+		for _, fb := range cov.fallbacks {
+			fmt.Println(fb.Category + "\t" + fb.Path)
+		}
+	}
+	return nil
+}
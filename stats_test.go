@@ -0,0 +1,115 @@
+package main
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+const (
+	statsObjects = `
+		"base_object": {"type": "object", "properties": {
+			"id": {"type": "integer"},
+			"title": {"type": "string"}
+		}},
+		"base_object_ext": {"type": "object", "properties": {"id": {"type": "string"}}},
+		"users_user": {"type": "object", "properties": {
+			"id": {"type": "integer"},
+			"sex": {"type": "integer", "enum": [0, 1, 2]},
+			"counters": {},
+			"status": {"type": "object", "oneOf": [{"type": "integer"}, {"type": "string"}]},
+			"city": {"$ref": "objects.json#/definitions/base_object"},
+			"tags": {"type": "array", "items": {}}
+		}},
+		"users_merged": {"allOf": [
+			{"$ref": "objects.json#/definitions/base_object"},
+			{"$ref": "objects.json#/definitions/base_object_ext"}
+		]},
+		"users_sex": {"type": "integer", "enum": [0, 1, 2]},
+		"notifications_item": {"type": "object"},
+		"notifications_either": {"type": "object", "oneOf": [
+			{"$ref": "objects.json#/definitions/base_object"},
+			{"$ref": "objects.json#/definitions/users_user"}
+		]}`
+	statsResponses = `
+		"users_get_response": {"type": "object", "properties": {"response": {
+			"type": "array", "items": {"$ref": "objects.json#/definitions/users_user"}
+		}}}`
+)
+
+func TestCoverage(t *testing.T) {
+	dir := writeSchemaDir(t, statsObjects, statsResponses, "")
+	bundle, err := schema.LoadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cov := newCoverage(newTestGenerator(t, dir, Options{}), bundle)
+
+	tests := []struct {
+		want     namespaceStats
+		coverage float64
+	}{
+		{
+			want: namespaceStats{
+				Namespace:   "base",
+				Fields:      map[string]int{fieldTyped: 3},
+				Definitions: 2,
+			},
+			coverage: 1,
+		},
+		{
+			want: namespaceStats{
+				Namespace: "users",
+				Fields: map[string]int{
+					fieldTyped: 4, fieldInterface: 2, fieldRaw: 1, fieldOneOf: 1, fieldUntypedEnum: 1,
+				},
+				Definitions: 4,
+			},
+			coverage: 4.0 / 9,
+		},
+		{
+			want: namespaceStats{
+				Namespace:    "notifications",
+				Fields:       map[string]int{},
+				Definitions:  2,
+				EmptyObjects: 1,
+				OneOfs:       1,
+			},
+			coverage: 1,
+		},
+	}
+	if len(cov.namespaces) != len(tests) {
+		t.Errorf("%d namespaces, want %d", len(cov.namespaces), len(tests))
+	}
+	for _, tt := range tests {
+		got := cov.namespaces[tt.want.Namespace]
+		if got == nil {
+			t.Errorf("no %s namespace", tt.want.Namespace)
+			continue
+		}
+		if !reflect.DeepEqual(*got, tt.want) {
+			t.Errorf("namespace %s = %+v, want %+v", tt.want.Namespace, *got, tt.want)
+		}
+		if c := got.Coverage(); c != tt.coverage {
+			t.Errorf("namespace %s coverage = %v, want %v", tt.want.Namespace, c, tt.coverage)
+		}
+	}
+
+	want := []fallback{
+		{"notifications_either", "oneof_definition"},
+		{"notifications_item", "empty_object"},
+		{"users_merged.id", fieldRaw},
+		{"users_user.counters", fieldInterface},
+		{"users_user.sex", fieldUntypedEnum},
+		{"users_user.status", fieldOneOf},
+		{"users_user.tags[]", fieldInterface},
+	}
+	sort.Slice(cov.fallbacks, func(i, j int) bool {
+		return cov.fallbacks[i].Path < cov.fallbacks[j].Path
+	})
+	if !reflect.DeepEqual(cov.fallbacks, want) {
+		t.Errorf("fallbacks =\n%+v\nwant\n%+v", cov.fallbacks, want)
+	}
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+// generateStrict emits the registry of schema types and their required
+// fields used by the strict decoding mode of the runtime to report drift,
+// including for the types the fast JSON and lenient emitters decode.
+func (g Generator) generateStrict() error {
+	objectsSchema, err := g.readSchema(schema.ObjectsSchema)
+	if err != nil {
+		return err
+	}
+	responsesSchema, err := g.readSchema(schema.ResponsesSchema)
+	if err != nil {
+		return err
+	}
+
+	objects, err := g.parser.ParseObjects(objectsSchema)
+	if err != nil {
+		return err
+	}
+	responses, err := g.parser.ParseResponses(responsesSchema)
+	if err != nil {
+		return err
+	}
+
+	b := bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\npackage " + g.pkgName + "\n\n")
+	b.WriteString("import \"" + runtimePkg + "\"\n\n")
+	b.WriteString("func init() {\n")
+	for _, object := range objects {
+		g.writeRequired(b, g.objectTypeName(object.Name), object.Expr)
+	}
+	for _, response := range responses {
+		if _, ok := responseRules[response.Name]; ok {
+			continue
+		}
+		g.writeRequired(b, g.responseTypeName(response.Name), response.Expr.ObjectExpr)
+	}
+	b.WriteString("}\n")
+	return g.writeSource("strict.gen.go", b)
+}
+
+func (g Generator) writeRequired(b *bytes.Buffer, goType string, expr schema.ObjectExpr) {
+	// aliases of builtin types are not types of their own
+	if (expr.IsBaseType || expr.IsReference) && isBuiltin(g.objectExprToGolang(expr)) {
+		return
+	}
+
+	args := []string{"(*" + goType + ")(nil)"}
+	if len(expr.Properties) > 0 && !expr.IsReference && !expr.IsBaseType {
+		for _, name := range expr.Required {
+			args = append(args, strconv.Quote(name))
+		}
+	}
+	b.WriteString("\truntime.RegisterRequired(" + strings.Join(args, ", ") + ")\n")
+}
@@ -0,0 +1,337 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+// maxSampleDepth limits how deep references are followed while building
+// sample payloads, so self-referencing objects (wall_wallpost_full and
+// its copy_history) produce finite samples.
+const maxSampleDepth = 4
+
+// roundTripCase is a single generated test case: a Go type together with
+// a payload built from its schema definition.
+type roundTripCase struct {
+	goType string
+	sample []byte
+	skip   string
+}
+
+func (g Generator) generateTests() error {
+	objectsSchema, err := g.readSchema(schema.ObjectsSchema)
+	if err != nil {
+		return err
+	}
+	responsesSchema, err := g.readSchema(schema.ResponsesSchema)
+	if err != nil {
+		return err
+	}
+
+	objects, err := g.parser.ParseObjects(objectsSchema)
+	if err != nil {
+		return err
+	}
+	responses, err := g.parser.ParseResponses(responsesSchema)
+	if err != nil {
+		return err
+	}
+	methodsSchema, err := g.readSchema(schema.MethodsSchema)
+	if err != nil {
+		return err
+	}
+	methods, err := g.parser.ParseMethods(methodsSchema)
+	if err != nil {
+		return err
+	}
+	requests := make(map[string]bool)
+	for _, method := range methods {
+		requests[g.goify(method.Name)] = true
+	}
+
+	var objectCases, responseCases []roundTripCase
+	for _, object := range objects {
+		c, err := g.sampleCase(g.objectTypeName(object.Name), object.Expr)
+		if err != nil {
+			return err
+		}
+		objectCases = append(objectCases, c)
+	}
+	for _, response := range responses {
+		c, err := g.sampleCase(g.responseTypeName(response.Name), response.Expr.ObjectExpr)
+		if err != nil {
+			return err
+		}
+		if response.Expr.IsReference && requests[g.objectExprToGolang(response.Expr.ObjectExpr)] {
+			// leads_complete and leads_start goify to the request structs
+			c.skip = "response aliases a request struct"
+		}
+		responseCases = append(responseCases, c)
+	}
+
+	b := bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\npackage " + g.pkgName + "\n\n")
+	b.WriteString("import (\n\t\"encoding/json\"\n\t\"strconv\"\n\t\"testing\"\n)\n\n")
+	writeRoundTripTest(b, "TestObjectsRoundTrip", objectCases)
+	writeRoundTripTest(b, "TestResponsesRoundTrip", responseCases)
+	b.WriteString(roundTripHelpers)
+	if err := g.writeSource("roundtrip.gen_test.go", b); err != nil {
+		return err
+	}
+
+	// testing.F is only available since go1.18, so fuzz targets live in
+	// their own file guarded by a build constraint.
+	b = bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\n//go:build go1.18\n// +build go1.18\n\npackage " + g.pkgName + "\n\n")
+	b.WriteString("import (\n\t\"encoding/json\"\n\t\"testing\"\n)\n\n")
+	for _, c := range append(objectCases, responseCases...) {
+		if c.skip != "" {
+			continue
+		}
+		b.WriteString("func Fuzz" + c.goType + "(f *testing.F) {\n")
+		b.WriteString("\tf.Add([]byte(" + strconv.Quote(string(c.sample)) + "))\n")
+		b.WriteString("\tf.Fuzz(func(t *testing.T, data []byte) {\n")
+		b.WriteString("\t\ttestFuzzRoundTrip(t, data, func() interface{} { return new(" + c.goType + ") })\n")
+		b.WriteString("\t})\n")
+		b.WriteString("}\n\n")
+	}
+	b.WriteString(fuzzHelpers)
+	return g.writeSource("fuzz.gen_test.go", b)
+}
+
+func (g Generator) sampleCase(goType string, expr schema.ObjectExpr) (roundTripCase, error) {
+	c := roundTripCase{goType: goType}
+	if expr.IsOneOf {
+		// oneOf definitions are generated as a struct of pointers keyed by
+		// the variant name, which does not match the wire format yet.
+		c.skip = "oneOf definitions are not supported by the generator"
+	} else if g.hasOneOf(expr, 0) {
+		c.skip = "nested oneOf fields are not supported by the generator"
+	}
+
+	sample, ok := g.sampleValue(expr, 0)
+	if !ok && c.skip == "" {
+		c.skip = "definition has no type to build a sample from"
+	}
+	data, err := json.Marshal(sample)
+	if err != nil {
+		return c, err
+	}
+	c.sample = data
+	return c, nil
+}
+
+// sampleValue builds a value matching the schema expression. The second
+// result is false when no meaningful value can be produced, in which case
+// the property is left out of the enclosing object.
+func (g Generator) sampleValue(expr schema.ObjectExpr, depth int) (interface{}, bool) {
+	if expr.IsReference {
+		if depth >= maxSampleDepth {
+			return nil, false
+		}
+		ref, err := expr.Ref()
+		if err != nil {
+			panic(err)
+		}
+		return g.sampleValue(ref.Expr, depth+1)
+	}
+
+	if expr.IsAllOf {
+		obj := make(map[string]interface{})
+		for name, fields := range g.allofExtractFields(expr) {
+			if v, ok := g.sampleValue(fields[0], depth); ok {
+				obj[name] = v
+			}
+		}
+		return obj, true
+	}
+
+	if expr.IsOneOf {
+		return nil, false
+	}
+
+	if expr.IsEnum {
+		if len(expr.Enum) == 0 {
+			return nil, false
+		}
+		return expr.Enum[0], true
+	}
+
+	switch expr.Type {
+	case "integer":
+		return 1, true
+	case "number":
+		return 1.5, true
+	case "string":
+		return "string", true
+	case "boolean":
+		return true, true
+	case "array":
+		item, ok := g.sampleValue(*expr.ArrayOf, depth)
+		if !ok {
+			return []interface{}{}, true
+		}
+		return []interface{}{item}, true
+	case "object":
+		obj := make(map[string]interface{})
+		for _, prop := range expr.Properties {
+			if v, ok := g.sampleValue(prop.Expr, depth); ok {
+				obj[prop.Name] = v
+			}
+		}
+		return obj, true
+	default:
+		return nil, false
+	}
+}
+
+// hasOneOf reports whether a oneOf is reachable from the expression within
+// the sample depth.
+func (g Generator) hasOneOf(expr schema.ObjectExpr, depth int) bool {
+	switch {
+	case expr.IsOneOf:
+		return true
+	case expr.IsReference:
+		if depth >= maxSampleDepth {
+			return false
+		}
+		ref, err := expr.Ref()
+		if err != nil {
+			panic(err)
+		}
+		return g.hasOneOf(ref.Expr, depth+1)
+	case expr.IsAllOf:
+		for _, fields := range g.allofExtractFields(expr) {
+			if g.hasOneOf(fields[0], depth) {
+				return true
+			}
+		}
+	case expr.ArrayOf != nil:
+		return g.hasOneOf(*expr.ArrayOf, depth)
+	}
+	for _, prop := range expr.Properties {
+		if g.hasOneOf(prop.Expr, depth) {
+			return true
+		}
+	}
+	return false
+}
+
+func writeRoundTripTest(b *bytes.Buffer, name string, cases []roundTripCase) {
+	b.WriteString("func " + name + "(t *testing.T) {\n")
+	b.WriteString("\ttests := []struct {\n")
+	b.WriteString("\t\tname   string\n")
+	b.WriteString("\t\tvalue  interface{}\n")
+	b.WriteString("\t\tsample string\n")
+	b.WriteString("\t\tskip   string\n")
+	b.WriteString("\t}{\n")
+	for _, c := range cases {
+		b.WriteString("\t\t{" + strconv.Quote(c.goType) + ", new(" + c.goType + "), " +
+			strconv.Quote(string(c.sample)) + ", " + strconv.Quote(c.skip) + "},\n")
+	}
+	b.WriteString("\t}\n\n")
+	b.WriteString("\tfor _, tt := range tests {\n")
+	b.WriteString("\t\ttt := tt\n")
+	b.WriteString("\t\tt.Run(tt.name, func(t *testing.T) {\n")
+	b.WriteString("\t\t\tif tt.skip != \"\" {\n")
+	b.WriteString("\t\t\t\tt.Skip(tt.skip)\n")
+	b.WriteString("\t\t\t}\n")
+	b.WriteString("\t\t\ttestRoundTrip(t, tt.value, tt.sample)\n")
+	b.WriteString("\t\t})\n")
+	b.WriteString("\t}\n")
+	b.WriteString("}\n\n")
+}
+
+const roundTripHelpers = `// testRoundTrip decodes the sample into v, encodes v back and checks that
+// every value of the sample survived the round trip.
+func testRoundTrip(t *testing.T, v interface{}, sample string) {
+	t.Helper()
+
+	if err := json.Unmarshal([]byte(sample), v); err != nil {
+		t.Fatalf("unmarshal: %v\nsample: %s", err, sample)
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	var want, got interface{}
+	if err := json.Unmarshal([]byte(sample), &want); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if path, ok := jsonContains(got, want, "$"); !ok {
+		t.Errorf("round trip mismatch at %s\nsample: %s\nresult: %s", path, sample, out)
+	}
+}
+
+// jsonContains reports whether got holds every value of want. Extra object
+// keys in got are allowed, since zero-valued fields are always encoded.
+func jsonContains(got, want interface{}, path string) (string, bool) {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			return path, false
+		}
+		for key, wv := range w {
+			gv, ok := g[key]
+			if !ok {
+				return path + "." + key, false
+			}
+			if p, ok := jsonContains(gv, wv, path+"."+key); !ok {
+				return p, false
+			}
+		}
+		return "", true
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok || len(g) != len(w) {
+			return path, false
+		}
+		for i := range w {
+			if p, ok := jsonContains(g[i], w[i], path+"["+strconv.Itoa(i)+"]"); !ok {
+				return p, false
+			}
+		}
+		return "", true
+	default:
+		return path, got == want
+	}
+}
+`
+
+const fuzzHelpers = `// testFuzzRoundTrip checks that any payload accepted by the type encodes
+// and decodes again to the same representation.
+func testFuzzRoundTrip(t *testing.T, data []byte, newValue func() interface{}) {
+	v := newValue()
+	if err := json.Unmarshal(data, v); err != nil {
+		return
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	v2 := newValue()
+	if err := json.Unmarshal(out, v2); err != nil {
+		t.Fatalf("unmarshal of marshalled value: %v\n%s", err, out)
+	}
+
+	out2, err := json.Marshal(v2)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if string(out) != string(out2) {
+		t.Errorf("unstable round trip:\n%s\n%s", out, out2)
+	}
+}
+`
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cqln/vkgen/schema"
+)
+
+// uploadPair is a get*UploadServer method and the method saving its upload.
+type uploadPair struct {
+	name   string // helper name
+	noun   string // what is uploaded, for the doc comment
+	server schema.MethodDefinition
+	save   schema.MethodDefinition
+	field  string            // multipart field of the file
+	rename map[string]string // upload response fields named differently in the save method
+}
+
+var uploadServerRe = regexp.MustCompile(`^(\w+)\.get(\w*)UploadServer$`)
+
+// uploadNouns names what the namespaces upload. Namespaces missing here
+// (stories has no save method in the schema) get no helpers.
+var uploadNouns = map[string]string{
+	"docs":   "Doc",
+	"photos": "Photo",
+}
+
+// uploadSaveMethods pairs the server methods the naming convention does
+// not match.
+var uploadSaveMethods = map[string]string{
+	"photos.getChatUploadServer": "messages.setChatPhoto",
+}
+
+// uploadFields holds the multipart fields other than "file".
+var uploadFields = map[string]string{
+	"photos.getMessagesUploadServer":        "photo",
+	"photos.getOwnerCoverPhotoUploadServer": "photo",
+	"photos.getOwnerPhotoUploadServer":      "photo",
+	"photos.getUploadServer":                "file1",
+	"photos.getWallUploadServer":            "photo",
+}
+
+// uploadRenames maps upload response fields to save method parameters.
+var uploadRenames = map[string]map[string]string{
+	"photos.getChatUploadServer": {"response": "file"},
+}
+
+// uploadPairs recognizes the upload server methods and their save methods:
+// ns.getXUploadServer is saved by ns.saveX, ns.saveXPhoto or ns.save.
+func (g Generator) uploadPairs(methods []schema.MethodDefinition) []uploadPair {
+	byName := make(map[string]schema.MethodDefinition, len(methods))
+	for _, method := range methods {
+		byName[method.Name] = method
+	}
+
+	var pairs []uploadPair
+	for _, server := range methods {
+		m := uploadServerRe.FindStringSubmatch(server.Name)
+		if m == nil {
+			continue
+		}
+		ns, x := m[1], m[2]
+		noun, ok := uploadNouns[ns]
+		if !ok {
+			continue
+		}
+
+		candidates := []string{ns + ".save" + x, ns + ".save" + x + noun, ns + ".save"}
+		if name, ok := uploadSaveMethods[server.Name]; ok {
+			candidates = []string{name}
+		}
+		var save schema.MethodDefinition
+		for _, name := range candidates {
+			if method, ok := byName[name]; ok {
+				save = method
+				break
+			}
+		}
+		if save.Name == "" {
+			continue
+		}
+
+		name := "Upload" + x
+		if !strings.HasSuffix(x, noun) {
+			name += noun
+		}
+		field, ok := uploadFields[server.Name]
+		if !ok {
+			field = "file"
+		}
+		pairs = append(pairs, uploadPair{
+			name:   name,
+			noun:   strings.ToLower(noun),
+			server: server,
+			save:   save,
+			field:  field,
+			rename: uploadRenames[server.Name],
+		})
+	}
+	return pairs
+}
+
+// generateUploads emits the helpers getting the upload server, streaming
+// the file to it and saving the upload.
+func (g Generator) generateUploads() error {
+	methodsSchema, err := g.readSchema(schema.MethodsSchema)
+	if err != nil {
+		return err
+	}
+	methods, err := g.parser.ParseMethods(methodsSchema)
+	if err != nil {
+		return err
+	}
+
+	b := bytes.NewBuffer(nil)
+	b.WriteString(genPrefix + "\n\npackage " + g.pkgName + "\n\n")
+	b.WriteString("import (\n")
+	b.WriteString("\t\"context\"\n")
+	b.WriteString("\t\"io\"\n\n")
+	b.WriteString("\t\"" + runtimePkg + "\"\n")
+	b.WriteString(")\n\n")
+	for _, pair := range g.uploadPairs(methods) {
+		g.writeUpload(b, pair)
+	}
+	return g.writeSource("uploads.gen.go", b)
+}
+
+func (g Generator) writeUpload(b *bytes.Buffer, pair uploadPair) {
+	var response schema.ObjectDefinition
+	for _, resp := range pair.save.Responses {
+		if len(pair.save.Responses) == 1 || resp.Name == "response" {
+			response = resp
+		}
+	}
+	gresponse := g.objectExprToGolang(response.Expr)
+
+	serverParams := make(map[string]bool)
+	for _, param := range pair.server.Parameters {
+		serverParams[param.Name] = true
+	}
+	var shared, saved []string
+	for _, param := range pair.save.Parameters {
+		if serverParams[param.Name] {
+			shared = append(shared, strconv.Quote(param.Name))
+		} else {
+			saved = append(saved, strconv.Quote(param.Name))
+		}
+	}
+
+	b.WriteString("// " + pair.name + " uploads a " + pair.noun + " to the server from " + pair.server.Name + "\n")
+	b.WriteString("// and saves it with " + pair.save.Name + ". Parameters shared by both methods\n")
+	b.WriteString("// are taken from req unless set in save.\n")
+	b.WriteString("func (vk *VK) " + pair.name + "(ctx context.Context, req " + g.goify(pair.server.Name) + ", save " + g.goify(pair.save.Name) + ", file io.Reader) (response " + gresponse + ", err error) {\n")
+	b.WriteString("\tvar server runtime.UploadServer\n")
+	b.WriteString("\tserverParams := req.Params()\n")
+	b.WriteString("\terr = vk.CallUnmarshal(ctx, req, serverParams, &server)\n")
+	b.WriteString("\tif err != nil {\n")
+	b.WriteString("\t\treturn\n")
+	b.WriteString("\t}\n\n")
+	b.WriteString("\tuploaded, err := vk.Upload(ctx, server.UploadURL, \"" + pair.field + "\", file)\n")
+	b.WriteString("\tif err != nil {\n")
+	b.WriteString("\t\treturn\n")
+	b.WriteString("\t}\n")
+	renamed := make([]string, 0, len(pair.rename))
+	for from := range pair.rename {
+		renamed = append(renamed, from)
+	}
+	sort.Strings(renamed)
+	for _, from := range renamed {
+		b.WriteString("\tuploaded[\"" + pair.rename[from] + "\"] = uploaded[\"" + from + "\"]\n")
+	}
+	b.WriteString("\n")
+	b.WriteString("\tparams := save.Params()\n")
+	if len(shared) > 0 {
+		b.WriteString("\tparams.Defaults(serverParams, " + strings.Join(shared, ", ") + ")\n")
+	}
+	if len(saved) > 0 {
+		b.WriteString("\tparams.Merge(uploaded, " + strings.Join(saved, ", ") + ")\n")
+	}
+	b.WriteString("\terr = vk.CallUnmarshal(ctx, save, params, &response)\n")
+	b.WriteString("\treturn\n")
+	b.WriteString("}\n\n")
+}
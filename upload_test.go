@@ -0,0 +1,45 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateUploads(t *testing.T) {
+	dir := writeSchemaDir(t,
+		`"base_ok": {"type": "integer", "enum": [1]}`,
+		`"base_ok_response": {"type": "object", "properties": {"response": {"$ref": "objects.json#/definitions/base_ok"}}},
+		"base_upload_server_response": {"type": "object", "properties": {"response": {"type": "object", "properties": {"upload_url": {"type": "string"}}}}}`,
+		`{
+			"name": "photos.getChatUploadServer",
+			"parameters": [{"name": "chat_id", "type": "integer", "required": true}],
+			"responses": {"response": {"$ref": "responses.json#/definitions/base_upload_server_response"}}
+		}, {
+			"name": "messages.setChatPhoto",
+			"parameters": [{"name": "file", "type": "string"}, {"name": "crop_data", "type": "string"}],
+			"responses": {"response": {"$ref": "responses.json#/definitions/base_ok_response"}}
+		}`,
+	)
+	defer func(renames map[string]string) {
+		uploadRenames["photos.getChatUploadServer"] = renames
+	}(uploadRenames["photos.getChatUploadServer"])
+	uploadRenames["photos.getChatUploadServer"] = map[string]string{"response": "file", "crop": "crop_data"}
+
+	want := []string{
+		"func (vk *VK) UploadChatPhoto(ctx context.Context, req PhotosGetChatUploadServer, save MessagesSetChatPhoto, file io.Reader)",
+		"err = vk.CallUnmarshal(ctx, req, serverParams, &server)",
+		"uploaded[\"crop_data\"] = uploaded[\"crop\"]\n\tuploaded[\"file\"] = uploaded[\"response\"]\n",
+		"params.Merge(uploaded, \"file\", \"crop_data\")",
+		"err = vk.CallUnmarshal(ctx, save, params, &response)",
+	}
+	// the renames come from a map: generate a few times to catch a
+	// changing order
+	for i := 0; i < 5; i++ {
+		src := generateFiles(t, dir, Options{})["uploads.gen.go"]
+		for _, want := range want {
+			if !strings.Contains(src, want) {
+				t.Fatalf("uploads.gen.go lacks\n%s\nin\n%s", want, src)
+			}
+		}
+	}
+}
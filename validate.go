@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cqln/vkgen/schema"
+	"github.com/urfave/cli/v2"
+)
+
+func validateCmd(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return errors.New("validate: want captured response files")
+	}
+	bundle, err := schema.LoadDir(c.String("dir"))
+	if err != nil {
+		return err
+	}
+	opts := schema.ValidateOptions{Unknown: c.Bool("unknown")}
+
+	type result struct {
+		File string `json:"file"`
+		*schema.Validation
+	}
+	var results []result
+	failed := false
+	for _, file := range c.Args().Slice() {
+		body, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		validation, err := bundle.ValidateResponse(c.String("method"), c.String("response"), body, opts)
+		if err != nil {
+			return fmt.Errorf("%s: %v", file, err)
+		}
+		results = append(results, result{File: file, Validation: validation})
+		failed = failed || len(validation.Mismatches) > 0
+	}
+
+	if c.Bool("json") {
+		data, err := json.MarshalIndent(results, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+	} else {
+		for _, res := range results {
+			for _, mismatch := range res.Mismatches {
+				fmt.Printf("%s: %s %s\n", res.File, res.Response, mismatch)
+			}
+		}
+	}
+
+	if failed {
+		return cli.Exit("", 1)
+	}
+	return nil
+}
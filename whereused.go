@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cqln/vkgen/schema"
+	"github.com/urfave/cli/v2"
+)
+
+func whereUsedCmd(c *cli.Context) error {
+	name := c.Args().First()
+	if c.NArg() != 1 {
+		return errors.New("whereused: want a definition name")
+	}
+	bundle, err := schema.LoadDir(c.String("dir"))
+	if err != nil {
+		return err
+	}
+	refs := bundle.References()
+	if kind := refs.Kind(name); kind == "" || kind == schema.UsageMethod {
+		return fmt.Errorf("whereused: no definition %s", name)
+	}
+
+	var usages []schema.Usage
+	for _, usage := range refs.WhereUsed(name) {
+		if !c.Bool("direct") || usage.Depth == 1 {
+			usages = append(usages, usage)
+		}
+	}
+
+	if c.Bool("json") {
+		if usages == nil {
+			usages = []schema.Usage{}
+		}
+		data, err := json.MarshalIndent(usages, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	for _, kind := range []string{schema.UsageMethod, schema.UsageResponse, schema.UsageObject} {
+		var lines []string
+		for _, usage := range usages {
+			if usage.Kind != kind {
+				continue
+			}
+			line := "  " + usage.Name
+			if usage.Via == "" {
+				line += " at " + strings.Join(usage.Paths, ", ")
+			} else {
+				line += fmt.Sprintf(" via %s (depth %d)", usage.Via, usage.Depth)
+			}
+			lines = append(lines, line)
+		}
+		if len(lines) > 0 {
+			fmt.Printf("%ss (%d):\n%s\n", kind, len(lines), strings.Join(lines, "\n"))
+		}
+	}
+	return nil
+}